@@ -24,6 +24,11 @@ func ExecFile(pathToFile string, opts Options) {
 }
 
 func Exec(query string, opts Options) {
+	if opts.Explain {
+		Explain(query, opts)
+		return
+	}
+
 	ferret := compiler.New()
 
 	prog, err := ferret.Compile(query)
@@ -64,6 +69,8 @@ func Exec(query string, opts Options) {
 		runtime.WithParams(opts.Params),
 	)
 
+	cancel()
+
 	if opts.ShowTime {
 		timer.Stop()
 	}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+)
+
+const (
+	ExplainFormatText = "text"
+	ExplainFormatJSON = "json"
+)
+
+func Explain(query string, opts Options) {
+	ferret := compiler.New()
+
+	if err := explain(ferret, query, opts.ExplainFormat); err != nil {
+		fmt.Println("Failed to explain the query")
+		fmt.Println(err)
+		os.Exit(1)
+		return
+	}
+}
+
+func explain(ferret *compiler.FqlCompiler, query, format string) error {
+	explanation, err := ferret.Explain(query)
+
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case ExplainFormatJSON:
+		out, err := json.MarshalIndent(explanation, "", "  ")
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(out))
+	case ExplainFormatText, "":
+		fmt.Print(explanation.String())
+	default:
+		return fmt.Errorf("unknown explain format: %s", format)
+	}
+
+	return nil
+}
@@ -2,26 +2,97 @@ package cli
 
 import (
 	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/MontFerret/ferret/pkg/drivers"
 	"github.com/MontFerret/ferret/pkg/drivers/cdp"
 	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/pkg/errors"
 )
 
 type Options struct {
-	Cdp         string
-	Params      map[string]interface{}
-	Proxy       string
-	UserAgent   string
-	ShowTime    bool
-	KeepCookies bool
+	Cdp           string
+	Params        map[string]interface{}
+	Proxy         string
+	UserAgent     string
+	ShowTime      bool
+	KeepCookies   bool
+	CookieJar     string
+	Robots        bool
+	HTTPCache     string
+	HTTPCacheMode string
+	HTTPCA        string
+	HTTPCert      string
+	HTTPKey       string
+	HTTPInsecure  string
+	HTTPRecord    string
+	HTTPReplay    string
+	Explain       bool
+	ExplainFormat string
 }
 
 func (opts Options) WithContext(ctx context.Context) (context.Context, context.CancelFunc) {
-	httpDriver := http.NewDriver(
+	httpOpts := []http.Option{
 		http.WithProxy(opts.Proxy),
 		http.WithUserAgent(opts.UserAgent),
-	)
+	}
+
+	if opts.Robots {
+		httpOpts = append(httpOpts, http.WithRobots())
+	}
+
+	if opts.HTTPCache != "" {
+		mode, err := toCacheMode(opts.HTTPCacheMode)
+
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		httpOpts = append(httpOpts, http.WithCache(opts.HTTPCache, mode))
+	}
+
+	httpOpts = append(httpOpts, opts.tlsOptions()...)
+
+	var jar *http.CookieJar
+
+	if opts.CookieJar != "" {
+		j, err := http.LoadCookieJar(opts.CookieJar)
+
+		if err != nil {
+			fmt.Println("Failed to load cookies")
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		jar = j
+		httpOpts = append(httpOpts, http.WithCookieJar(jar))
+	}
+
+	var recorder *http.Recorder
+
+	if opts.HTTPRecord != "" {
+		recorder = http.NewRecorder()
+		httpOpts = append(httpOpts, http.WithRecorder(recorder))
+	}
+
+	if opts.HTTPReplay != "" {
+		replay, err := http.LoadReplay(opts.HTTPReplay)
+
+		// the live network must not be used instead of the recorded one
+		if err != nil {
+			fmt.Println("Failed to load recorded requests")
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		httpOpts = append(httpOpts, http.WithReplay(replay))
+	}
+
+	httpDriver := http.NewDriver(httpOpts...)
 
 	ctx = drivers.WithContext(
 		ctx,
@@ -39,6 +110,10 @@ func (opts Options) WithContext(ctx context.Context) (context.Context, context.C
 		cdpOpts = append(cdpOpts, cdp.WithKeepCookies())
 	}
 
+	if opts.Robots {
+		cdpOpts = append(cdpOpts, cdp.WithRobots())
+	}
+
 	cdpDriver := cdp.NewDriver(cdpOpts...)
 
 	ctx = drivers.WithContext(
@@ -46,5 +121,79 @@ func (opts Options) WithContext(ctx context.Context) (context.Context, context.C
 		cdpDriver,
 	)
 
-	return context.WithCancel(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+
+	if jar == nil && recorder == nil {
+		return ctx, cancel
+	}
+
+	return ctx, func() {
+		cancel()
+
+		// cookies are saved, so that the next run continues the session
+		if jar != nil {
+			if err := jar.Save(opts.CookieJar); err != nil {
+				fmt.Println("Failed to save cookies")
+				fmt.Println(err)
+			}
+		}
+
+		if recorder != nil {
+			if err := recorder.Save(opts.HTTPRecord); err != nil {
+				fmt.Println("Failed to save recorded requests")
+				fmt.Println(err)
+			}
+		}
+	}
+}
+
+func (opts Options) tlsOptions() []http.Option {
+	res := make([]http.Option, 0, 3)
+
+	if opts.HTTPCA != "" {
+		pool, err := http.LoadCertPool(strings.Split(opts.HTTPCA, ",")...)
+
+		if err != nil {
+			fmt.Println("Failed to load CA certificates")
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		res = append(res, http.WithRootCAs(pool))
+	}
+
+	if opts.HTTPCert != "" {
+		cert, err := tls.LoadX509KeyPair(opts.HTTPCert, opts.HTTPKey)
+
+		if err != nil {
+			fmt.Println("Failed to load a client certificate")
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		res = append(res, http.WithClientCertificates(cert))
+	}
+
+	switch opts.HTTPInsecure {
+	case "":
+	case "*":
+		res = append(res, http.WithInsecureSkipVerify())
+	default:
+		res = append(res, http.WithInsecureSkipVerify(strings.Split(opts.HTTPInsecure, ",")...))
+	}
+
+	return res
+}
+
+func toCacheMode(mode string) (http.CacheMode, error) {
+	switch mode {
+	case "", "default":
+		return http.CacheModeDefault, nil
+	case "force":
+		return http.CacheModeForce, nil
+	case "offline":
+		return http.CacheModeOffline, nil
+	default:
+		return http.CacheModeDefault, errors.Errorf("unknown HTTP cache mode: %s", mode)
+	}
 }
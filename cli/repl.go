@@ -104,6 +104,15 @@ func Repl(version string, opts Options) {
 			return
 		}
 
+		if opts.Explain {
+			if err := explain(ferret, query, opts.ExplainFormat); err != nil {
+				fmt.Println("Failed to explain the query")
+				fmt.Println(err)
+			}
+
+			continue
+		}
+
 		program, err := ferret.Compile(query)
 
 		if err != nil {
@@ -136,6 +145,8 @@ func Repl(version string, opts Options) {
 			fmt.Println(timer.Print())
 		}
 	}
+
+	exit()
 }
 
 func fqlLiterals() (literals []string) {
@@ -39,11 +39,11 @@ func getTopTenTrendingTopics() ([]*Topic, error) {
 			LIMIT 10
 			LET url = ELEMENT(el, "a")
 			LET name = ELEMENT(el, ".f3")
-			LET desc = ELEMENT(el, ".f5")
+			LET description = ELEMENT(el, ".f5")
 
 			RETURN {
 				name: TRIM(name.innerText),
-				description: TRIM(desc.innerText),
+				description: TRIM(description.innerText),
 				url: "https://github.com" + url.attributes.href
 			}
 	`
@@ -72,6 +72,66 @@ var (
 		"keep cookies between queries (i.e. do not open tabs in incognito mode)",
 	)
 
+	cookieJar = flag.String(
+		"cookie-jar",
+		"",
+		"path to a JSON file to load cookies of static pages from and save them to after execution",
+	)
+
+	httpCache = flag.String(
+		"http-cache",
+		"",
+		"directory to cache responses of static pages in",
+	)
+
+	httpCacheMode = flag.String(
+		"http-cache-mode",
+		"default",
+		"how cached responses are used (default - while fresh, force - always, offline - never send requests)",
+	)
+
+	httpCA = flag.String(
+		"http-ca",
+		"",
+		"comma separated paths to PEM files of CA certificates trusted by the HTTP driver in addition to the system ones",
+	)
+
+	httpCert = flag.String(
+		"http-cert",
+		"",
+		"path to a PEM file of a client certificate presented by the HTTP driver",
+	)
+
+	httpKey = flag.String(
+		"http-key",
+		"",
+		"path to a PEM file of a private key of the client certificate",
+	)
+
+	httpInsecure = flag.String(
+		"http-insecure",
+		"",
+		"comma separated hosts whose certificates are not verified by the HTTP driver, * for all hosts",
+	)
+
+	httpRecord = flag.String(
+		"http-record",
+		"",
+		"path to a HAR file to record requests of the HTTP driver to",
+	)
+
+	httpReplay = flag.String(
+		"http-replay",
+		"",
+		"path to a HAR file to replay responses of the HTTP driver from without network access",
+	)
+
+	robots = flag.Bool(
+		"robots",
+		false,
+		"honour robots.txt of visited hosts",
+	)
+
 	proxyAddress = flag.String(
 		"proxy",
 		"",
@@ -90,6 +150,18 @@ var (
 		"show how much time was taken to execute a query",
 	)
 
+	explain = flag.Bool(
+		"explain",
+		false,
+		"show the query plan instead of executing the query",
+	)
+
+	explainFormat = flag.String(
+		"explain-format",
+		cli.ExplainFormatText,
+		"format of the query plan (text, json)",
+	)
+
 	showVersion = flag.Bool(
 		"version",
 		false,
@@ -160,12 +232,24 @@ func main() {
 	}
 
 	opts := cli.Options{
-		Cdp:         cdpConn,
-		Params:      p,
-		Proxy:       *proxyAddress,
-		UserAgent:   *userAgent,
-		ShowTime:    *showTime,
-		KeepCookies: *cdpKeepCookies,
+		Cdp:           cdpConn,
+		Params:        p,
+		Proxy:         *proxyAddress,
+		UserAgent:     *userAgent,
+		ShowTime:      *showTime,
+		KeepCookies:   *cdpKeepCookies,
+		CookieJar:     *cookieJar,
+		Robots:        *robots,
+		HTTPCache:     *httpCache,
+		HTTPCacheMode: *httpCacheMode,
+		HTTPCA:        *httpCA,
+		HTTPCert:      *httpCert,
+		HTTPKey:       *httpKey,
+		HTTPInsecure:  *httpInsecure,
+		HTTPRecord:    *httpRecord,
+		HTTPReplay:    *httpReplay,
+		Explain:       *explain,
+		ExplainFormat: *explainFormat,
 	}
 
 	stat, _ := os.Stdin.Stat()
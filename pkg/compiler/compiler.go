@@ -11,14 +11,25 @@ import (
 	"github.com/pkg/errors"
 )
 
+const cacheFunctionName = "CACHE"
+
 var fnNameValidation = regexp.MustCompile("^[a-zA-Z]+[a-zA-Z0-9_]*(::[a-zA-Z]+[a-zA-Z0-9_]*)*$")
 
+// functions of the standard library which can reduce their own results along with the rest of values,
+// that allows to calculate running aggregates of windows incrementally
+var incrementalFunctions = []string{"SUM", "MIN", "MAX"}
+
 type FqlCompiler struct {
-	funcs map[string]core.Function
+	funcs       map[string]core.Function
+	cacheable   map[string]bool
+	incremental map[string]bool
 }
 
 func New(setters ...Option) *FqlCompiler {
-	c := &FqlCompiler{}
+	c := &FqlCompiler{
+		cacheable:   make(map[string]bool),
+		incremental: make(map[string]bool),
+	}
 	opts := &Options{}
 
 	for _, setter := range setters {
@@ -27,6 +38,10 @@ func New(setters ...Option) *FqlCompiler {
 
 	if !opts.noStdlib {
 		c.funcs = stdlib.NewLib()
+
+		for _, name := range incrementalFunctions {
+			c.incremental[name] = true
+		}
 	} else {
 		c.funcs = make(map[string]core.Function)
 	}
@@ -46,13 +61,32 @@ func (c *FqlCompiler) RegisterFunction(name string, fun core.Function) error {
 		return errors.Errorf("invalid function name: %s", name)
 	}
 
+	// calls of CACHE are compiled into a special expression, so such a function would never be called
+	if strings.ToUpper(name) == cacheFunctionName {
+		return errors.Errorf("reserved function name: %s", name)
+	}
+
 	c.funcs[strings.ToUpper(name)] = fun
 
 	return nil
 }
 
+// RegisterCacheableFunction registers a function whose results are reused within a run
+// for calls with arguments having equal hashes.
+func (c *FqlCompiler) RegisterCacheableFunction(name string, fun core.Function) error {
+	if err := c.RegisterFunction(name, fun); err != nil {
+		return err
+	}
+
+	c.cacheable[strings.ToUpper(name)] = true
+
+	return nil
+}
+
 func (c *FqlCompiler) RemoveFunction(name string) {
 	delete(c.funcs, strings.ToUpper(name))
+	delete(c.cacheable, strings.ToUpper(name))
+	delete(c.incremental, strings.ToUpper(name))
 }
 
 func (c *FqlCompiler) RegisterFunctions(funcs map[string]core.Function) error {
@@ -88,8 +122,34 @@ func (c *FqlCompiler) RegisteredFunctionsNS(namespace string) []string {
 }
 
 func (c *FqlCompiler) Compile(query string) (program *runtime.Program, err error) {
+	program, _, err = c.compile(query)
+
+	return program, err
+}
+
+// Explain compiles a given query and returns a description of how it is going to be executed.
+func (c *FqlCompiler) Explain(query string) (*Explanation, error) {
+	program, l, err := c.compile(query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := l.decisions
+
+	if decisions == nil {
+		decisions = make([]*Decision, 0)
+	}
+
+	return &Explanation{
+		Plan:      program.Explain(),
+		Decisions: decisions,
+	}, nil
+}
+
+func (c *FqlCompiler) compile(query string) (program *runtime.Program, l *visitor, err error) {
 	if query == "" {
-		return nil, ErrEmptyQuery
+		return nil, nil, ErrEmptyQuery
 	}
 
 	defer func() {
@@ -111,7 +171,7 @@ func (c *FqlCompiler) Compile(query string) (program *runtime.Program, err error
 	p := parser.New(query)
 	p.AddErrorListener(&errorListener{})
 
-	l := newVisitor(query, c.funcs)
+	l = newVisitor(query, c.funcs, c.cacheable, c.incremental)
 
 	res := p.Visit(l).(*result)
 
@@ -121,7 +181,7 @@ func (c *FqlCompiler) Compile(query string) (program *runtime.Program, err error
 		err = res.Error()
 	}
 
-	return program, err
+	return program, l, err
 }
 
 func (c *FqlCompiler) MustCompile(query string) *runtime.Program {
@@ -0,0 +1,162 @@
+package compiler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newCountingFunction returns a function that returns the number of its calls.
+func newCountingFunction() core.Function {
+	var counter int
+
+	return func(_ context.Context, _ ...core.Value) (core.Value, error) {
+		counter++
+
+		return values.NewInt(counter), nil
+	}
+}
+
+func TestCache(t *testing.T) {
+	Convey("Should call a function each time without cache options", t, func() {
+		c := compiler.New()
+		c.RegisterFunction("CALL", newCountingFunction())
+
+		out, err := c.MustCompile(`
+			LET a = CALL(1)
+			LET b = CALL(1)
+
+			RETURN [a, b]
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,2]`)
+	})
+
+	Convey("Should reuse results of functions given to runtime.WithCache", t, func() {
+		c := compiler.New()
+		c.RegisterFunction("CALL", newCountingFunction())
+
+		p := c.MustCompile(`
+			LET a = CALL(1)
+			LET b = CALL(1)
+			LET c = CALL(2)
+			LET d = (FOR i IN [2, 1] RETURN CALL(i))
+
+			RETURN [a, b, c, d]
+		`)
+
+		out, err := p.Run(context.Background(), runtime.WithCache("call"))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,1,2,[2,1]]`)
+
+		Convey("Should not share results between runs", func() {
+			out, err := p.Run(context.Background(), runtime.WithCache("CALL"))
+
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, `[3,3,4,[4,3]]`)
+		})
+	})
+
+	Convey("Should reuse results of cacheable functions", t, func() {
+		c := compiler.New()
+		So(c.RegisterCacheableFunction("CALL", newCountingFunction()), ShouldBeNil)
+
+		out, err := c.MustCompile(`
+			LET a = CALL("foo")
+			LET b = CALL("foo")
+			LET c = CALL("bar")
+
+			RETURN [a, b, c]
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,1,2]`)
+	})
+
+	Convey("Should compile CACHE(key, ttl, expression)", t, func() {
+		c := compiler.New()
+		c.RegisterFunction("CALL", newCountingFunction())
+
+		out, err := c.MustCompile(`
+			FOR i IN 1..3
+				RETURN [CACHE("foo", 0, CALL()), CACHE(i % 2, 0, CALL())]
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[1,2],[1,3],[1,2]]`)
+	})
+
+	Convey("Should expire results of CACHE after ttl", t, func() {
+		c := compiler.New()
+		c.RegisterFunction("CALL", newCountingFunction())
+
+		out, err := c.MustCompile(`
+			LET a = CACHE("foo", 10, CALL())
+			LET b = CACHE("foo", 10, CALL())
+
+			WAIT(20)
+
+			LET c = CACHE("foo", 10, CALL())
+
+			RETURN [a, b, c]
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,1,2]`)
+	})
+
+	Convey("Should keep cached resources open until the end of the run", t, func() {
+		registry := &testResources{}
+		c := newResourceCompiler(registry)
+
+		out, err := c.MustCompile(`
+			FOR i IN 1..3
+				LET r = CACHE("resource", 0, OPEN())
+				RETURN IS_OPEN(r)
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[true,true,true]`)
+		So(registry.opened, ShouldEqual, 1)
+		So(registry.open, ShouldEqual, 0)
+	})
+
+	Convey("Should not compile CACHE with a wrong number of arguments", t, func() {
+		c := compiler.New()
+
+		_, err := c.Compile(`
+			RETURN CACHE("foo", 1)
+		`)
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should return an error when ttl is not a number", t, func() {
+		c := compiler.New()
+
+		_, err := c.MustCompile(`
+			RETURN CACHE("foo", "bar", 1)
+		`).Run(context.Background())
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should not register a function named CACHE", t, func() {
+		c := compiler.New()
+		fn := func(_ context.Context, _ ...core.Value) (core.Value, error) {
+			return values.None, nil
+		}
+
+		So(c.RegisterFunction("CACHE", fn), ShouldNotBeNil)
+		So(c.RegisterFunction("cache", fn), ShouldNotBeNil)
+		So(c.RegisterCacheableFunction("Cache", fn), ShouldNotBeNil)
+		So(c.RegisterFunction("X::CACHE", fn), ShouldBeNil)
+	})
+}
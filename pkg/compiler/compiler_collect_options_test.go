@@ -0,0 +1,211 @@
+package compiler_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCollectOptions(t *testing.T) {
+	Convey("Should stream groups of a sorted data source", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [1, 1, 2, 3, 3, 3, 1]
+				COLLECT n = i INTO g = i * 10 OPTIONS { method: "sorted" }
+				RETURN { n, g }
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[{"g":[10,10],"n":1},{"g":[20],"n":2},{"g":[30,30,30],"n":3},{"g":[10],"n":1}]`)
+	})
+
+	Convey("Should count and aggregate groups of a sorted data source", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET users = [
+				{ gender: "f", age: 25 },
+				{ gender: "f", age: 45 },
+				{ gender: "m", age: 36 }
+			]
+			LET counts = (
+				FOR u IN users
+					COLLECT gender = u.gender WITH COUNT INTO total OPTIONS { method: "sorted" }
+					RETURN { gender, total }
+			)
+			LET ages = (
+				FOR u IN users
+					COLLECT gender = u.gender AGGREGATE minAge = MIN(u.age), maxAge = MAX(u.age) OPTIONS { method: "sorted" }
+					RETURN { gender, minAge, maxAge }
+			)
+			RETURN { counts, ages }
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `{"ages":[{"gender":"f","maxAge":45,"minAge":25},{"gender":"m","maxAge":36,"minAge":36}],"counts":[{"gender":"f","total":2},{"gender":"m","total":1}]}`)
+	})
+
+	Convey("Should group an unsorted data source using a hash table", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [3, 1, 2, 3, 1, 3]
+				COLLECT n = i WITH COUNT INTO total OPTIONS { method: "hash" }
+				RETURN [n, total]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[3,3],[1,2],[2,1]]`)
+	})
+
+	Convey("Should spill groups to disk when the hash table is full", t, func() {
+		dir, err := ioutil.TempDir("", "ferret-collect-")
+
+		So(err, ShouldBeNil)
+
+		defer os.RemoveAll(dir)
+
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET groups = (
+				FOR i IN 1..100
+					COLLECT n = i % 10 INTO items = i OPTIONS { method: "hash" }
+					RETURN { n, items }
+			)
+			FOR g IN groups
+				SORT g.n
+				RETURN [g.n, LENGTH(g.items), SUM(g.items)]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background(), runtime.WithSpill(3, dir))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[0,10,550],[1,10,460],[2,10,470],[3,10,480],[4,10,490],[5,10,500],[6,10,510],[7,10,520],[8,10,530],[9,10,540]]`)
+
+		files, err := ioutil.ReadDir(dir)
+
+		So(err, ShouldBeNil)
+		So(files, ShouldBeEmpty)
+	})
+
+	Convey("Should limit a number of groups separately from the spill threshold", t, func() {
+		dir, err := ioutil.TempDir("", "ferret-collect-")
+
+		So(err, ShouldBeNil)
+
+		defer os.RemoveAll(dir)
+
+		c := compiler.New()
+
+		c.RegisterFunction("SPILLED", func(_ context.Context, _ ...core.Value) (core.Value, error) {
+			files, err := ioutil.ReadDir(dir)
+
+			return values.NewInt(len(files)), err
+		})
+
+		p, err := c.Compile(`
+			FOR i IN [1, 2, 3, 1, 2, 3]
+				COLLECT n = i OPTIONS { method: "hash" }
+				RETURN [n, SPILLED()]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background(), runtime.WithSpill(1000, dir))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[1,0],[2,0],[3,0]]`)
+
+		out, err = p.Run(context.Background(), runtime.WithSpill(1000, dir), runtime.WithSpillGroupLimit(1))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[1,1],[2,1],[3,0]]`)
+
+		out, err = p.Run(context.Background(), runtime.WithSpillGroupLimit(2), runtime.WithSpill(1000, dir))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[1,1],[2,1],[3,0]]`)
+	})
+
+	Convey("Should aggregate spilled groups", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET groups = (
+				FOR i IN 1..100
+					COLLECT n = i % 4 AGGREGATE total = SUM(i), cnt = LENGTH(i) OPTIONS { method: "hash" }
+					RETURN [n, total, cnt]
+			)
+			FOR g IN groups
+				SORT g[0]
+				RETURN g
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background(), runtime.WithSpill(1, ""))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[0,1300,25],[1,1225,25],[2,1250,25],[3,1275,25]]`)
+	})
+
+	Convey("Should not compile unknown options", t, func() {
+		c := compiler.New()
+
+		_, err := c.Compile(`
+			FOR i IN [1, 2]
+				COLLECT n = i OPTIONS { method: "tree" }
+				RETURN n
+		`)
+
+		So(err, ShouldNotBeNil)
+
+		_, err = c.Compile(`
+			FOR i IN [1, 2]
+				COLLECT n = i OPTIONS { size: 1 }
+				RETURN n
+		`)
+
+		So(err, ShouldNotBeNil)
+
+		_, err = c.Compile(`
+			LET method = "hash"
+			FOR i IN [1, 2]
+				COLLECT n = i OPTIONS { method }
+				RETURN n
+		`)
+
+		So(err, ShouldNotBeNil)
+
+		_, err = c.Compile(`
+			FOR i IN [1, 2]
+				COLLECT n = i SETTINGS { method: "hash" }
+				RETURN n
+		`)
+
+		So(err, ShouldNotBeNil)
+	})
+}
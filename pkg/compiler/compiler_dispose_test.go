@@ -0,0 +1,215 @@
+package compiler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type (
+	testResourceType struct{}
+
+	testResource struct {
+		registry *testResources
+		closed   bool
+	}
+
+	testResources struct {
+		opened int
+		open   int
+	}
+)
+
+func (t testResourceType) ID() int64 {
+	return 100
+}
+
+func (t testResourceType) String() string {
+	return "TestResource"
+}
+
+func (t testResourceType) Equals(other core.Type) bool {
+	return other.ID() == t.ID()
+}
+
+func (r *testResource) MarshalJSON() ([]byte, error) {
+	return []byte(`"resource"`), nil
+}
+
+func (r *testResource) Type() core.Type {
+	return testResourceType{}
+}
+
+func (r *testResource) String() string {
+	return "resource"
+}
+
+func (r *testResource) Compare(_ core.Value) int64 {
+	return 0
+}
+
+func (r *testResource) Unwrap() interface{} {
+	return r
+}
+
+func (r *testResource) Hash() uint64 {
+	return 0
+}
+
+func (r *testResource) Copy() core.Value {
+	return r
+}
+
+func (r *testResource) Close() error {
+	if r.closed {
+		return core.Error(core.ErrInvalidOperation, "resource is already closed")
+	}
+
+	r.closed = true
+	r.registry.open--
+
+	return nil
+}
+
+// newResourceCompiler returns a compiler with functions that open resources
+// and report how many of them are open at the moment.
+func newResourceCompiler(registry *testResources) *compiler.FqlCompiler {
+	c := compiler.New()
+
+	c.RegisterFunction("OPEN", func(_ context.Context, _ ...core.Value) (core.Value, error) {
+		registry.opened++
+		registry.open++
+
+		return &testResource{registry: registry}, nil
+	})
+
+	c.RegisterFunction("OPENED", func(_ context.Context, _ ...core.Value) (core.Value, error) {
+		return values.NewInt(registry.open), nil
+	})
+
+	c.RegisterFunction("IS_OPEN", func(_ context.Context, args ...core.Value) (core.Value, error) {
+		r, ok := args[0].(*testResource)
+
+		return values.NewBoolean(ok && !r.closed), nil
+	})
+
+	return c
+}
+
+func TestDispose(t *testing.T) {
+	Convey("Should close resources of an iteration when it ends", t, func() {
+		registry := &testResources{}
+		c := newResourceCompiler(registry)
+
+		out, err := c.MustCompile(`
+			FOR i IN 1..3
+				LET r = OPEN()
+				RETURN OPENED()
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,1,1]`)
+		So(registry.opened, ShouldEqual, 3)
+		So(registry.open, ShouldEqual, 0)
+	})
+
+	Convey("Should close resources of filtered out iterations", t, func() {
+		registry := &testResources{}
+		c := newResourceCompiler(registry)
+
+		out, err := c.MustCompile(`
+			LET res = (
+				FOR i IN 1..3
+					LET r = OPEN()
+					FILTER i > 5
+					RETURN r
+			)
+
+			RETURN OPENED()
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `0`)
+		So(registry.open, ShouldEqual, 0)
+	})
+
+	Convey("Should keep resources which escape into the result", t, func() {
+		registry := &testResources{}
+		c := newResourceCompiler(registry)
+
+		out, err := c.MustCompile(`
+			LET res = (
+				FOR i IN 1..3
+					LET r = OPEN()
+					RETURN { i, r }
+			)
+
+			LET open = (FOR item IN res RETURN IS_OPEN(item.r))
+
+			RETURN [OPENED(), open]
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[3,[true,true,true]]`)
+		So(registry.open, ShouldEqual, 0)
+	})
+
+	Convey("Should keep resources declared outside of a loop", t, func() {
+		registry := &testResources{}
+		c := newResourceCompiler(registry)
+
+		out, err := c.MustCompile(`
+			LET list = (FOR i IN 1..2 RETURN OPEN())
+			LET first = (FOR r IN list LET alias = r RETURN IS_OPEN(alias))
+			LET second = (FOR r IN list RETURN IS_OPEN(r))
+
+			RETURN [first, second]
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[true,true],[true,true]]`)
+		So(registry.open, ShouldEqual, 0)
+	})
+
+	Convey("Should close resources of nested loops", t, func() {
+		registry := &testResources{}
+		c := newResourceCompiler(registry)
+
+		out, err := c.MustCompile(`
+			FOR i IN 1..2
+				LET outer = OPEN()
+				FOR j IN 1..2
+					LET inner = OPEN()
+					RETURN OPENED()
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[2,2,2,2]`)
+		So(registry.opened, ShouldEqual, 6)
+		So(registry.open, ShouldEqual, 0)
+	})
+
+	Convey("Should close resources escaping a nested loop when an outer iteration ends", t, func() {
+		registry := &testResources{}
+		c := newResourceCompiler(registry)
+
+		out, err := c.MustCompile(`
+			FOR i IN 1..2
+				LET outer = OPEN()
+				LET inner = (
+					FOR j IN 1..2
+						LET r = OPEN()
+						RETURN r
+				)
+				RETURN OPENED()
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[3,3]`)
+		So(registry.open, ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,99 @@
+package compiler_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func findPlanNode(node *core.PlanNode, typeName string) *core.PlanNode {
+	if node.Type == typeName {
+		return node
+	}
+
+	for _, child := range node.Children {
+		if found := findPlanNode(child, typeName); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+func TestExplain(t *testing.T) {
+	Convey("Should describe a query plan", t, func() {
+		c := compiler.New()
+
+		explanation, err := c.Explain(`
+			FOR i IN [1, 2, 3]
+				FILTER i > 1
+				RETURN LENGTH([i])
+		`)
+
+		So(err, ShouldBeNil)
+		So(explanation.Decisions, ShouldBeEmpty)
+
+		forNode := findPlanNode(explanation.Plan, "ForExpression")
+
+		So(forNode, ShouldNotBeNil)
+		So(forNode.Line, ShouldEqual, 2)
+
+		filter := findPlanNode(forNode, "FilterClause")
+
+		So(filter, ShouldNotBeNil)
+		So(filter.Line, ShouldEqual, 3)
+
+		fn := findPlanNode(forNode, "FunctionCallExpression")
+
+		So(fn, ShouldNotBeNil)
+		So(fn.Attributes["name"], ShouldEqual, "LENGTH")
+
+		So(explanation.String(), ShouldContainSubstring, "FilterClause at 3:4")
+	})
+
+	Convey("Should describe applied optimizations", t, func() {
+		c := compiler.New()
+
+		explanation, err := c.Explain(`
+			FOR i IN [3, 1, 2]
+				SORT i DESC
+				LIMIT 2
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+		So(explanation.Decisions, ShouldHaveLength, 1)
+		So(explanation.Decisions[0].Rule, ShouldEqual, "top-k")
+		So(explanation.Decisions[0].Line, ShouldEqual, 4)
+
+		limit := findPlanNode(explanation.Plan, "LimitClause")
+
+		So(limit, ShouldNotBeNil)
+		So(limit.Attributes["optimization"], ShouldEqual, "top-k")
+	})
+
+	Convey("Should marshal a query plan to JSON", t, func() {
+		c := compiler.New()
+
+		explanation, err := c.Explain(`RETURN 1`)
+
+		So(err, ShouldBeNil)
+
+		out, err := json.Marshal(explanation)
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldContainSubstring, `"decisions":[]`)
+		So(string(out), ShouldContainSubstring, `"type":"ReturnExpression"`)
+	})
+
+	Convey("Should return a compilation error", t, func() {
+		c := compiler.New()
+
+		_, err := c.Explain(`FOR i IN RETURN i`)
+
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -248,6 +248,50 @@ func TestFor(t *testing.T) {
 
 		So(string(out), ShouldEqual, `[1,2,3,4]`)
 	})
+
+	Convey("Should resolve variables of outer loops and statements", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET base = 10
+			FOR i IN [1, 2]
+				LET x = i * base
+				FOR j IN [3, 4]
+					FILTER j > i
+					LET y = x + j
+					LIMIT base
+					RETURN [i, j, y]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `[[1,3,13],[1,4,14],[2,3,23],[2,4,24]]`)
+	})
+
+	Convey("Should resolve shadowed variables", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [1, 2]
+				LET x = (
+					FOR i IN [i * 10]
+						RETURN i
+				)
+				RETURN [i, x[0]]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `[[1,10],[2,20]]`)
+	})
 }
 
 func BenchmarkForEmpty(b *testing.B) {
@@ -459,3 +503,17 @@ func BenchmarkForSortDesc(b *testing.B) {
 		p.Run(context.Background())
 	}
 }
+
+func BenchmarkForLarge(b *testing.B) {
+	p := compiler.New().MustCompile(`
+			LET offset = 1
+			FOR i IN 1..100000
+				LET x = i + offset
+				FILTER x % 2 == 0
+				RETURN x
+		`)
+
+	for n := 0; n < b.N; n++ {
+		p.Run(context.Background())
+	}
+}
@@ -0,0 +1,220 @@
+package compiler_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHashJoin(t *testing.T) {
+	Convey("Should join two arrays by equality", t, func() {
+		c := compiler.New()
+
+		query := `
+			LET products = [
+				{ sku: "a", name: "Apple" },
+				{ sku: "b", name: "Banana" },
+				{ sku: 1, name: "One" },
+				{ sku: "c", name: "Cherry" }
+			]
+			LET prices = [
+				{ sku: "b", price: 2 },
+				{ sku: "a", price: 1 },
+				{ sku: 1.0, price: 10 },
+				{ sku: "b", price: 3 },
+				{ sku: "x", price: 100 }
+			]
+			FOR p IN products
+				FOR pr, i IN prices
+					FILTER p.sku == pr.sku
+					RETURN { name: p.name, price: pr.price, i }
+		`
+
+		explanation, err := c.Explain(query)
+
+		So(err, ShouldBeNil)
+		So(explanation.Decisions, ShouldHaveLength, 1)
+		So(explanation.Decisions[0].Rule, ShouldEqual, compiler.RuleHashJoin)
+		So(explanation.String(), ShouldContainSubstring, "hash-join at 16:4")
+
+		out, err := c.MustCompile(query).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[{"i":1,"name":"Apple","price":1},{"i":0,"name":"Banana","price":2},{"i":3,"name":"Banana","price":3},{"i":2,"name":"One","price":10}]`)
+	})
+
+	Convey("Should join when the inner value is on the left side", t, func() {
+		c := compiler.New()
+
+		out, err := c.MustCompile(`
+			LET users = [{ id: 1 }, { id: 2 }, { id: 3 }]
+			LET orders = [{ user: 2, total: 5 }, { user: 3, total: 7 }, { user: 2, total: 1 }]
+			FOR u IN users
+				LET totals = (
+					FOR o IN orders
+						FILTER o.user == u.id
+						RETURN o.total
+				)
+				RETURN totals
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[],[5,1],[7]]`)
+	})
+
+	Convey("Should apply other clauses after the join", t, func() {
+		c := compiler.New()
+
+		out, err := c.MustCompile(`
+			FOR a IN [1, 2]
+				FOR b IN @items
+					FILTER b.id == a
+					FILTER b.value > 1
+					SORT b.value DESC
+					LIMIT 2
+					RETURN [a, b.value]
+		`).Run(
+			context.Background(),
+			runtime.WithParam("items", []interface{}{
+				map[string]interface{}{"id": 1, "value": 1},
+				map[string]interface{}{"id": 1, "value": 2},
+				map[string]interface{}{"id": 2, "value": 3},
+				map[string]interface{}{"id": 1, "value": 4},
+				map[string]interface{}{"id": 1, "value": 5},
+			}),
+		)
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[1,5],[1,4],[2,3]]`)
+	})
+
+	Convey("Should fall back for data sources other than arrays", t, func() {
+		c := compiler.New()
+
+		out, err := c.MustCompile(`
+			LET obj = { a: 1, b: 2 }
+			FOR i IN [2, 1]
+				FOR v IN obj
+					FILTER v == i
+					RETURN v
+		`).Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[2,1]`)
+	})
+
+	Convey("Should not reuse an index of a previous run", t, func() {
+		items := values.NewArrayWith(values.NewInt(1))
+
+		c := compiler.New()
+		c.RegisterFunction("ITEMS", func(_ context.Context, _ ...core.Value) (core.Value, error) {
+			return items, nil
+		})
+
+		p := c.MustCompile(`
+			LET items = ITEMS()
+			FOR i IN [1, 2]
+				FOR item IN items
+					FILTER item == i
+					RETURN item
+		`)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1]`)
+
+		items.Push(values.NewInt(2))
+
+		out, err = p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,2]`)
+	})
+
+	Convey("Should not join", t, func() {
+		queries := []string{
+			// the data source depends on the outer loop
+			`FOR a IN [[1]] FOR b IN a FILTER b == a[0] RETURN b`,
+			// the filter uses a function
+			`LET x = [1] FOR a IN [1] FOR b IN x FILTER b == TO_INT(a) RETURN b`,
+			// the filter does not use the outer loop
+			`LET x = [1] FOR a IN [1] FOR b IN x FILTER b == 1 RETURN b`,
+			// the filter is not an equality
+			`LET x = [1] FOR a IN [1] FOR b IN x FILTER b >= a RETURN b`,
+			// the filter is not the first clause
+			`LET x = [1] FOR a IN [1] FOR b IN x LIMIT 1 FILTER b == a RETURN b`,
+		}
+
+		c := compiler.New()
+
+		for _, query := range queries {
+			explanation, err := c.Explain(query)
+
+			So(err, ShouldBeNil)
+			So(explanation.Decisions, ShouldBeEmpty)
+			So(explanation.String(), ShouldContainSubstring, "none")
+		}
+	})
+}
+
+func BenchmarkHashJoin(b *testing.B) {
+	items := make([]string, 0, 1000)
+
+	for i := 0; i < 1000; i++ {
+		items = append(items, fmt.Sprintf(`{ sku: %d }`, i))
+	}
+
+	arr := "[" + strings.Join(items, ",") + "]"
+
+	p := compiler.New().MustCompile(fmt.Sprintf(`
+		LET products = %s
+		LET prices = %s
+		FOR p IN products
+			FOR pr IN prices
+				FILTER p.sku == pr.sku
+				RETURN pr
+	`, arr, arr))
+
+	ctx := context.Background()
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		p.Run(ctx)
+	}
+}
+
+func BenchmarkNestedLoop(b *testing.B) {
+	items := make([]string, 0, 1000)
+
+	for i := 0; i < 1000; i++ {
+		items = append(items, fmt.Sprintf(`{ sku: %d }`, i))
+	}
+
+	arr := "[" + strings.Join(items, ",") + "]"
+
+	p := compiler.New().MustCompile(fmt.Sprintf(`
+		LET products = %s
+		LET prices = %s
+		FOR p IN products
+			FOR pr IN prices
+				FILTER TO_INT(p.sku) == pr.sku
+				RETURN pr
+	`, arr, arr))
+
+	ctx := context.Background()
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		p.Run(ctx)
+	}
+}
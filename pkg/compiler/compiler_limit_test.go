@@ -3,6 +3,7 @@ package compiler_test
 import (
 	"context"
 	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 	. "github.com/smartystreets/goconvey/convey"
@@ -93,4 +94,103 @@ func TestForLimit(t *testing.T) {
 		So(err, ShouldBeNil)
 		So(string(out), ShouldEqual, `[1,4]`)
 	})
+
+	Convey("Should accept expressions as a limit value", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET pageSize = 2
+			LET items = [ 1,2,3,4,5,6,7,8 ]
+			FOR i IN items
+				LIMIT pageSize * 2
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,2,3,4]`)
+	})
+
+	Convey("Should accept expressions as an offset and a limit value", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET items = [ 1,2,3,4,5,6,7,8 ]
+			FOR i IN items
+				LIMIT LENGTH(items) - 3, @count + 1
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background(), runtime.WithParam("count", 1))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[6,7]`)
+	})
+
+	Convey("Should fail when a limit value is not a number", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [ 1,2,3 ]
+				LIMIT "foo"
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+
+		_, err = p.Run(context.Background())
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should not allocate memory for huge limits of sorted items", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [ 3, 1, 2 ]
+				SORT i
+				LIMIT 1000000000
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,2,3]`)
+	})
+
+	Convey("Should handle overflowing and negative limits of sorted items", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET overflow = (
+				FOR i IN [ 3, 1, 2 ]
+					SORT i
+					LIMIT 1, 9223372036854775807
+					RETURN i
+			)
+			LET negative = (
+				FOR i IN [ 3, 1, 2 ]
+					SORT i
+					LIMIT 1, -1
+					RETURN i
+			)
+
+			RETURN { overflow, negative }
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `{"negative":[],"overflow":[2,3]}`)
+	})
 }
@@ -0,0 +1,188 @@
+package compiler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKeywords(t *testing.T) {
+	Convey("Should compile keywords in any case", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			let items = [3, 1, 2]
+
+			for i in items
+				filter i > 1
+				sort i desc
+				Return { value: i, big: i > 2 And true }
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[{"big":true,"value":3},{"big":false,"value":2}]`)
+	})
+
+	Convey("Should allow keywords as property names", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			LET obj = { count: 1, sort: "asc", "end": 3, in: 4 }
+
+			RETURN [obj.count, obj.sort, obj.end, obj["in"]]
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[1,"asc",3,4]`)
+	})
+
+	Convey("Should allow non-structural keywords as variable names", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			LET desc = { text: "foo" }
+			LET count = 1
+			LET end = 2
+			LET all = [3]
+
+			FOR asc IN all
+				SORT asc desc
+				RETURN [desc.text, count, end, asc]
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[["foo",1,2,3]]`)
+	})
+
+	Convey("Should not allow structural keywords as variable names", t, func() {
+		for _, name := range []string{
+			"for", "return", "distinct", "filter", "sort", "limit", "let", "collect", "with", "into", "keep", "aggregate",
+			"window", "switch", "when", "case", "like", "not", "in", "and", "or", "none", "null",
+		} {
+			_, err := compiler.New().Compile(`
+				LET ` + name + ` = 1
+				RETURN 1
+			`)
+
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
+func TestUnicodeIdentifiers(t *testing.T) {
+	Convey("Should compile Unicode identifiers", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			LET café = 1
+			LET город = 2
+			LET 名前 = { größe: café + город }
+
+			RETURN 名前.größe
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `3`)
+	})
+}
+
+func TestNumberLiterals(t *testing.T) {
+	Convey("Should compile integers in different notations", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			RETURN [42, 0x2A, 0b101010, 0o52, 0xff]
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[42,42,42,42,255]`)
+	})
+
+	Convey("Should keep decimal integers with leading zeros decimal", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			RETURN 010
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `10`)
+	})
+
+	Convey("Should compile numbers with digit separators", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			RETURN [1_000_000, 0xFF_FF, 1_000.5, 0.000_5]
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[1000000,65535,1000.5,0.0005]`)
+	})
+
+	Convey("Should compile numbers with digit separators in LIMIT", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			FOR i IN 1..5
+				LIMIT 0x2
+				RETURN i
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[1,2]`)
+	})
+}
+
+func TestStringEscapes(t *testing.T) {
+	Convey("Should replace escape sequences", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			RETURN [
+				"a\nb",
+				"tab\there",
+				'it\'s',
+				"say \"hi\"",
+				"back\\slash",
+				"café",
+				"\u{1F600}",
+				'a''b'
+			]
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `["a\nb","tab\there","it's","say \"hi\"","back\\slash","café","😀","a'b"]`)
+	})
+
+	Convey("Should keep unknown escape sequences", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			RETURN "\d+\.\w"
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `"\\d+\\.\\w"`)
+	})
+
+	Convey("Should keep word boundaries of regular expressions", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			RETURN [REGEXP_TEST("a cat", "\bcat\b"), REGEXP_TEST("concat", "\bcat\b")]
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[true,false]`)
+	})
+
+	Convey("Should replace escaped backslashes", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			RETURN [REGEXP_TEST("42", "\\d"), REGEXP_TEST("\\d", "\\\\d")]
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `[true,true]`)
+	})
+
+	Convey("Should fail on an invalid unicode escape sequence", t, func() {
+		_, err := compiler.New().Compile(`
+			RETURN "\uZZZZ"
+		`)
+
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -3,6 +3,7 @@ package compiler_test
 import (
 	"context"
 	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 	. "github.com/smartystreets/goconvey/convey"
@@ -209,4 +210,136 @@ func TestForSort(t *testing.T) {
 		So(err, ShouldBeNil)
 		So(string(out), ShouldEqual, `[{"u":{"active":true,"age":29,"gender":"f"},"x":"f"},{"u":{"active":true,"age":31,"gender":"f"},"x":"f"},{"u":{"active":true,"age":31,"gender":"m"},"x":"m"},{"u":{"active":true,"age":36,"gender":"m"},"x":"m"}]`)
 	})
+
+	Convey("Should place nulls first", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [ 3, NONE, 1, NONE, 2 ]
+				SORT i DESC NULLS FIRST
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[null,null,3,2,1]`)
+	})
+
+	Convey("Should place nulls last", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [ 3, NONE, 1, 2 ]
+				SORT i nulls last
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[1,2,3,null]`)
+	})
+
+	Convey("Should sort strings case-insensitively", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR s IN [ "b", "C", "a", "B" ]
+				SORT s ASC COLLATE NOCASE
+				RETURN s
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `["a","b","B","C"]`)
+	})
+
+	Convey("Should sort strings in natural order", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR s IN [ "item10", "item2", "item1", NONE ]
+				SORT s DESC NULLS LAST COLLATE NATURAL
+				RETURN s
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `["item10","item2","item1",null]`)
+	})
+
+	Convey("Should not compile unknown sort options", t, func() {
+		c := compiler.New()
+
+		_, err := c.Compile(`
+			FOR s IN [ "a" ]
+				SORT s NULLS MIDDLE
+				RETURN s
+		`)
+
+		So(err, ShouldNotBeNil)
+
+		_, err = c.Compile(`
+			FOR s IN [ "a" ]
+				SORT s COLLATE FOO
+				RETURN s
+		`)
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should return the same result for SORT followed by LIMIT", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET users = [
+				{ name: "a", age: 31 },
+				{ name: "b", age: 25 },
+				{ name: "c", age: 36 },
+				{ name: "d", age: 25 },
+				{ name: "e", age: 31 },
+				{ name: "f", age: 69 }
+			]
+			FOR u IN users
+				SORT u.age DESC
+				LIMIT 1, 3
+				RETURN u.name
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `["c","a","e"]`)
+	})
+
+	Convey("Should sort large data sets using disk", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN 1..30
+				LET x = { value: i % 10 }
+				SORT x.value DESC, i
+				RETURN i
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background(), runtime.WithSpill(7, ""))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[9,19,29,8,18,28,7,17,27,6,16,26,5,15,25,4,14,24,3,13,23,2,12,22,1,11,21,10,20,30]`)
+	})
 }
@@ -0,0 +1,123 @@
+package compiler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSpread(t *testing.T) {
+	Convey("Should spread arrays into an array literal", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET a = [1, 2]
+			LET b = [3, 4]
+
+			RETURN [0, ...a, ...b, 5]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `[0,1,2,3,4,5]`)
+	})
+
+	Convey("Should spread objects into an object literal", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET base = { name: "item", price: 1 }
+
+			RETURN { ...base, price: 2, ...{ currency: "USD" } }
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `{"currency":"USD","name":"item","price":2}`)
+	})
+
+	Convey("Should override spread properties in order", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET base = { price: 1 }
+
+			RETURN { price: 2, ...base }
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `{"price":1}`)
+	})
+
+	Convey("Should spread arrays into function arguments", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET parts = ["b", "c"]
+
+			RETURN CONCAT("a", ...parts, "d")
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `"abcd"`)
+	})
+
+	Convey("Should ignore NONE values", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			RETURN { arr: [1, ...NONE], obj: { a: 1, ...NONE } }
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `{"arr":[1],"obj":{"a":1}}`)
+	})
+
+	Convey("Should fail when spreading a non-array value into an array", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET obj = { a: 1 }
+
+			RETURN [...obj]
+		`)
+
+		So(err, ShouldBeNil)
+
+		_, err = p.Run(context.Background())
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should fail when spreading a non-object value into an object", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			RETURN { ...[1, 2] }
+		`)
+
+		So(err, ShouldBeNil)
+
+		_, err = p.Run(context.Background())
+
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -56,6 +56,89 @@ RETURN %s<!DOCTYPE html>
 	})
 }
 
+func TestTemplateString(t *testing.T) {
+	Convey("Should interpolate expressions", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			LET category = "books"
+			LET pages = [1, 2]
+
+			FOR i IN pages
+				RETURN ` + "`https://site/${category}?page=${i + 1}`" + `
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `["https://site/books?page=2","https://site/books?page=3"]`)
+	})
+
+	Convey("Should interpolate complex expressions", t, func() {
+		out := compiler.New().
+			MustCompile(`
+			LET obj = { name: "foo", tags: ["a", "b"] }
+
+			RETURN ` + "`${UPPER(obj.name)}: ${ LENGTH({ val: \"}\" }) } ${obj.tags}`" + `
+		`).
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `"FOO: 1 [\"a\",\"b\"]"`)
+	})
+
+	Convey("Should support escaping", t, func() {
+		out := compiler.New().
+			MustCompile("RETURN `\\${foo} \\`bar\\``").
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, "\"${foo} `bar`\"")
+	})
+
+	Convey("Should not interpolate raw strings", t, func() {
+		out := compiler.New().
+			MustCompile("LET x = 1 RETURN r`x => \\`${x}\\` \\n`").
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, "\"x =\\u003e `${x}` \\\\n\"")
+	})
+
+	Convey("Should keep escaped ${ for JavaScript template literals", t, func() {
+		out := compiler.New().
+			MustCompile("LET x = 1 RETURN `(y) => \\`\\${y}${x}\\``").
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, "\"(y) =\\u003e `${y}1`\"")
+	})
+
+	Convey("Should render NONE as an empty string", t, func() {
+		out := compiler.New().
+			MustCompile("RETURN `[${NONE}]`").
+			MustRun(context.Background())
+
+		So(string(out), ShouldEqual, `"[]"`)
+	})
+
+	Convey("Should fail on an unknown variable", t, func() {
+		_, err := compiler.New().Compile("RETURN `${foo}`")
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should report a position of an invalid expression", t, func() {
+		_, err := compiler.New().Compile(`
+			LET foo = 1
+
+			RETURN ` + "`value: ${foo +}`" + `
+		`)
+
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "at 4:")
+	})
+
+	Convey("Should fail on an unterminated expression", t, func() {
+		_, err := compiler.New().Compile("RETURN `${foo`")
+
+		So(err, ShouldNotBeNil)
+	})
+}
+
 func BenchmarkStringLiteral(b *testing.B) {
 	p := compiler.New().MustCompile(`
 			RETURN "
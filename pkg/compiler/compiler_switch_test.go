@@ -0,0 +1,131 @@
+package compiler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSwitch(t *testing.T) {
+	Convey("Should compile SWITCH expression", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			FOR i IN [200, 301, 302, 404, 500]
+				RETURN SWITCH i
+					CASE 200: "ok"
+					CASE 301, 302: "redirect"
+					CASE 404: "not found"
+					DEFAULT: "error"
+				END
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `["ok","redirect","redirect","not found","error"]`)
+	})
+
+	Convey("Should return NONE when no case matches and no DEFAULT is given", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET cur = "£"
+
+			RETURN SWITCH cur
+				CASE "$": "USD"
+				CASE "€": "EUR"
+			END
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `null`)
+	})
+
+	Convey("Should compile SWITCH expression with a complex value", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET obj = { category: "books" }
+
+			RETURN SWITCH obj.category
+				CASE "books", "magazines": { type: "print" }
+				DEFAULT: { type: "other" }
+			END
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `{"type":"print"}`)
+	})
+
+}
+
+func TestWhen(t *testing.T) {
+	Convey("Should compile WHEN expression", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			FOR i IN [1, 5, 10, 15]
+				RETURN WHEN
+					CASE i > 10: "large"
+					CASE i > 4: "medium"
+					DEFAULT: "small"
+				END
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `["small","medium","medium","large"]`)
+	})
+
+	Convey("Should match any of conditions in a case", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			FOR i IN [1, 2, 3]
+				RETURN WHEN
+					CASE i == 1, i == 3: "odd"
+					DEFAULT: "even"
+				END
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `["odd","even","odd"]`)
+	})
+
+	Convey("Should be used as an operand", t, func() {
+		c := compiler.New()
+		p, err := c.Compile(`
+			LET i = 2
+
+			RETURN (WHEN CASE i > 1: 10 DEFAULT: 0 END) + 1
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+
+		So(string(out), ShouldEqual, `11`)
+	})
+}
@@ -0,0 +1,173 @@
+package compiler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWindow(t *testing.T) {
+	Convey("Should calculate running aggregates by default", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [1, 2, 3, 4]
+				WINDOW AGGREGATE total = SUM(i), rank = LENGTH(i)
+				RETURN [i, total, rank]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[1,1,1],[2,3,2],[3,6,3],[4,10,4]]`)
+	})
+
+	Convey("Should calculate aggregates of preceding and following rows", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [1, 5, 3, 8]
+				WINDOW { preceding: 1, following: 1 } AGGREGATE low = MIN(i), high = MAX(i), avg = AVERAGE(i)
+				RETURN [low, high, avg]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[1,5,3],[1,5,3],[3,8,5.333333333333333],[3,8,5.5]]`)
+	})
+
+	Convey("Should calculate previous row deltas within partitions", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			LET prices = [
+				{ symbol: "a", price: 10 },
+				{ symbol: "b", price: 100 },
+				{ symbol: "a", price: 12 },
+				{ symbol: "b", price: 90 },
+				{ symbol: "a", price: 11 }
+			]
+			FOR p IN prices
+				WINDOW PARTITION p.symbol { preceding: 1, following: 0 } AGGREGATE prev = FIRST(p.price)
+				WINDOW PARTITION p.symbol AGGREGATE rank = LENGTH(p.price), total = SUM(p.price)
+				RETURN { symbol: p.symbol, delta: p.price - prev, rank, total }
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[{"delta":0,"rank":1,"symbol":"a","total":10},{"delta":0,"rank":1,"symbol":"b","total":100},{"delta":2,"rank":2,"symbol":"a","total":22},{"delta":-10,"rank":2,"symbol":"b","total":190},{"delta":-1,"rank":3,"symbol":"a","total":33}]`)
+	})
+
+	Convey("Should calculate aggregates of all rows of a partition", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [1, 2, 3, 4]
+				SORT i DESC
+				WINDOW PARTITION i % 2 { preceding: "unbounded", following: "unbounded" } AGGREGATE total = SUM(i)
+				FILTER total > 4
+				RETURN [i, total]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[4,6],[2,6]]`)
+	})
+
+	Convey("Should calculate running aggregates incrementally", t, func() {
+		c := compiler.New()
+
+		p, err := c.Compile(`
+			FOR i IN [3, -1, 4, -5, 2]
+				WINDOW { following: 1 } AGGREGATE total = SUM(i), low = MIN(i), high = MAX(i)
+				RETURN [total, low, high]
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[[2,-1,3],[6,-1,4],[1,-5,4],[3,-5,4],[3,-5,4]]`)
+	})
+
+	Convey("Should reuse aggregates of equal windows", t, func() {
+		c := compiler.New()
+		calls := 0
+
+		c.RegisterFunction("COUNTED", func(_ context.Context, args ...core.Value) (core.Value, error) {
+			calls++
+
+			return args[0].(*values.Array).Length(), nil
+		})
+
+		p, err := c.Compile(`
+			FOR i IN [1, 2, 3, 4]
+				WINDOW { preceding: "unbounded", following: "unbounded" } AGGREGATE n = COUNTED(i)
+				RETURN n
+		`)
+
+		So(err, ShouldBeNil)
+
+		out, err := p.Run(context.Background())
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `[4,4,4,4]`)
+		So(calls, ShouldEqual, 1)
+	})
+
+	Convey("Should not compile invalid window bounds", t, func() {
+		c := compiler.New()
+
+		_, err := c.Compile(`
+			FOR i IN [1, 2]
+				WINDOW { preceding: -1 } AGGREGATE total = SUM(i)
+				RETURN total
+		`)
+
+		So(err, ShouldNotBeNil)
+
+		_, err = c.Compile(`
+			FOR i IN [1, 2]
+				WINDOW { preceding: 1, size: 2 } AGGREGATE total = SUM(i)
+				RETURN total
+		`)
+
+		So(err, ShouldNotBeNil)
+
+		_, err = c.Compile(`
+			FOR i IN [1, 2]
+				WINDOW { following: "all" } AGGREGATE total = SUM(i)
+				RETURN total
+		`)
+
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func BenchmarkWindowRunningSum(b *testing.B) {
+	p := compiler.New().MustCompile(`
+		FOR i IN 1..10000
+			WINDOW AGGREGATE total = SUM(i)
+			RETURN total
+	`)
+
+	for n := 0; n < b.N; n++ {
+		p.Run(context.Background())
+	}
+}
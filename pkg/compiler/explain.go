@@ -0,0 +1,64 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+type (
+	// Decision describes an optimization applied by the compiler.
+	Decision struct {
+		Rule        string `json:"rule"`
+		Description string `json:"description"`
+		Line        int    `json:"line"`
+		Column      int    `json:"column"`
+	}
+
+	// Explanation describes how a query is going to be executed:
+	// the compiled expression tree and optimizations applied by the compiler.
+	Explanation struct {
+		Plan      *core.PlanNode `json:"plan"`
+		Decisions []*Decision    `json:"decisions"`
+	}
+)
+
+func (d *Decision) String() string {
+	return fmt.Sprintf("%s at %d:%d: %s", d.Rule, d.Line, d.Column, d.Description)
+}
+
+func (e *Explanation) String() string {
+	var b strings.Builder
+
+	b.WriteString("Plan:\n")
+
+	for _, line := range strings.Split(strings.TrimSuffix(e.Plan.String(), "\n"), "\n") {
+		b.WriteString("  ")
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	b.WriteString("Optimizations:\n")
+
+	if len(e.Decisions) == 0 {
+		b.WriteString("  none\n")
+	}
+
+	for _, d := range e.Decisions {
+		b.WriteString("  ")
+		b.WriteString(d.String())
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (v *visitor) addDecision(rule, description string, src core.SourceMap) {
+	v.decisions = append(v.decisions, &Decision{
+		Rule:        rule,
+		Description: description,
+		Line:        src.Line(),
+		Column:      src.Column(),
+	})
+}
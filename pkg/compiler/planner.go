@@ -0,0 +1,226 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+	"github.com/MontFerret/ferret/pkg/runtime/collections"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/expressions"
+	"github.com/MontFerret/ferret/pkg/runtime/expressions/clauses"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+const (
+	RuleHashJoin = "hash-join"
+	RuleTopK     = "top-k"
+)
+
+type references struct {
+	vars map[string]bool
+	// opaque is true when an expression calls functions or contains template strings,
+	// so that it is unknown which variables it uses and whether it has side effects
+	opaque bool
+}
+
+// doVisitHashJoin returns a hash join data source for a FOR loop that can use it or nil otherwise.
+// A FOR loop can use a hash join if:
+//   - its data source is a variable or a parameter declared outside of any loop;
+//   - its first clause is FILTER comparing two expressions with '==';
+//   - one of the expressions uses the loop variables only, the other one uses variables of outer loops, but not the loop variables;
+//   - none of the expressions calls functions, since they are evaluated fewer times than in a nested loop.
+func (v *visitor) doVisitHashJoin(
+	ctx *fql.ForExpressionContext,
+	src collections.Iterable,
+	srcExp core.Expression,
+	scope *scope,
+	valVarName,
+	keyVarName string,
+) (collections.Iterable, error) {
+	srcCtx := ctx.ForExpressionSource().(*fql.ForExpressionSourceContext)
+
+	if srcCtx.Param() == nil {
+		variableCtx := srcCtx.Variable()
+
+		if variableCtx == nil || !scope.IsGlobalVariable(variableCtx.GetText()) {
+			return nil, nil
+		}
+	}
+
+	bodies := ctx.AllForExpressionBody()
+
+	if len(bodies) == 0 {
+		return nil, nil
+	}
+
+	clauseCtx := bodies[0].(*fql.ForExpressionBodyContext).ForExpressionClause()
+
+	if clauseCtx == nil {
+		return nil, nil
+	}
+
+	filterCtx := clauseCtx.(*fql.ForExpressionClauseContext).FilterClause()
+
+	if filterCtx == nil {
+		return nil, nil
+	}
+
+	exp := filterCtx.(*fql.FilterClauseContext).Expression().(*fql.ExpressionContext)
+	equalityOp := exp.EqualityOperator()
+
+	if equalityOp == nil || equalityOp.GetText() != "==" {
+		return nil, nil
+	}
+
+	left := exp.Expression(0).(*fql.ExpressionContext)
+	right := exp.Expression(1).(*fql.ExpressionContext)
+
+	isLoopVar := func(name string) bool {
+		return name == valVarName || (keyVarName != "" && name == keyVarName)
+	}
+
+	isInner := func(refs *references) bool {
+		if refs.opaque || len(refs.vars) == 0 {
+			return false
+		}
+
+		for name := range refs.vars {
+			if !isLoopVar(name) && !scope.IsGlobalVariable(name) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	isOuter := func(refs *references) bool {
+		if refs.opaque {
+			return false
+		}
+
+		var hasOuter bool
+
+		for name := range refs.vars {
+			if isLoopVar(name) {
+				return false
+			}
+
+			if !scope.IsGlobalVariable(name) {
+				hasOuter = true
+			}
+		}
+
+		return hasOuter
+	}
+
+	leftRefs := collectReferences(left)
+	rightRefs := collectReferences(right)
+
+	var innerCtx, outerCtx *fql.ExpressionContext
+	var innerOnLeft bool
+
+	if isInner(leftRefs) && isOuter(rightRefs) {
+		innerCtx, outerCtx, innerOnLeft = left, right, true
+	} else if isInner(rightRefs) && isOuter(leftRefs) {
+		innerCtx, outerCtx = right, left
+	} else {
+		return nil, nil
+	}
+
+	innerKey, err := v.doVisitExpression(innerCtx, scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the outer key is evaluated in the outer scope
+	outerKey, err := v.doVisitExpression(outerCtx, scope.parent)
+
+	if err != nil {
+		return nil, err
+	}
+
+	filterExp, err := v.doVisitFilterClause(filterCtx.(*fql.FilterClauseContext), scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fallback, err := clauses.NewFilterClause(v.getSourceMap(filterCtx), src, filterExp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	join, err := expressions.NewHashJoinDataSource(
+		v.getSourceMap(srcCtx),
+		valVarName,
+		keyVarName,
+		srcExp,
+		innerKey,
+		outerKey,
+		innerOnLeft,
+		fallback,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v.addDecision(
+		RuleHashJoin,
+		fmt.Sprintf("FOR %s IN %s: hash index by %s", valVarName, srcCtx.GetText(), innerCtx.GetText()),
+		v.getSourceMap(ctx),
+	)
+
+	return join, nil
+}
+
+// doVisitTopK records a decision to use a bounded top-K sort for SORT clause immediately followed by LIMIT clause.
+// The decision itself is made by expressions.ForExpression when the clauses are added.
+func (v *visitor) doVisitTopK(prev, curr *fql.ForExpressionClauseContext) {
+	if prev == nil || prev.SortClause() == nil || curr.LimitClause() == nil {
+		return
+	}
+
+	v.addDecision(
+		RuleTopK,
+		"SORT followed by LIMIT: bounded heap of OFFSET + COUNT items",
+		v.getSourceMap(curr),
+	)
+}
+
+func collectReferences(tree antlr.Tree) *references {
+	refs := &references{
+		vars: make(map[string]bool),
+	}
+
+	walkReferences(tree, refs)
+
+	return refs
+}
+
+func walkReferences(tree antlr.Tree, refs *references) {
+	switch node := tree.(type) {
+	case *fql.VariableContext:
+		refs.vars[node.GetText()] = true
+
+		return
+	case *fql.MemberExpressionContext:
+		refs.vars[node.Identifier().GetText()] = true
+	case *fql.FunctionCallExpressionContext:
+		refs.opaque = true
+
+		return
+	case antlr.TerminalNode:
+		if node.GetSymbol().GetTokenType() == fql.FqlParserTemplateStringLiteral {
+			refs.opaque = true
+		}
+
+		return
+	}
+
+	for i := 0; i < tree.GetChildCount(); i++ {
+		walkReferences(tree.GetChild(i), refs)
+	}
+}
@@ -6,15 +6,20 @@ import (
 )
 
 type (
+	// scope mirrors runtime scopes:
+	// every scope is a runtime scope and variables get their slots in the order of declaration.
 	scope struct {
 		parent *scope
 		vars   map[string]core.Type
+		slots  map[string]int
+		size   int
 	}
 )
 
 func newRootScope() *scope {
 	return &scope{
-		vars: make(map[string]core.Type),
+		vars:  make(map[string]core.Type),
+		slots: make(map[string]int),
 	}
 }
 
@@ -54,6 +59,8 @@ func (s *scope) SetVariable(name string) error {
 
 	// TODO: add type detection
 	s.vars[name] = types.None
+	s.slots[name] = s.size
+	s.size++
 
 	return nil
 }
@@ -66,14 +73,62 @@ func (s *scope) RemoveVariable(name string) error {
 	}
 
 	delete(s.vars, name)
+	delete(s.slots, name)
 
 	return nil
 }
 
 func (s *scope) ClearVariables() {
 	s.vars = make(map[string]core.Type)
+	s.slots = make(map[string]int)
+	s.size = 0
+}
+
+// ResolveVariable returns a position of a given variable:
+// the number of parent scopes to go up and the slot of the variable in that scope.
+// The last returned value is false if the variable is not found
+// or it shadows another variable with the same name, in which case it has to be looked up by name.
+func (s *scope) ResolveVariable(name string) (depth, slot int, ok bool) {
+	curr := s
+
+	for curr != nil {
+		if idx, exists := curr.slots[name]; exists {
+			slot = idx
+			break
+		}
+
+		curr = curr.parent
+		depth++
+	}
+
+	if curr == nil {
+		return 0, 0, false
+	}
+
+	for parent := curr.parent; parent != nil; parent = parent.parent {
+		if _, exists := parent.vars[name]; exists {
+			return 0, 0, false
+		}
+	}
+
+	return depth, slot, true
 }
 
 func (s *scope) Fork() *scope {
 	return newScope(s)
 }
+
+// IsGlobalVariable returns true if a given variable is declared in the root scope.
+func (s *scope) IsGlobalVariable(name string) bool {
+	_, exists := s.vars[name]
+
+	if exists {
+		return s.parent == nil
+	}
+
+	if s.parent != nil {
+		return s.parent.IsGlobalVariable(name)
+	}
+
+	return false
+}
@@ -0,0 +1,138 @@
+package compiler
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// unquoteString removes quotes from a string literal and replaces escape sequences.
+// Supported sequences are \n, \r, \t, \f, \v, \0, \\, \/, quotes, doubled quotes,
+// \uXXXX and \u{X...}. Unknown sequences are kept as is,
+// which allows to write regular expressions without double escaping.
+// \b is kept as well, since it is a word boundary in regular expressions.
+func unquoteString(literal string) (string, error) {
+	quote, _ := utf8.DecodeRuneInString(literal)
+	runes := []rune(literal)
+	runes = runes[1 : len(runes)-1]
+
+	if !strings.ContainsRune(string(runes), '\\') && !strings.ContainsRune(string(runes), quote) {
+		return string(runes), nil
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(runes))
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if r == quote && i+1 < len(runes) && runes[i+1] == quote {
+			sb.WriteRune(quote)
+			i++
+
+			continue
+		}
+
+		if r != '\\' || i+1 >= len(runes) {
+			sb.WriteRune(r)
+
+			continue
+		}
+
+		i++
+		next := runes[i]
+
+		switch next {
+		case 'n':
+			sb.WriteRune('\n')
+		case 'r':
+			sb.WriteRune('\r')
+		case 't':
+			sb.WriteRune('\t')
+		case 'f':
+			sb.WriteRune('\f')
+		case 'v':
+			sb.WriteRune('\v')
+		case '0':
+			sb.WriteRune(0)
+		case '\\', '/', '\'', '"', '`':
+			sb.WriteRune(next)
+		case 'u':
+			cp, size, err := parseUnicodeEscape(runes[i+1:])
+
+			if err != nil {
+				return "", err
+			}
+
+			sb.WriteRune(cp)
+			i += size
+		default:
+			sb.WriteRune(r)
+			sb.WriteRune(next)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// parseUnicodeEscape parses a code point of \uXXXX or \u{X...} sequence
+// and returns it along with a number of consumed runes.
+func parseUnicodeEscape(runes []rune) (rune, int, error) {
+	var digits string
+	var size int
+
+	if len(runes) > 0 && runes[0] == '{' {
+		end := -1
+
+		for i, r := range runes {
+			if r == '}' {
+				end = i
+				break
+			}
+		}
+
+		if end < 2 || end > 7 {
+			return 0, 0, errors.New("invalid unicode escape sequence")
+		}
+
+		digits = string(runes[1:end])
+		size = end + 1
+	} else {
+		if len(runes) < 4 {
+			return 0, 0, errors.New("invalid unicode escape sequence")
+		}
+
+		digits = string(runes[:4])
+		size = 4
+	}
+
+	cp, err := strconv.ParseUint(digits, 16, 32)
+
+	if err != nil || cp > utf8.MaxRune {
+		return 0, 0, errors.Errorf("invalid unicode escape sequence: \\u%s", digits)
+	}
+
+	return rune(cp), size, nil
+}
+
+// parseInteger parses an integer literal in decimal, hexadecimal (0x), binary (0b) or octal (0o) notation.
+// Digits can be separated by underscores.
+func parseInteger(literal string) (int, error) {
+	if len(literal) > 1 && literal[0] == '0' {
+		switch literal[1] {
+		case 'x', 'X', 'b', 'B', 'o', 'O':
+			i, err := strconv.ParseInt(literal, 0, 64)
+
+			return int(i), err
+		}
+	}
+
+	return strconv.Atoi(strings.Replace(literal, "_", "", -1))
+}
+
+// parseFloat parses a float literal whose digits can be separated by underscores.
+func parseFloat(literal string) (float64, error) {
+	return strconv.ParseFloat(strings.Replace(literal, "_", "", -1), 64)
+}
@@ -0,0 +1,139 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type templatePart struct {
+	text   string
+	isExp  bool
+	line   int
+	column int
+}
+
+// splitTemplate splits a template string literal (including its backticks) into
+// static text parts and interpolated expression parts.
+// Positions of expression parts point to their location in the original query.
+// Supported escape sequences are \` for a backtick and \$ for a dollar sign,
+// the latter can be used to prevent interpolation: \${not an expression}.
+func splitTemplate(src string, line, column int) ([]templatePart, error) {
+	runes := []rune(src)
+	// skip backticks
+	runes = runes[1 : len(runes)-1]
+	column++
+
+	parts := make([]templatePart, 0, 3)
+	var text strings.Builder
+
+	advance := func(r rune) {
+		if r == '\n' {
+			line++
+			column = 0
+		} else {
+			column++
+		}
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if r == '\\' && i+1 < len(runes) && (runes[i+1] == '`' || runes[i+1] == '$') {
+			text.WriteRune(runes[i+1])
+			advance(r)
+			advance(runes[i+1])
+			i++
+
+			continue
+		}
+
+		if r != '$' || i+1 >= len(runes) || runes[i+1] != '{' {
+			text.WriteRune(r)
+			advance(r)
+
+			continue
+		}
+
+		startLine, startColumn := line, column
+
+		// skip ${
+		advance(r)
+		advance(runes[i+1])
+		i += 2
+
+		expLine, expColumn := line, column
+		end, err := findTemplateExpressionEnd(runes, i)
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "at %d:%d", startLine, startColumn)
+		}
+
+		exp := string(runes[i:end])
+
+		if strings.TrimSpace(exp) == "" {
+			return nil, errors.Errorf("empty template expression at %d:%d", startLine, startColumn)
+		}
+
+		if text.Len() > 0 {
+			parts = append(parts, templatePart{text: text.String()})
+			text.Reset()
+		}
+
+		parts = append(parts, templatePart{
+			text:   exp,
+			isExp:  true,
+			line:   expLine,
+			column: expColumn,
+		})
+
+		for ; i <= end; i++ {
+			advance(runes[i])
+		}
+
+		// compensate the loop increment
+		i--
+	}
+
+	if text.Len() > 0 || len(parts) == 0 {
+		parts = append(parts, templatePart{text: text.String()})
+	}
+
+	return parts, nil
+}
+
+// findTemplateExpressionEnd returns an index of a closing brace of an interpolated expression
+// that starts at a given position, skipping nested braces and string literals.
+func findTemplateExpressionEnd(runes []rune, start int) (int, error) {
+	depth := 1
+	var quote rune
+
+	for i := start; i < len(runes); i++ {
+		r := runes[i]
+
+		if quote != 0 {
+			if r == '\\' {
+				i++
+			} else if r == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch r {
+		case '"', '\'':
+			quote = r
+		case '{':
+			depth++
+		case '}':
+			depth--
+
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return -1, errors.New("unterminated template expression")
+}
@@ -1,10 +1,11 @@
 package compiler
 
 import (
+	"context"
 	"fmt"
-	"strconv"
 	"strings"
 
+	"github.com/MontFerret/ferret/pkg/parser"
 	"github.com/MontFerret/ferret/pkg/parser/fql"
 	"github.com/MontFerret/ferret/pkg/runtime"
 	"github.com/MontFerret/ferret/pkg/runtime/collections"
@@ -13,6 +14,8 @@ import (
 	"github.com/MontFerret/ferret/pkg/runtime/expressions/clauses"
 	"github.com/MontFerret/ferret/pkg/runtime/expressions/literals"
 	"github.com/MontFerret/ferret/pkg/runtime/expressions/operators"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/pkg/errors"
 )
@@ -22,16 +25,27 @@ type (
 
 	visitor struct {
 		*fql.BaseFqlParserVisitor
-		src   string
-		funcs map[string]core.Function
+		src         string
+		funcs       map[string]core.Function
+		cacheable   map[string]bool
+		incremental map[string]bool
+		decisions   []*Decision
 	}
 )
 
-func newVisitor(src string, funcs map[string]core.Function) *visitor {
+func newVisitor(
+	src string,
+	funcs map[string]core.Function,
+	cacheable map[string]bool,
+	incremental map[string]bool,
+) *visitor {
 	return &visitor{
 		&fql.BaseFqlParserVisitor{},
 		src,
 		funcs,
+		cacheable,
+		incremental,
+		nil,
 	}
 }
 
@@ -130,7 +144,7 @@ func (v *visitor) doVisitReturnExpression(ctx *fql.ReturnExpressionContext, scop
 	forIn := ctx.ForExpression()
 
 	if forIn != nil {
-		out, err := v.doVisitForExpression(ctx.ForExpression().(*fql.ForExpressionContext), scope.Fork())
+		out, err := v.doVisitForExpression(ctx.ForExpression().(*fql.ForExpressionContext), scope)
 
 		if err != nil {
 			return nil, err
@@ -164,7 +178,8 @@ func (v *visitor) doVisitForExpression(ctx *fql.ForExpressionContext, scope *sco
 	forInScope := scope.Fork()
 
 	srcCtx := ctx.ForExpressionSource().(*fql.ForExpressionSourceContext)
-	srcExp, err := v.doVisitForExpressionSource(srcCtx, forInScope)
+	// the data source is evaluated in the outer scope
+	srcExp, err := v.doVisitForExpressionSource(srcCtx, scope)
 
 	if err != nil {
 		return nil, err
@@ -198,16 +213,33 @@ func (v *visitor) doVisitForExpression(ctx *fql.ForExpressionContext, scope *sco
 		return nil, err
 	}
 
+	bodies := ctx.AllForExpressionBody()
+
+	join, err := v.doVisitHashJoin(ctx, src, srcExp, forInScope, valVarName, keyVarName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the first FILTER clause is a part of the hash join
+	if join != nil {
+		src = join
+		bodies = bodies[1:]
+	}
+
 	// Clauses.
 	// We put clauses parsing before parsing the query body because COLLECT clause overrides scope variables
-	for _, e := range ctx.AllForExpressionBody() {
+	var prevClauseCtx *fql.ForExpressionClauseContext
+
+	for _, e := range bodies {
 		e := e.(*fql.ForExpressionBodyContext)
 		clauseCtx := e.ForExpressionClause()
 		statementCtx := e.ForExpressionStatement()
 
 		if clauseCtx != nil {
+			clauseCtx := clauseCtx.(*fql.ForExpressionClauseContext)
 			setter, err := v.doVisitForExpressionClause(
-				clauseCtx.(*fql.ForExpressionClauseContext),
+				clauseCtx,
 				forInScope,
 				valVarName,
 				keyVarName,
@@ -217,8 +249,13 @@ func (v *visitor) doVisitForExpression(ctx *fql.ForExpressionContext, scope *sco
 				return nil, err
 			}
 
+			v.doVisitTopK(prevClauseCtx, clauseCtx)
+
+			prevClauseCtx = clauseCtx
 			parsedClauses = append(parsedClauses, setter)
 		} else if statementCtx != nil {
+			prevClauseCtx = nil
+
 			exp, err := v.doVisitForExpressionStatement(
 				statementCtx.(*fql.ForExpressionStatementContext),
 				forInScope,
@@ -321,21 +358,7 @@ func (v *visitor) doVisitLimitClause(ctx *fql.LimitClauseContext, scope *scope)
 }
 
 func (v *visitor) doVisitLimitClauseValue(ctx *fql.LimitClauseValueContext, scope *scope) (core.Expression, error) {
-	literalCtx := ctx.IntegerLiteral()
-
-	if literalCtx != nil {
-		i, err := strconv.Atoi(literalCtx.GetText())
-
-		if err != nil {
-			return nil, err
-		}
-
-		return literals.NewIntLiteral(i), nil
-	}
-
-	paramCtx := ctx.Param()
-
-	return v.doVisitParamContext(paramCtx.(*fql.ParamContext), scope)
+	return v.doVisitExpression(ctx.Expression().(*fql.ExpressionContext), scope)
 }
 
 func (v *visitor) doVisitFilterClause(ctx *fql.FilterClauseContext, scope *scope) (core.Expression, error) {
@@ -360,13 +383,13 @@ func (v *visitor) doVisitFilterClause(ctx *fql.FilterClauseContext, scope *scope
 		logicalAndOp := exp.LogicalAndOperator()
 
 		if logicalAndOp != nil {
-			return operators.NewLogicalOperator(v.getSourceMap(ctx), left, right, logicalAndOp.GetText())
+			return operators.NewLogicalOperator(v.getSourceMap(ctx), left, right, strings.ToUpper(logicalAndOp.GetText()))
 		}
 
 		logicalOrOp := exp.LogicalOrOperator()
 
 		if logicalOrOp != nil {
-			return operators.NewLogicalOperator(v.getSourceMap(ctx), left, right, logicalOrOp.GetText())
+			return operators.NewLogicalOperator(v.getSourceMap(ctx), left, right, strings.ToUpper(logicalOrOp.GetText()))
 		}
 	} else {
 		// should be unary operator
@@ -396,9 +419,33 @@ func (v *visitor) doVisitSortClause(ctx *fql.SortClauseContext, scope *scope) ([
 			direction = collections.SortDirectionFromString(dir.GetText())
 		}
 
-		sorterExp, err := clauses.NewSorterExpression(
+		nulls := collections.SortNullsDefault
+		nullsCtx := sortExpCtx.SortClauseNulls()
+
+		if nullsCtx != nil {
+			nulls, err = collections.SortNullsFromString(nullsCtx.(*fql.SortClauseNullsContext).Identifier().GetText())
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		collation := collections.SortCollationDefault
+		collationCtx := sortExpCtx.SortClauseCollation()
+
+		if collationCtx != nil {
+			collation, err = collections.SortCollationFromString(collationCtx.(*fql.SortClauseCollationContext).Identifier().GetText())
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		sorterExp, err := clauses.NewSorterExpressionWith(
 			exp,
 			direction,
+			nulls,
+			collation,
 		)
 
 		if err != nil {
@@ -542,6 +589,17 @@ func (v *visitor) doVisitCollectClause(ctx *fql.CollectClauseContext, scope *sco
 		}
 	}
 
+	method := clauses.CollectMethodDefault
+	optionsCtx := ctx.CollectOptions()
+
+	if optionsCtx != nil {
+		method, err = v.doVisitCollectOptions(optionsCtx.(*fql.CollectOptionsContext))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// clear all variables defined before
 	scope.ClearVariables()
 
@@ -551,7 +609,66 @@ func (v *visitor) doVisitCollectClause(ctx *fql.CollectClauseContext, scope *sco
 		}
 	}
 
-	return clauses.NewCollect(selectors, projection, count, aggregate)
+	return clauses.NewCollectWith(selectors, projection, count, aggregate, method)
+}
+
+// doVisitStaticObjectLiteral evaluates an object literal at compile time.
+// It is used for clause options, which must be constant, since they define how the clause gets executed.
+func (v *visitor) doVisitStaticObjectLiteral(ctx *fql.ObjectLiteralContext) (*values.Object, error) {
+	// the literal is compiled in an empty scope, so that it cannot refer to variables
+	exp, err := v.doVisitObjectLiteral(ctx, newRootScope())
+
+	if err != nil {
+		return nil, err
+	}
+
+	rootScope, closeFn := core.NewRootScope()
+	defer closeFn()
+
+	out, err := exp.Exec(context.Background(), rootScope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out.(*values.Object), nil
+}
+
+// doVisitCollectOptions reads options of a COLLECT clause.
+func (v *visitor) doVisitCollectOptions(ctx *fql.CollectOptionsContext) (clauses.CollectMethod, error) {
+	method := clauses.CollectMethodDefault
+	keyword := ctx.Identifier().GetText()
+
+	if !strings.EqualFold(keyword, "OPTIONS") {
+		return method, core.Error(ErrInvalidToken, keyword)
+	}
+
+	obj, err := v.doVisitStaticObjectLiteral(ctx.ObjectLiteral().(*fql.ObjectLiteralContext))
+
+	if err != nil {
+		return method, err
+	}
+
+	var failure error
+
+	obj.ForEach(func(value core.Value, key string) bool {
+		switch key {
+		case "method":
+			if value.Type() != types.String {
+				failure = core.TypeError(value.Type(), types.String)
+
+				return false
+			}
+
+			method, failure = clauses.CollectMethodFromString(value.String())
+		default:
+			failure = core.Error(core.ErrInvalidArgument, "collect option: "+key)
+		}
+
+		return failure == nil
+	})
+
+	return method, failure
 }
 
 func (v *visitor) doVisitCollectSelector(ctx *fql.CollectSelectorContext, scope *scope) (*clauses.CollectSelector, error) {
@@ -570,7 +687,8 @@ func (v *visitor) doVisitCollectAggregateSelector(ctx *fql.CollectAggregateSelec
 	fnCtx := ctx.FunctionCallExpression()
 
 	if fnCtx != nil {
-		exp, err := v.doVisitFunctionCallExpression(fnCtx.(*fql.FunctionCallExpressionContext), scope)
+		fnCtx := fnCtx.(*fql.FunctionCallExpressionContext)
+		exp, err := v.doVisitFunctionCallExpression(fnCtx, scope)
 
 		if err != nil {
 			return nil, err
@@ -582,7 +700,18 @@ func (v *visitor) doVisitCollectAggregateSelector(ctx *fql.CollectAggregateSelec
 			return nil, core.Error(core.ErrInvalidType, "expected function expression")
 		}
 
-		return clauses.NewCollectAggregateSelector(variable, fnExp.Arguments(), fnExp.Function())
+		for _, arg := range fnExp.Arguments() {
+			if _, spread := arg.(*literals.SpreadElement); spread {
+				return nil, core.Error(core.ErrInvalidArgument, "spread arguments are not supported in aggregate functions")
+			}
+		}
+
+		return clauses.NewCollectAggregateSelectorWith(
+			variable,
+			fnExp.Arguments(),
+			fnExp.Function(),
+			v.incremental[functionName(fnCtx)],
+		)
 	}
 
 	return nil, core.Error(core.ErrNotFound, "function expression")
@@ -638,7 +767,8 @@ func (v *visitor) doVisitForExpressionClause(ctx *fql.ForExpressionClauseContext
 	limitCtx := ctx.LimitClause()
 
 	if limitCtx != nil {
-		limit, offset, err := v.doVisitLimitClause(limitCtx.(*fql.LimitClauseContext), scope)
+		// the limit values are evaluated in the outer scope
+		limit, offset, err := v.doVisitLimitClause(limitCtx.(*fql.LimitClauseContext), scope.parent)
 
 		if err != nil {
 			return nil, err
@@ -693,9 +823,125 @@ func (v *visitor) doVisitForExpressionClause(ctx *fql.ForExpressionClauseContext
 		}, nil
 	}
 
+	windowCtx := ctx.WindowClause()
+
+	if windowCtx != nil {
+		windowCtx := windowCtx.(*fql.WindowClauseContext)
+
+		return v.doVisitWindowClause(windowCtx, scope)
+	}
+
 	return nil, v.unexpectedToken(ctx)
 }
 
+func (v *visitor) doVisitWindowClause(ctx *fql.WindowClauseContext, scope *scope) (func(f *expressions.ForExpression) error, error) {
+	var partition core.Expression
+	var err error
+
+	partitionCtx := ctx.WindowPartition()
+
+	if partitionCtx != nil {
+		exp := partitionCtx.(*fql.WindowPartitionContext).Expression().(*fql.ExpressionContext)
+		partition, err = v.doVisitExpression(exp, scope)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bounds := clauses.DefaultWindowBounds()
+	boundsCtx := ctx.ObjectLiteral()
+
+	if boundsCtx != nil {
+		bounds, err = v.doVisitWindowBounds(boundsCtx.(*fql.ObjectLiteralContext))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	aggrCtx := ctx.CollectAggregator().(*fql.CollectAggregatorContext)
+	selectorCtxs := aggrCtx.AllCollectAggregateSelector()
+	selectors := make([]*clauses.CollectAggregateSelector, 0, len(selectorCtxs))
+
+	for _, sc := range selectorCtxs {
+		selector, err := v.doVisitCollectAggregateSelector(sc.(*fql.CollectAggregateSelectorContext), scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, selector)
+	}
+
+	// aggregated values are added to the variables of each row
+	for _, selector := range selectors {
+		if err := scope.SetVariable(selector.Variable()); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(f *expressions.ForExpression) error {
+		return f.AddWindow(v.getSourceMap(ctx), partition, bounds, selectors)
+	}, nil
+}
+
+// doVisitWindowBounds reads bounds of a WINDOW clause like { preceding: 1, following: "unbounded" }.
+func (v *visitor) doVisitWindowBounds(ctx *fql.ObjectLiteralContext) (clauses.WindowBounds, error) {
+	bounds := clauses.DefaultWindowBounds()
+	obj, err := v.doVisitStaticObjectLiteral(ctx)
+
+	if err != nil {
+		return bounds, err
+	}
+
+	var failure error
+
+	obj.ForEach(func(value core.Value, key string) bool {
+		var bound int
+
+		bound, failure = parseWindowBound(key, value)
+
+		if failure != nil {
+			return false
+		}
+
+		switch key {
+		case "preceding":
+			bounds.Preceding = bound
+		case "following":
+			bounds.Following = bound
+		default:
+			failure = core.Error(core.ErrInvalidArgument, "window option: "+key)
+		}
+
+		return failure == nil
+	})
+
+	return bounds, failure
+}
+
+func parseWindowBound(name string, value core.Value) (int, error) {
+	switch value.Type() {
+	case types.Int:
+		bound := int(value.(values.Int))
+
+		if bound < 0 {
+			return 0, core.Error(core.ErrInvalidArgument, name+" must not be negative")
+		}
+
+		return bound, nil
+	case types.String:
+		if strings.EqualFold(value.String(), "unbounded") {
+			return clauses.WindowUnbounded, nil
+		}
+
+		return 0, core.Error(core.ErrInvalidArgument, name+": "+value.String())
+	default:
+		return 0, core.TypeError(value.Type(), types.Int, types.String)
+	}
+}
+
 func (v *visitor) doVisitForExpressionStatement(ctx *fql.ForExpressionStatementContext, scope *scope) (func(f *expressions.ForExpression) error, error) {
 	variableCtx := ctx.VariableDeclaration()
 
@@ -753,6 +999,13 @@ func (v *visitor) doVisitMemberExpression(ctx *fql.MemberExpressionContext, scop
 			continue
 		}
 
+		// the variable itself
+		_, ok = child.(*fql.IdentifierContext)
+
+		if ok {
+			continue
+		}
+
 		var exp core.Expression
 		var err error
 		var parsed bool
@@ -807,6 +1060,24 @@ func (v *visitor) doVisitObjectLiteral(ctx *fql.ObjectLiteralContext, scope *sco
 
 		assignment := assignment.(*fql.PropertyAssignmentContext)
 
+		if assignment.Ellipsis() != nil {
+			value, err = v.doVisitExpression(assignment.Expression().(*fql.ExpressionContext), scope)
+
+			if err != nil {
+				return nil, err
+			}
+
+			pa, err := literals.NewObjectPropertySpread(value)
+
+			if err != nil {
+				return nil, err
+			}
+
+			props = append(props, pa)
+
+			continue
+		}
+
 		prop := assignment.PropertyName()
 		computedProp := assignment.ComputedPropertyName()
 		shortHand := assignment.ShorthandPropertyName()
@@ -850,15 +1121,24 @@ func (v *visitor) doVisitPropertyNameContext(ctx *fql.PropertyNameContext, _ *sc
 	var name string
 
 	identifier := ctx.Identifier()
+	reserved := ctx.ReservedWord()
 
-	if identifier != nil {
+	switch {
+	case identifier != nil:
 		name = identifier.GetText()
-	} else {
+	case reserved != nil:
+		name = reserved.GetText()
+	default:
 		stringLiteral := ctx.StringLiteral()
 
 		if stringLiteral != nil {
-			runes := []rune(stringLiteral.GetText())
-			name = string(runes[1 : len(runes)-1])
+			text, err := unquoteString(stringLiteral.GetText())
+
+			if err != nil {
+				return nil, err
+			}
+
+			name = text
 		}
 	}
 
@@ -893,11 +1173,12 @@ func (v *visitor) doVisitArrayLiteral(ctx *fql.ArrayLiteralContext, scope *scope
 	}
 
 	list := listCtx.(*fql.ArrayElementListContext)
-	exp := list.AllExpression()
+	exp := list.AllArrayElement()
 	elements := make([]core.Expression, 0, len(exp))
 
 	for _, e := range exp {
-		element, err := v.visit(e, scope)
+		e := e.(*fql.ArrayElementContext)
+		element, err := v.doVisitSpreadable(e.Ellipsis(), e.Expression(), scope)
 
 		if err != nil {
 			return nil, err
@@ -909,8 +1190,22 @@ func (v *visitor) doVisitArrayLiteral(ctx *fql.ArrayLiteralContext, scope *scope
 	return literals.NewArrayLiteralWith(elements...), nil
 }
 
+func (v *visitor) doVisitSpreadable(ellipsis antlr.TerminalNode, ctx fql.IExpressionContext, scope *scope) (core.Expression, error) {
+	exp, err := v.doVisitExpression(ctx.(*fql.ExpressionContext), scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ellipsis == nil {
+		return exp, nil
+	}
+
+	return literals.NewSpreadElement(exp)
+}
+
 func (v *visitor) doVisitFloatLiteral(ctx *fql.FloatLiteralContext) (core.Expression, error) {
-	val, err := strconv.ParseFloat(ctx.GetText(), 64)
+	val, err := parseFloat(ctx.GetText())
 
 	if err != nil {
 		return nil, err
@@ -920,7 +1215,7 @@ func (v *visitor) doVisitFloatLiteral(ctx *fql.FloatLiteralContext) (core.Expres
 }
 
 func (v *visitor) doVisitIntegerLiteral(ctx *fql.IntegerLiteralContext) (core.Expression, error) {
-	val, err := strconv.Atoi(ctx.GetText())
+	val, err := parseInteger(ctx.GetText())
 
 	if err != nil {
 		return nil, err
@@ -929,19 +1224,69 @@ func (v *visitor) doVisitIntegerLiteral(ctx *fql.IntegerLiteralContext) (core.Ex
 	return literals.NewIntLiteral(val), nil
 }
 
-func (v *visitor) doVisitStringLiteral(ctx *fql.StringLiteralContext) (core.Expression, error) {
-	var text string
+func (v *visitor) doVisitStringLiteral(ctx *fql.StringLiteralContext, scope *scope) (core.Expression, error) {
+	if raw := ctx.RawStringLiteral(); raw != nil {
+		text := raw.GetText()
+
+		// remove the prefix and backticks, nothing is interpolated
+		return literals.NewStringLiteral(strings.Replace(text[2:len(text)-1], "\\`", "`", -1)), nil
+	}
 
 	strLiteral := ctx.StringLiteral()
 
-	if strLiteral != nil {
-		text = strLiteral.GetText()
-	} else {
-		text = ctx.TemplateStringLiteral().GetText()
+	if strLiteral == nil {
+		return v.doVisitTemplateStringLiteral(ctx.TemplateStringLiteral(), scope)
+	}
+
+	text, err := unquoteString(strLiteral.GetText())
+
+	if err != nil {
+		return nil, err
 	}
 
-	// remove extra quotes
-	return literals.NewStringLiteral(text[1 : len(text)-1]), nil
+	return literals.NewStringLiteral(text), nil
+}
+
+func (v *visitor) doVisitTemplateStringLiteral(node antlr.TerminalNode, scope *scope) (core.Expression, error) {
+	token := node.GetSymbol()
+	parts, err := splitTemplate(node.GetText(), token.GetLine(), token.GetColumn())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) == 1 && !parts[0].isExp {
+		return literals.NewStringLiteral(parts[0].text), nil
+	}
+
+	exps := make([]core.Expression, 0, len(parts))
+
+	for _, part := range parts {
+		if !part.isExp {
+			exps = append(exps, literals.NewStringLiteral(part.text))
+
+			continue
+		}
+
+		p := parser.NewAt(part.text, part.line, part.column)
+		p.AddErrorListener(&errorListener{})
+
+		expCtx, err := p.VisitExpression()
+
+		if err != nil {
+			return nil, err
+		}
+
+		exp, err := v.doVisitExpression(expCtx, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		exps = append(exps, exp)
+	}
+
+	return literals.NewTemplateLiteral(exps...), nil
 }
 
 func (v *visitor) doVisitBooleanLiteral(ctx *fql.BooleanLiteralContext) (core.Expression, error) {
@@ -962,6 +1307,12 @@ func (v *visitor) doVisitVariable(ctx *fql.VariableContext, scope *scope) (core.
 		return nil, err
 	}
 
+	depth, slot, ok := scope.ResolveVariable(name)
+
+	if ok {
+		return expressions.NewResolvedVariableExpression(v.getSourceMap(ctx), name, depth, slot)
+	}
+
 	return expressions.NewVariableExpression(v.getSourceMap(ctx), name)
 }
 
@@ -1028,14 +1379,19 @@ func (v *visitor) doVisitRangeOperator(ctx *fql.RangeOperatorContext, scope *sco
 }
 
 func (v *visitor) doVisitFunctionCallExpression(context *fql.FunctionCallExpressionContext, scope *scope) (core.Expression, error) {
+	if context.Namespace().GetText() == "" && strings.ToUpper(context.Identifier().GetText()) == cacheFunctionName {
+		return v.doVisitCacheExpression(context, scope)
+	}
+
 	args := make([]core.Expression, 0, 5)
 	argsCtx := context.Arguments()
 
 	if argsCtx != nil {
 		argsCtx := argsCtx.(*fql.ArgumentsContext)
 
-		for _, arg := range argsCtx.AllExpression() {
-			exp, err := v.doVisitExpression(arg.(*fql.ExpressionContext), scope)
+		for _, arg := range argsCtx.AllArgument() {
+			arg := arg.(*fql.ArgumentContext)
+			exp, err := v.doVisitSpreadable(arg.Ellipsis(), arg.Expression(), scope)
 
 			if err != nil {
 				return nil, err
@@ -1045,15 +1401,7 @@ func (v *visitor) doVisitFunctionCallExpression(context *fql.FunctionCallExpress
 		}
 	}
 
-	var name string
-
-	funcNS := context.Namespace()
-
-	if funcNS != nil {
-		name += funcNS.GetText()
-	}
-
-	name += context.Identifier().GetText()
+	name := functionName(context)
 
 	fun, exists := v.funcs[name]
 
@@ -1061,15 +1409,67 @@ func (v *visitor) doVisitFunctionCallExpression(context *fql.FunctionCallExpress
 		return nil, core.Error(core.ErrNotFound, fmt.Sprintf("function: '%s'", name))
 	}
 
-	return expressions.NewFunctionCallExpression(
+	return expressions.NewFunctionCallExpressionWith(
 		v.getSourceMap(context),
+		name,
 		fun,
+		v.cacheable[name],
 		args...,
 	)
 }
 
+// functionName returns a name of a called function along with its namespace.
+func functionName(ctx *fql.FunctionCallExpressionContext) string {
+	var name string
+
+	funcNS := ctx.Namespace()
+
+	if funcNS != nil {
+		name += funcNS.GetText()
+	}
+
+	return name + ctx.Identifier().GetText()
+}
+
+// doVisitCacheExpression compiles CACHE(key, ttl, expression).
+// Unlike function arguments, the expression is executed only if there is no cached result for the key.
+func (v *visitor) doVisitCacheExpression(context *fql.FunctionCallExpressionContext, scope *scope) (core.Expression, error) {
+	args := make([]core.Expression, 0, 3)
+	argsCtx := context.Arguments()
+
+	if argsCtx != nil {
+		for _, arg := range argsCtx.(*fql.ArgumentsContext).AllArgument() {
+			arg := arg.(*fql.ArgumentContext)
+
+			if arg.Ellipsis() != nil {
+				return nil, core.Error(core.ErrInvalidArgument, "CACHE does not accept spread arguments")
+			}
+
+			exp, err := v.doVisitExpression(arg.Expression().(*fql.ExpressionContext), scope)
+
+			if err != nil {
+				return nil, err
+			}
+
+			args = append(args, exp)
+		}
+	}
+
+	if len(args) != 3 {
+		return nil, core.Error(core.ErrInvalidArgumentNumber, "CACHE expects a key, a ttl and an expression")
+	}
+
+	return expressions.NewCacheExpression(v.getSourceMap(context), args[0], args[1], args[2])
+}
+
 func (v *visitor) doVisitParamContext(context *fql.ParamContext, _ *scope) (core.Expression, error) {
-	name := context.Identifier().GetText()
+	var name string
+
+	if identifier := context.Identifier(); identifier != nil {
+		name = identifier.GetText()
+	} else {
+		name = context.ReservedWord().GetText()
+	}
 
 	return expressions.NewParameterExpression(
 		v.getSourceMap(context),
@@ -1140,7 +1540,7 @@ func (v *visitor) doVisitUnaryOperator(ctx *fql.ExpressionContext, scope *scope)
 	return operators.NewUnaryOperator(
 		v.getSourceMap(ctx),
 		exp,
-		operators.UnaryOperatorType(op.GetText()),
+		operators.UnaryOperatorType(strings.ToUpper(op.GetText())),
 	)
 }
 
@@ -1150,7 +1550,7 @@ func (v *visitor) doVisitLogicalOperator(ctx *fql.ExpressionContext, scope *scop
 	logicalAndOp := ctx.LogicalAndOperator()
 
 	if logicalAndOp != nil {
-		operator = logicalAndOp.GetText()
+		operator = strings.ToUpper(logicalAndOp.GetText())
 	} else {
 		logicalOrOp := ctx.LogicalOrOperator()
 
@@ -1158,7 +1558,7 @@ func (v *visitor) doVisitLogicalOperator(ctx *fql.ExpressionContext, scope *scop
 			return nil, ErrInvalidToken
 		}
 
-		operator = logicalOrOp.GetText()
+		operator = strings.ToUpper(logicalOrOp.GetText())
 	}
 
 	exps, err := v.doVisitAllExpressions(ctx.AllExpression(), scope)
@@ -1241,7 +1641,7 @@ func (v *visitor) doVisitArrayOperator(ctx *fql.ExpressionContext, scope *scope)
 	left := exps[0]
 	right := exps[1]
 
-	aotype, err := operators.ToIsValidArrayOperatorType(ctx.ArrayOperator().GetText())
+	aotype, err := operators.ToIsValidArrayOperatorType(strings.ToUpper(ctx.ArrayOperator().GetText()))
 
 	if err != nil {
 		return nil, err
@@ -1266,6 +1666,77 @@ func (v *visitor) doVisitExpressionGroup(ctx *fql.ExpressionGroupContext, scope
 	return v.doVisitExpression(exp.(*fql.ExpressionContext), scope)
 }
 
+func (v *visitor) doVisitSwitchExpression(ctx *fql.SwitchExpressionContext, scope *scope) (core.Expression, error) {
+	test, err := v.doVisitExpression(ctx.Expression().(*fql.ExpressionContext), scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cases, fallback, err := v.doVisitSwitchCases(ctx.AllSwitchCase(), ctx.SwitchDefault(), scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return expressions.NewSwitchExpression(
+		v.getSourceMap(ctx),
+		test,
+		cases,
+		fallback,
+	)
+}
+
+func (v *visitor) doVisitWhenExpression(ctx *fql.WhenExpressionContext, scope *scope) (core.Expression, error) {
+	cases, fallback, err := v.doVisitSwitchCases(ctx.AllSwitchCase(), ctx.SwitchDefault(), scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return expressions.NewSwitchExpression(
+		v.getSourceMap(ctx),
+		nil,
+		cases,
+		fallback,
+	)
+}
+
+func (v *visitor) doVisitSwitchCases(contexts []fql.ISwitchCaseContext, defaultCtx fql.ISwitchDefaultContext, scope *scope) ([]*expressions.SwitchCase, core.Expression, error) {
+	cases := make([]*expressions.SwitchCase, 0, len(contexts))
+
+	for _, caseCtx := range contexts {
+		exps, err := v.doVisitAllExpressions(caseCtx.(*fql.SwitchCaseContext).AllExpression(), scope)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// the last expression is a result, the rest are tests
+		c, err := expressions.NewSwitchCase(exps[:len(exps)-1], exps[len(exps)-1])
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cases = append(cases, c)
+	}
+
+	var fallback core.Expression
+
+	if defaultCtx != nil {
+		exp, err := v.doVisitExpression(defaultCtx.(*fql.SwitchDefaultContext).Expression().(*fql.ExpressionContext), scope)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		fallback = exp
+	}
+
+	return cases, fallback, nil
+}
+
 func (v *visitor) doVisitExpression(ctx *fql.ExpressionContext, scope *scope) (core.Expression, error) {
 	seq := ctx.ExpressionGroup()
 
@@ -1273,6 +1744,18 @@ func (v *visitor) doVisitExpression(ctx *fql.ExpressionContext, scope *scope) (c
 		return v.doVisitExpressionGroup(seq.(*fql.ExpressionGroupContext), scope)
 	}
 
+	switchExp := ctx.SwitchExpression()
+
+	if switchExp != nil {
+		return v.doVisitSwitchExpression(switchExp.(*fql.SwitchExpressionContext), scope)
+	}
+
+	whenExp := ctx.WhenExpression()
+
+	if whenExp != nil {
+		return v.doVisitWhenExpression(whenExp.(*fql.WhenExpressionContext), scope)
+	}
+
 	member := ctx.MemberExpression()
 
 	if member != nil {
@@ -1342,7 +1825,7 @@ func (v *visitor) doVisitExpression(ctx *fql.ExpressionContext, scope *scope) (c
 	str := ctx.StringLiteral()
 
 	if str != nil {
-		return v.doVisitStringLiteral(str.(*fql.StringLiteralContext))
+		return v.doVisitStringLiteral(str.(*fql.StringLiteralContext), scope)
 	}
 
 	integ := ctx.IntegerLiteral()
@@ -1459,7 +1942,7 @@ func (v *visitor) visit(node antlr.Tree, scope *scope) (core.Expression, error)
 	case *fql.ObjectLiteralContext:
 		out, err = v.doVisitObjectLiteral(ctx, scope)
 	case *fql.StringLiteralContext:
-		out, err = v.doVisitStringLiteral(ctx)
+		out, err = v.doVisitStringLiteral(ctx, scope)
 	case *fql.IntegerLiteralContext:
 		out, err = v.doVisitIntegerLiteral(ctx)
 	case *fql.FloatLiteralContext:
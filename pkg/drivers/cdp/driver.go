@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/common"
 	"github.com/MontFerret/ferret/pkg/runtime/logging"
 )
 
@@ -25,6 +26,7 @@ type Driver struct {
 	session   *session.Manager
 	contextID target.BrowserContextID
 	options   *Options
+	robots    *common.RobotsPolicy
 }
 
 func NewDriver(opts ...Option) *Driver {
@@ -32,6 +34,10 @@ func NewDriver(opts ...Option) *Driver {
 	drv.options = newOptions(opts)
 	drv.dev = devtool.New(drv.options.Address)
 
+	if drv.options.Robots {
+		drv.robots = common.NewRobotsPolicy(drv.options.UserAgent, nil)
+	}
+
 	return drv
 }
 
@@ -42,6 +48,12 @@ func (drv *Driver) Name() string {
 func (drv *Driver) Open(ctx context.Context, params drivers.OpenPageParams) (drivers.HTMLPage, error) {
 	logger := logging.FromContext(ctx)
 
+	if drv.robots != nil {
+		if err := drv.robots.Wait(ctx, params.URL); err != nil {
+			return nil, err
+		}
+	}
+
 	err := drv.init(ctx)
 
 	if err != nil {
@@ -95,7 +107,16 @@ func (drv *Driver) Open(ctx context.Context, params drivers.OpenPageParams) (dri
 		params.UserAgent = drv.options.UserAgent
 	}
 
-	return LoadHTMLPage(ctx, conn, params)
+	page, err := LoadHTMLPage(ctx, conn, params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	page.observer = common.ObservePage(ctx, drv.Name(), params.URL)
+	page.robots = drv.robots
+
+	return page, nil
 }
 
 func (drv *Driver) Close() error {
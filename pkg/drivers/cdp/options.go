@@ -7,6 +7,8 @@ type (
 		UserAgent   string
 		Address     string
 		KeepCookies bool
+		// Robots makes the driver honour robots.txt of hosts for its user agent.
+		Robots bool
 	}
 
 	Option func(opts *Options)
@@ -52,6 +54,12 @@ func WithKeepCookies() Option {
 	}
 }
 
+func WithRobots() Option {
+	return func(opts *Options) {
+		opts.Robots = true
+	}
+}
+
 func WithCustomName(name string) Option {
 	return func(opts *Options) {
 		opts.Name = name
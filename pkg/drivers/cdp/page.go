@@ -34,6 +34,8 @@ type HTMLPage struct {
 	keyboard *input.Keyboard
 	document *HTMLDocument
 	frames   *common.LazyValue
+	observer *common.PageObserver
+	robots   *common.RobotsPolicy
 }
 
 func handleLoadError(logger *zerolog.Logger, client *cdp.Client) {
@@ -161,8 +163,24 @@ func LoadHTMLPage(
 	}
 
 	if params.URL != BlankPageURL && params.URL != "" {
+		var interceptor *requestInterceptor
+
+		if hasCustomRequest(params) {
+			interceptor, err = interceptRequest(ctx, client, params)
+
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to intercept the request")
+			}
+		}
+
 		repl, err := client.Page.Navigate(ctx, page.NewNavigateArgs(params.URL))
 
+		if interceptor != nil {
+			if e := interceptor.Stop(ctx); e != nil && err == nil {
+				err = errors.Wrap(e, "failed to send the request")
+			}
+		}
+
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to load the page")
 		}
@@ -365,7 +383,11 @@ func (p *HTMLPage) Close() error {
 			Msg("failed to close browser page")
 	}
 
-	return p.conn.Close()
+	err = p.conn.Close()
+
+	p.observer.Closed(err)
+
+	return err
 }
 
 func (p *HTMLPage) IsClosed() values.Boolean {
@@ -600,6 +622,12 @@ func (p *HTMLPage) Navigate(ctx context.Context, url values.String) error {
 		url = BlankPageURL
 	}
 
+	if p.robots != nil {
+		if err := p.robots.Wait(ctx, url.String()); err != nil {
+			return err
+		}
+	}
+
 	repl, err := p.client.Page.Navigate(ctx, page.NewNavigateArgs(url.String()))
 
 	if err != nil {
@@ -0,0 +1,155 @@
+package cdp
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/mafredri/cdp"
+	"github.com/mafredri/cdp/protocol/fetch"
+	"github.com/mafredri/cdp/protocol/network"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+// requestInterceptor overrides a method and a body of a navigation request,
+// which Page.navigate does not support.
+type requestInterceptor struct {
+	client *cdp.Client
+	stream fetch.RequestPausedClient
+	params drivers.OpenPageParams
+	done   chan struct{}
+	err    error
+}
+
+func hasCustomRequest(params drivers.OpenPageParams) bool {
+	method := strings.ToUpper(params.Method)
+
+	return (method != "" && method != "GET") || params.Body != nil
+}
+
+// interceptRequest starts intercepting document requests.
+// The first one is sent with a method and a body of given params, the rest are sent as they are.
+func interceptRequest(
+	ctx context.Context,
+	client *cdp.Client,
+	params drivers.OpenPageParams,
+) (*requestInterceptor, error) {
+	resourceType := network.ResourceTypeDocument
+
+	err := client.Fetch.Enable(
+		ctx,
+		fetch.NewEnableArgs().SetPatterns([]fetch.RequestPattern{
+			{ResourceType: &resourceType},
+		}),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := client.Fetch.RequestPaused(ctx)
+
+	if err != nil {
+		client.Fetch.Disable(ctx)
+
+		return nil, err
+	}
+
+	interceptor := &requestInterceptor{
+		client: client,
+		stream: stream,
+		params: params,
+		done:   make(chan struct{}),
+	}
+
+	go interceptor.run(ctx)
+
+	return interceptor, nil
+}
+
+func (i *requestInterceptor) run(ctx context.Context) {
+	defer close(i.done)
+
+	overridden := false
+
+	for {
+		reply, err := i.stream.Recv()
+
+		// the stream gets closed by Stop
+		if err != nil {
+			return
+		}
+
+		if overridden {
+			i.client.Fetch.ContinueRequest(ctx, fetch.NewContinueRequestArgs(reply.RequestID))
+
+			continue
+		}
+
+		overridden = true
+
+		if err := i.override(ctx, reply); err != nil {
+			i.err = err
+
+			// otherwise the navigation hangs until the timeout
+			i.client.Fetch.FailRequest(
+				ctx,
+				fetch.NewFailRequestArgs(reply.RequestID, network.ErrorReasonFailed),
+			)
+		}
+	}
+}
+
+func (i *requestInterceptor) override(ctx context.Context, reply *fetch.RequestPausedReply) error {
+	args := fetch.NewContinueRequestArgs(reply.RequestID)
+
+	if i.params.Method != "" {
+		args.SetMethod(strings.ToUpper(i.params.Method))
+	}
+
+	if i.params.Body != nil {
+		// the protocol expects binary data to be base64 encoded
+		args.SetPostData(base64.StdEncoding.EncodeToString(i.params.Body))
+	}
+
+	if i.params.ContentType != "" {
+		headers := make(map[string]string)
+
+		if err := json.Unmarshal(reply.Request.Headers, &headers); err != nil {
+			return err
+		}
+
+		entries := make([]fetch.HeaderEntry, 0, len(headers)+1)
+
+		for name, value := range headers {
+			if strings.EqualFold(name, "Content-Type") {
+				continue
+			}
+
+			entries = append(entries, fetch.HeaderEntry{Name: name, Value: value})
+		}
+
+		entries = append(entries, fetch.HeaderEntry{Name: "Content-Type", Value: i.params.ContentType})
+
+		args.SetHeaders(entries)
+	}
+
+	return i.client.Fetch.ContinueRequest(ctx, args)
+}
+
+// Stop stops intercepting requests and returns an error which has occurred while overriding the request.
+func (i *requestInterceptor) Stop(ctx context.Context) error {
+	i.stream.Close()
+	<-i.done
+
+	err := i.client.Fetch.Disable(ctx)
+
+	// done is closed after the last write
+	if i.err != nil {
+		return i.err
+	}
+
+	return err
+}
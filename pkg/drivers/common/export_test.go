@@ -0,0 +1,17 @@
+package common
+
+import "time"
+
+var RobotsRetryDelay = robotsRetryDelay
+
+// ExpireRobots makes robots.txt files cached by a policy outdated, as if their time has passed.
+func ExpireRobots(p *RobotsPolicy) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, host := range p.hosts {
+		host.mu.Lock()
+		host.expires = time.Time{}
+		host.mu.Unlock()
+	}
+}
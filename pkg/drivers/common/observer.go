@@ -0,0 +1,50 @@
+package common
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MontFerret/ferret/pkg/runtime/hooks"
+)
+
+// PageObserver reports opening and closing of a page to hooks of the run which has opened it.
+type PageObserver struct {
+	mu     sync.Mutex
+	hooks  hooks.Hooks
+	event  hooks.PageEvent
+	closed bool
+}
+
+// ObservePage reports opening of a page and returns an observer which reports its closing.
+func ObservePage(ctx context.Context, driver, url string) *PageObserver {
+	o := &PageObserver{
+		hooks: hooks.FromContext(ctx),
+		event: hooks.PageEvent{Driver: driver, URL: url},
+	}
+
+	o.hooks.OnPageOpen(ctx, o.event)
+
+	return o
+}
+
+// Closed reports closing of the page with a given error.
+// Only the first call gets reported.
+func (o *PageObserver) Closed(err error) {
+	if o == nil {
+		return
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.closed {
+		return
+	}
+
+	o.closed = true
+
+	event := o.event
+	event.Error = err
+
+	o.hooks.OnPageClose(context.Background(), event)
+}
@@ -0,0 +1,418 @@
+package common
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrDisallowedByRobots is a cause of errors returned for urls disallowed by robots.txt.
+var ErrDisallowedByRobots = errors.New("disallowed by robots.txt")
+
+const (
+	robotsTTL = 24 * time.Hour
+	// unreachable robots.txt files are fetched again sooner,
+	// after a delay which doubles with each failure up to the maximum
+	robotsMinRetryDelay = time.Minute
+	robotsMaxRetryDelay = 30 * time.Minute
+	// robots.txt files are cut at 500 KiB as defined by RFC 9309
+	robotsMaxSize = 500 * 1024
+)
+
+type (
+	robotsRule struct {
+		allow   bool
+		pattern string
+	}
+
+	robotsGroup struct {
+		agents     []string
+		rules      []robotsRule
+		crawlDelay time.Duration
+	}
+
+	robotsHost struct {
+		mu         sync.Mutex
+		expires    time.Time
+		rules      []robotsRule
+		crawlDelay time.Duration
+		// disallowAll is set when robots.txt is unreachable
+		disallowAll bool
+		// failures is a number of consecutive failed fetches
+		failures int
+		last     time.Time
+	}
+
+	// RobotsPolicy fetches robots.txt of hosts, caches it
+	// and checks whether urls are allowed to be crawled by a given user agent.
+	RobotsPolicy struct {
+		mu        sync.Mutex
+		client    *http.Client
+		userAgent string
+		hosts     map[string]*robotsHost
+	}
+)
+
+// NewRobotsPolicy returns a policy for a given user agent,
+// which fetches robots.txt by a given client or by a default one if it is nil.
+// Rules of a group are applied if the user agent contains its name, otherwise rules for "*" are applied.
+func NewRobotsPolicy(userAgent string, client *http.Client) *RobotsPolicy {
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	// random user agents are not known beforehand
+	if userAgent == RandomUserAgent {
+		userAgent = ""
+	}
+
+	return &RobotsPolicy{
+		client:    client,
+		userAgent: strings.ToLower(userAgent),
+		hosts:     make(map[string]*robotsHost),
+	}
+}
+
+// Wait returns an error caused by ErrDisallowedByRobots if a given url is disallowed.
+// Otherwise, it blocks until a crawl delay of the host has passed since the previous request.
+func (p *RobotsPolicy) Wait(ctx context.Context, target string) error {
+	u, err := url.Parse(target)
+
+	if err != nil {
+		return err
+	}
+
+	// robots.txt is only defined for http and https urls
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil
+	}
+
+	host, err := p.host(ctx, u)
+
+	if err != nil {
+		return err
+	}
+
+	host.mu.Lock()
+
+	if !host.isAllowed(u) {
+		host.mu.Unlock()
+
+		return errors.Wrap(ErrDisallowedByRobots, target)
+	}
+
+	now := time.Now()
+	start := host.last.Add(host.crawlDelay)
+
+	if start.Before(now) {
+		start = now
+	}
+
+	host.last = start
+	host.mu.Unlock()
+
+	wait := start.Sub(now)
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *RobotsPolicy) host(ctx context.Context, u *url.URL) (*robotsHost, error) {
+	key := u.Scheme + "://" + u.Host
+
+	p.mu.Lock()
+	host, exists := p.hosts[key]
+
+	if !exists {
+		host = new(robotsHost)
+		p.hosts[key] = host
+	}
+
+	p.mu.Unlock()
+
+	host.mu.Lock()
+	defer host.mu.Unlock()
+
+	if time.Now().Before(host.expires) {
+		return host, nil
+	}
+
+	robotsURL := &url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/robots.txt"}
+	req, err := http.NewRequest(http.MethodGet, robotsURL.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.client.Do(req.WithContext(ctx))
+
+	if err != nil {
+		// a canceled run must not leave the host disallowed
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		host.update(nil, true)
+
+		return host, nil
+	}
+
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		group := parseRobots(io.LimitReader(resp.Body, robotsMaxSize)).find(p.userAgent)
+		host.update(group, false)
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		// robots.txt is unavailable, so everything is allowed
+		host.update(nil, false)
+	default:
+		host.update(nil, true)
+	}
+
+	return host, nil
+}
+
+func (h *robotsHost) update(group *robotsGroup, disallowAll bool) {
+	if disallowAll {
+		h.expires = time.Now().Add(robotsRetryDelay(h.failures))
+		h.failures++
+	} else {
+		h.expires = time.Now().Add(robotsTTL)
+		h.failures = 0
+	}
+
+	h.disallowAll = disallowAll
+	h.rules = nil
+	h.crawlDelay = 0
+
+	if group != nil {
+		h.rules = group.rules
+		h.crawlDelay = group.crawlDelay
+	}
+}
+
+// robotsRetryDelay returns a time after which robots.txt is fetched again
+// following a given number of previous consecutive failures.
+func robotsRetryDelay(failures int) time.Duration {
+	delay := robotsMinRetryDelay
+
+	for i := 0; i < failures && delay < robotsMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > robotsMaxRetryDelay {
+		delay = robotsMaxRetryDelay
+	}
+
+	return delay
+}
+
+// isAllowed applies the most specific rule matching a path of a given url.
+// Allow rules win over disallow rules of the same length.
+func (h *robotsHost) isAllowed(u *url.URL) bool {
+	if h.disallowAll {
+		return false
+	}
+
+	path := u.EscapedPath()
+
+	if path == "" {
+		path = "/"
+	}
+
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+
+	allowed := true
+	matched := -1
+
+	for _, rule := range h.rules {
+		if !matchRobotsPattern(rule.pattern, path) {
+			continue
+		}
+
+		if len(rule.pattern) > matched || (len(rule.pattern) == matched && rule.allow) {
+			matched = len(rule.pattern)
+			allowed = rule.allow
+		}
+	}
+
+	return allowed
+}
+
+type robotsGroups []*robotsGroup
+
+func parseRobots(r io.Reader) robotsGroups {
+	groups := make(robotsGroups, 0, 5)
+
+	var current *robotsGroup
+	// consecutive user-agent lines start a single group
+	collectingAgents := false
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		i := strings.Index(line, ":")
+
+		if i < 0 {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimSpace(line[:i]))
+		value := strings.TrimSpace(line[i+1:])
+
+		switch key {
+		case "user-agent":
+			if !collectingAgents {
+				current = new(robotsGroup)
+				groups = append(groups, current)
+				collectingAgents = true
+			}
+
+			current.agents = append(current.agents, strings.ToLower(value))
+		case "allow", "disallow":
+			collectingAgents = false
+
+			// empty rules match nothing
+			if current == nil || value == "" {
+				continue
+			}
+
+			current.rules = append(current.rules, robotsRule{
+				allow:   key == "allow",
+				pattern: value,
+			})
+		case "crawl-delay":
+			collectingAgents = false
+
+			if current == nil {
+				continue
+			}
+
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				current.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		default:
+			collectingAgents = false
+		}
+	}
+
+	return groups
+}
+
+// find merges groups for the longest agent name contained in a given user agent,
+// or groups for "*" if there are none.
+func (groups robotsGroups) find(userAgent string) *robotsGroup {
+	var res *robotsGroup
+	var matched string
+
+	for _, group := range groups {
+		for _, agent := range group.agents {
+			if agent == "*" || agent == "" || !strings.Contains(userAgent, agent) || len(agent) < len(matched) {
+				continue
+			}
+
+			if len(agent) > len(matched) {
+				res = new(robotsGroup)
+				matched = agent
+			}
+
+			res.merge(group)
+
+			break
+		}
+	}
+
+	if res != nil {
+		return res
+	}
+
+	for _, group := range groups {
+		for _, agent := range group.agents {
+			if agent != "*" {
+				continue
+			}
+
+			if res == nil {
+				res = new(robotsGroup)
+			}
+
+			res.merge(group)
+
+			break
+		}
+	}
+
+	return res
+}
+
+func (g *robotsGroup) merge(other *robotsGroup) {
+	g.rules = append(g.rules, other.rules...)
+
+	if other.crawlDelay > g.crawlDelay {
+		g.crawlDelay = other.crawlDelay
+	}
+}
+
+// matchRobotsPattern matches a path by a pattern, where "*" matches any sequence of characters
+// and "$" at the end matches the end of the path.
+func matchRobotsPattern(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+
+	if anchored {
+		pattern = strings.TrimSuffix(pattern, "$")
+	}
+
+	parts := strings.Split(pattern, "*")
+
+	// the first part is a prefix
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+
+	rest := path[len(parts[0]):]
+
+	for i, part := range parts[1:] {
+		// the last part of an anchored pattern must be at the end
+		if anchored && i == len(parts)-2 {
+			return strings.HasSuffix(rest, part)
+		}
+
+		idx := strings.Index(rest, part)
+
+		if idx < 0 {
+			return false
+		}
+
+		rest = rest[idx+len(part):]
+	}
+
+	return !anchored || rest == ""
+}
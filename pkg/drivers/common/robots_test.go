@@ -0,0 +1,144 @@
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/drivers/common"
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const robotsTxt = `
+# comments are ignored
+User-agent: *
+Disallow: /private
+Allow: /private/public
+Disallow: /*.pdf$
+
+User-agent: FerretBot
+User-agent: OtherBot
+Disallow: /
+Allow: /ferret
+Crawl-delay: 0.1
+`
+
+func newRobotsServer(status int, body string) (*httptest.Server, *int32) {
+	var fetched int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			atomic.AddInt32(&fetched, 1)
+			w.WriteHeader(status)
+			fmt.Fprint(w, body)
+		}
+	}))
+
+	return server, &fetched
+}
+
+func isDisallowed(err error) bool {
+	return err != nil && errors.Cause(err) == common.ErrDisallowedByRobots
+}
+
+func TestRobotsPolicy(t *testing.T) {
+	Convey("Should apply rules for any agent", t, func() {
+		server, fetched := newRobotsServer(http.StatusOK, robotsTxt)
+		defer server.Close()
+
+		policy := common.NewRobotsPolicy("Mozilla/5.0", nil)
+		ctx := context.Background()
+
+		So(policy.Wait(ctx, server.URL+"/"), ShouldBeNil)
+		So(policy.Wait(ctx, server.URL+"/public"), ShouldBeNil)
+		So(isDisallowed(policy.Wait(ctx, server.URL+"/private")), ShouldBeTrue)
+		So(isDisallowed(policy.Wait(ctx, server.URL+"/private/data")), ShouldBeTrue)
+		So(policy.Wait(ctx, server.URL+"/private/public/data"), ShouldBeNil)
+		So(isDisallowed(policy.Wait(ctx, server.URL+"/docs/file.pdf")), ShouldBeTrue)
+		So(policy.Wait(ctx, server.URL+"/docs/file.pdf?download=1"), ShouldBeNil)
+
+		So(atomic.LoadInt32(fetched), ShouldEqual, 1)
+	})
+
+	Convey("Should apply rules for a matching agent", t, func() {
+		server, _ := newRobotsServer(http.StatusOK, robotsTxt)
+		defer server.Close()
+
+		policy := common.NewRobotsPolicy("Mozilla/5.0 (compatible; FerretBot/1.0)", nil)
+		ctx := context.Background()
+
+		So(isDisallowed(policy.Wait(ctx, server.URL+"/public")), ShouldBeTrue)
+		So(policy.Wait(ctx, server.URL+"/ferret"), ShouldBeNil)
+
+		Convey("Should wait for a crawl delay", func() {
+			started := time.Now()
+
+			So(policy.Wait(ctx, server.URL+"/ferret/1"), ShouldBeNil)
+			So(policy.Wait(ctx, server.URL+"/ferret/2"), ShouldBeNil)
+
+			So(time.Since(started), ShouldBeGreaterThanOrEqualTo, 150*time.Millisecond)
+		})
+	})
+
+	Convey("Should allow everything when robots.txt is missing", t, func() {
+		server, _ := newRobotsServer(http.StatusNotFound, "")
+		defer server.Close()
+
+		policy := common.NewRobotsPolicy("FerretBot", nil)
+
+		So(policy.Wait(context.Background(), server.URL+"/private"), ShouldBeNil)
+	})
+
+	Convey("Should disallow everything when robots.txt is unreachable", t, func() {
+		server, _ := newRobotsServer(http.StatusServiceUnavailable, "")
+		defer server.Close()
+
+		policy := common.NewRobotsPolicy("FerretBot", nil)
+
+		So(isDisallowed(policy.Wait(context.Background(), server.URL+"/")), ShouldBeTrue)
+	})
+
+	Convey("Should fetch unreachable robots.txt again", t, func() {
+		var fetched, status int32 = 0, http.StatusServiceUnavailable
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/robots.txt" {
+				atomic.AddInt32(&fetched, 1)
+				w.WriteHeader(int(atomic.LoadInt32(&status)))
+				fmt.Fprint(w, robotsTxt)
+			}
+		}))
+		defer server.Close()
+
+		policy := common.NewRobotsPolicy("Mozilla/5.0", nil)
+		ctx := context.Background()
+
+		So(isDisallowed(policy.Wait(ctx, server.URL+"/")), ShouldBeTrue)
+		So(isDisallowed(policy.Wait(ctx, server.URL+"/")), ShouldBeTrue)
+		So(atomic.LoadInt32(&fetched), ShouldEqual, 1)
+
+		atomic.StoreInt32(&status, http.StatusOK)
+		common.ExpireRobots(policy)
+
+		So(policy.Wait(ctx, server.URL+"/"), ShouldBeNil)
+		So(isDisallowed(policy.Wait(ctx, server.URL+"/private")), ShouldBeTrue)
+		So(atomic.LoadInt32(&fetched), ShouldEqual, 2)
+	})
+
+	Convey("Should retry fetching with bounded delays", t, func() {
+		So(common.RobotsRetryDelay(0), ShouldEqual, time.Minute)
+		So(common.RobotsRetryDelay(1), ShouldEqual, 2*time.Minute)
+		So(common.RobotsRetryDelay(100), ShouldEqual, 30*time.Minute)
+	})
+
+	Convey("Should ignore urls other than http", t, func() {
+		policy := common.NewRobotsPolicy("FerretBot", nil)
+
+		So(policy.Wait(context.Background(), "about:blank"), ShouldBeNil)
+	})
+}
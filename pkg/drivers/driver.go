@@ -24,6 +24,15 @@ type (
 		KeepCookies bool
 		Cookies     []HTTPCookie
 		Header      HTTPHeader
+		// AllowedStatusCodes are response status codes which are not treated as errors.
+		// If empty, any status code other than 200 is an error.
+		// Drivers which do not expose responses ignore it.
+		AllowedStatusCodes []int
+		// Method is an HTTP method of the request. If empty, GET is used.
+		Method string
+		// Body is sent with the request along with ContentType, if set.
+		Body        []byte
+		ContentType string
 	}
 
 	Driver interface {
@@ -101,7 +101,7 @@ func (h HTTPHeader) Copy() core.Value {
 }
 
 func (h HTTPHeader) MarshalJSON() ([]byte, error) {
-	out, err := json.Marshal(h)
+	out, err := json.Marshal(map[string][]string(h))
 
 	if err != nil {
 		return nil, err
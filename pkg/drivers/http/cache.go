@@ -0,0 +1,417 @@
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CacheMode defines how cached responses are used.
+type CacheMode int
+
+const (
+	// CacheModeDefault uses cached responses while they are fresh according to Cache-Control and Expires headers
+	// and revalidates stale ones by ETag and Last-Modified.
+	CacheModeDefault CacheMode = iota
+	// CacheModeForce uses cached responses regardless of their freshness
+	// and caches all responses, which is handy for developing queries.
+	CacheModeForce
+	// CacheModeOffline uses cached responses only and never sends requests.
+	CacheModeOffline
+)
+
+// ErrNotCached is a cause of errors returned in offline mode for requests without cached responses.
+var ErrNotCached = errors.New("response is not cached")
+
+// FromCacheHeader is set on responses taken from the cache.
+const FromCacheHeader = "X-From-Cache"
+
+var cacheableStatusCodes = map[int]bool{
+	http.StatusOK:                   true,
+	http.StatusNonAuthoritativeInfo: true,
+	http.StatusMultipleChoices:      true,
+	http.StatusMovedPermanently:     true,
+	http.StatusNotFound:             true,
+	http.StatusGone:                 true,
+}
+
+type (
+	cacheEntry struct {
+		response *http.Response
+		body     []byte
+		storedAt time.Time
+	}
+
+	// cachingTransport stores responses in a directory, one file per method, url and values of headers
+	// listed in Vary header of the response.
+	cachingTransport struct {
+		mu   sync.Mutex
+		base http.RoundTripper
+		dir  string
+		mode CacheMode
+	}
+)
+
+func newCachingTransport(base http.RoundTripper, dir string, mode CacheMode) *cachingTransport {
+	return &cachingTransport{base: base, dir: dir, mode: mode}
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || hasDirective(req.Header, "no-store") {
+		if t.mode == CacheModeOffline {
+			return nil, errors.Wrap(ErrNotCached, req.URL.String())
+		}
+
+		return t.base.RoundTrip(req)
+	}
+
+	entry, err := t.load(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if entry != nil && (t.mode != CacheModeDefault || entry.isFresh()) {
+		return entry.toResponse(req), nil
+	}
+
+	if t.mode == CacheModeOffline {
+		return nil, errors.Wrap(ErrNotCached, req.URL.String())
+	}
+
+	if entry != nil {
+		return t.revalidate(req, entry)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.store(req, resp)
+}
+
+// revalidate sends a conditional request for a stale entry and returns the entry if it has not been modified.
+func (t *cachingTransport) revalidate(req *http.Request, entry *cacheEntry) (*http.Response, error) {
+	conditional := cloneRequest(req)
+
+	if etag := entry.response.Header.Get("ETag"); etag != "" {
+		conditional.Header.Set("If-None-Match", etag)
+	}
+
+	if modified := entry.response.Header.Get("Last-Modified"); modified != "" {
+		conditional.Header.Set("If-Modified-Since", modified)
+	}
+
+	resp, err := t.base.RoundTrip(conditional)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusNotModified {
+		return t.store(req, resp)
+	}
+
+	resp.Body.Close()
+
+	// headers of 304 responses update the stored ones
+	for name, values := range resp.Header {
+		entry.response.Header[name] = values
+	}
+
+	entry.storedAt = time.Now()
+
+	if err := t.save(req, entry); err != nil {
+		return nil, err
+	}
+
+	return entry.toResponse(req), nil
+}
+
+func (t *cachingTransport) store(req *http.Request, resp *http.Response) (*http.Response, error) {
+	if !t.isCacheable(resp) {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	entry := &cacheEntry{
+		response: resp,
+		body:     body,
+		storedAt: time.Now(),
+	}
+
+	if err := t.save(req, entry); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (t *cachingTransport) isCacheable(resp *http.Response) bool {
+	if !cacheableStatusCodes[resp.StatusCode] || resp.Header.Get("Vary") == "*" {
+		return false
+	}
+
+	if t.mode == CacheModeForce {
+		return true
+	}
+
+	return !hasDirective(resp.Header, "no-store")
+}
+
+func (t *cachingTransport) load(req *http.Request) (*cacheEntry, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	vary, err := ioutil.ReadFile(t.path(varyKey(req)))
+
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	file, err := os.Open(t.path(entryKey(req, strings.Fields(string(vary)))))
+
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	line, err := reader.ReadString('\n')
+
+	if err != nil {
+		return nil, err
+	}
+
+	storedAt, err := time.Parse(time.RFC3339Nano, strings.TrimSpace(line))
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.ReadResponse(reader, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &cacheEntry{resp, body, storedAt}, nil
+}
+
+func (t *cachingTransport) save(req *http.Request, entry *cacheEntry) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := os.MkdirAll(t.dir, 0755); err != nil {
+		return err
+	}
+
+	vary := varyHeaders(entry.response.Header)
+
+	if err := ioutil.WriteFile(t.path(varyKey(req)), []byte(strings.Join(vary, " ")), 0644); err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString(entry.storedAt.Format(time.RFC3339Nano))
+	buf.WriteString("\n")
+
+	resp := entry.toResponse(nil)
+	// the body is stored as it is
+	resp.TransferEncoding = nil
+	resp.ContentLength = int64(len(entry.body))
+	resp.Header.Del(FromCacheHeader)
+
+	dump, err := httputil.DumpResponse(resp, true)
+
+	if err != nil {
+		return err
+	}
+
+	buf.Write(dump)
+
+	return ioutil.WriteFile(t.path(entryKey(req, vary)), buf.Bytes(), 0644)
+}
+
+func (t *cachingTransport) path(key string) string {
+	return filepath.Join(t.dir, key)
+}
+
+// toResponse returns a copy of the stored response for a given request.
+// Responses for requests are marked by FromCacheHeader.
+func (e *cacheEntry) toResponse(req *http.Request) *http.Response {
+	resp := new(http.Response)
+	*resp = *e.response
+	resp.Header = cloneHeader(e.response.Header)
+	resp.Body = ioutil.NopCloser(bytes.NewReader(e.body))
+	resp.ContentLength = int64(len(e.body))
+	resp.Request = req
+
+	if req != nil {
+		resp.Header.Set(FromCacheHeader, "1")
+	}
+
+	return resp
+}
+
+// isFresh reports whether the entry can be used without revalidation.
+func (e *cacheEntry) isFresh() bool {
+	header := e.response.Header
+
+	if hasDirective(header, "no-cache") {
+		return false
+	}
+
+	age := time.Since(e.storedAt)
+
+	if value, err := strconv.Atoi(header.Get("Age")); err == nil {
+		age += time.Duration(value) * time.Second
+	}
+
+	if value, ok := directive(header, "max-age"); ok {
+		seconds, err := strconv.Atoi(value)
+
+		return err == nil && age < time.Duration(seconds)*time.Second
+	}
+
+	if expires := header.Get("Expires"); expires != "" {
+		expiresAt, err := http.ParseTime(expires)
+
+		if err != nil {
+			return false
+		}
+
+		date, err := http.ParseTime(header.Get("Date"))
+
+		if err != nil {
+			date = e.storedAt
+		}
+
+		return age < expiresAt.Sub(date)
+	}
+
+	return false
+}
+
+func varyKey(req *http.Request) string {
+	return hashKey(req.Method, req.URL.String()) + ".vary"
+}
+
+func entryKey(req *http.Request, vary []string) string {
+	parts := []string{req.Method, req.URL.String()}
+
+	for _, name := range vary {
+		parts = append(parts, name+":"+strings.Join(req.Header[name], ","))
+	}
+
+	return hashKey(parts...) + ".response"
+}
+
+func hashKey(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func varyHeaders(header http.Header) []string {
+	res := make([]string, 0, 2)
+
+	for _, value := range header["Vary"] {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+
+			if name != "" {
+				res = append(res, http.CanonicalHeaderKey(name))
+			}
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+// directive returns a value of a given Cache-Control directive.
+func directive(header http.Header, name string) (string, bool) {
+	for _, value := range header["Cache-Control"] {
+		for _, d := range strings.Split(value, ",") {
+			d = strings.TrimSpace(d)
+			parts := strings.SplitN(d, "=", 2)
+
+			if !strings.EqualFold(parts[0], name) {
+				continue
+			}
+
+			if len(parts) == 1 {
+				return "", true
+			}
+
+			return strings.Trim(parts[1], `"`), true
+		}
+	}
+
+	return "", false
+}
+
+func hasDirective(header http.Header, name string) bool {
+	_, ok := directive(header, name)
+
+	return ok
+}
+
+func cloneRequest(req *http.Request) *http.Request {
+	res := new(http.Request)
+	*res = *req
+	res.Header = cloneHeader(req.Header)
+
+	return res
+}
+
+func cloneHeader(header http.Header) http.Header {
+	res := make(http.Header, len(header))
+
+	for name, values := range header {
+		res[name] = append([]string(nil), values...)
+	}
+
+	return res
+}
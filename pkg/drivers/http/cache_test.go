@@ -0,0 +1,159 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	h "net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCache(t *testing.T) {
+	var requests, notModified int32
+
+	mux := h.NewServeMux()
+	mux.HandleFunc("/fresh", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		fmt.Fprint(w, `<html><head><title>Fresh</title></head><body></body></html>`)
+	})
+	mux.HandleFunc("/etag", func(w h.ResponseWriter, r *h.Request) {
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("ETag", `"v1"`)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(h.StatusNotModified)
+
+			return
+		}
+
+		fmt.Fprint(w, `<html><head><title>ETag</title></head><body></body></html>`)
+	})
+	mux.HandleFunc("/no-store", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		fmt.Fprint(w, `<html><head><title>No Store</title></head><body></body></html>`)
+	})
+	mux.HandleFunc("/vary", func(w h.ResponseWriter, r *h.Request) {
+		w.Header().Set("Cache-Control", "max-age=60")
+		w.Header().Set("Vary", "Accept-Language")
+		fmt.Fprintf(w, `<html><head><title>%s</title></head><body></body></html>`, strings.Join(r.Header["Accept-Language"], ","))
+	})
+
+	server := httptest.NewServer(h.HandlerFunc(func(w h.ResponseWriter, r *h.Request) {
+		atomic.AddInt32(&requests, 1)
+		mux.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	open := func(drv *http.Driver, path string) (drivers.HTMLPage, error) {
+		return drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + path})
+	}
+
+	Convey("Cache", t, func() {
+		dir, err := ioutil.TempDir("", "ferret-cache")
+		So(err, ShouldBeNil)
+
+		defer os.RemoveAll(dir)
+
+		atomic.StoreInt32(&requests, 0)
+		atomic.StoreInt32(&notModified, 0)
+
+		drv := http.NewDriver(http.WithCache(dir, http.CacheModeDefault))
+
+		Convey("Should reuse fresh responses", func() {
+			_, err := open(drv, "/fresh")
+			So(err, ShouldBeNil)
+
+			page, err := open(drv, "/fresh")
+			So(err, ShouldBeNil)
+
+			So(getIn(page, "title"), ShouldEqual, values.NewString("Fresh"))
+			So(getIn(page, "response", "headers", http.FromCacheHeader), ShouldEqual, values.NewString("1"))
+			So(atomic.LoadInt32(&requests), ShouldEqual, 1)
+
+			Convey("Should keep responses between drivers", func() {
+				_, err := open(http.NewDriver(http.WithCache(dir, http.CacheModeDefault)), "/fresh")
+
+				So(err, ShouldBeNil)
+				So(atomic.LoadInt32(&requests), ShouldEqual, 1)
+			})
+		})
+
+		Convey("Should revalidate stale responses", func() {
+			_, err := open(drv, "/etag")
+			So(err, ShouldBeNil)
+
+			page, err := open(drv, "/etag")
+			So(err, ShouldBeNil)
+
+			So(getIn(page, "title"), ShouldEqual, values.NewString("ETag"))
+			So(atomic.LoadInt32(&requests), ShouldEqual, 2)
+			So(atomic.LoadInt32(&notModified), ShouldEqual, 1)
+		})
+
+		Convey("Should not store responses with no-store", func() {
+			_, err := open(drv, "/no-store")
+			So(err, ShouldBeNil)
+
+			_, err = open(drv, "/no-store")
+			So(err, ShouldBeNil)
+
+			So(atomic.LoadInt32(&requests), ShouldEqual, 2)
+		})
+
+		Convey("Should store variants of responses", func() {
+			openWithLanguage := func(lang string) drivers.HTMLPage {
+				page, err := drv.Open(context.Background(), drivers.OpenPageParams{
+					URL:    server.URL + "/vary",
+					Header: drivers.HTTPHeader{"Accept-Language": []string{lang}},
+				})
+
+				So(err, ShouldBeNil)
+
+				return page
+			}
+
+			openWithLanguage("fr")
+			openWithLanguage("de")
+			page := openWithLanguage("fr")
+
+			So(getIn(page, "title").String(), ShouldContainSubstring, "fr")
+			So(atomic.LoadInt32(&requests), ShouldEqual, 2)
+		})
+
+		Convey("Should use any cached response in force mode", func() {
+			drv := http.NewDriver(http.WithCache(dir, http.CacheModeForce))
+
+			_, err := open(drv, "/no-store")
+			So(err, ShouldBeNil)
+
+			_, err = open(drv, "/no-store")
+			So(err, ShouldBeNil)
+
+			So(atomic.LoadInt32(&requests), ShouldEqual, 1)
+
+			Convey("Should not send requests in offline mode", func() {
+				drv := http.NewDriver(http.WithCache(dir, http.CacheModeOffline))
+
+				page, err := open(drv, "/no-store")
+				So(err, ShouldBeNil)
+				So(getIn(page, "title"), ShouldEqual, values.NewString("No Store"))
+
+				_, err = open(drv, "/fresh")
+				So(err, ShouldNotBeNil)
+				So(strings.Contains(err.Error(), http.ErrNotCached.Error()), ShouldBeTrue)
+
+				So(atomic.LoadInt32(&requests), ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html/charset"
+	"golang.org/x/text/transform"
+)
+
+// newDocument parses a body of a given response decoded to UTF-8
+// and returns the document along with a name of the detected charset.
+func newDocument(resp *http.Response) (*goquery.Document, string, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	reader, name := decodeBody(body, resp.Header.Get("Content-Type"))
+
+	doc, err := goquery.NewDocumentFromReader(reader)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	return doc, name, nil
+}
+
+// decodeBody detects a charset of a body by BOM, Content-Type header and <meta> tags, in that order,
+// and returns a reader decoding the body to UTF-8.
+func decodeBody(body []byte, contentType string) (io.Reader, string) {
+	enc, name, certain := charset.DetermineEncoding(body, contentType)
+
+	// the detection only looks at the beginning of the body and falls back to windows-1252,
+	// whereas pages without a declared charset are mostly UTF-8
+	if !certain && name == "windows-1252" && utf8.Valid(body) {
+		return bytes.NewReader(body), "utf-8"
+	}
+
+	return transform.NewReader(bytes.NewReader(body), enc.NewDecoder()), name
+}
@@ -0,0 +1,99 @@
+package http_test
+
+import (
+	"context"
+	h "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func encode(enc encoding.Encoding, str string) []byte {
+	out, err := enc.NewEncoder().Bytes([]byte(str))
+
+	if err != nil {
+		panic(err)
+	}
+
+	return out
+}
+
+func TestCharset(t *testing.T) {
+	cyrillic := encode(charmap.Windows1251, `<html><head><title>Привет</title></head><body></body></html>`)
+	japaneseMeta := encode(japanese.ShiftJIS, `<html><head><meta charset="Shift_JIS"><title>こんにちは</title></head><body></body></html>`)
+
+	mux := h.NewServeMux()
+	mux.HandleFunc("/header", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=windows-1251")
+		w.Write(cyrillic)
+	})
+	mux.HandleFunc("/meta", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write(japaneseMeta)
+	})
+	mux.HandleFunc("/bom", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=windows-1252")
+		w.Write(append([]byte("\xef\xbb\xbf"), `<html><head><title>Grüße</title></head><body></body></html>`...))
+	})
+	mux.HandleFunc("/undeclared", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<html><head><title>Grüße</title></head><body></body></html>`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	drv := http.NewDriver()
+
+	open := func(path string) drivers.HTMLPage {
+		page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + path})
+
+		So(err, ShouldBeNil)
+
+		return page
+	}
+
+	Convey("Should decode a page by a charset of Content-Type header", t, func() {
+		page := open("/header")
+
+		So(innerText(page, "title"), ShouldEqual, values.NewString("Привет"))
+		So(getIn(page, "response", "charset"), ShouldEqual, values.NewString("windows-1251"))
+	})
+
+	Convey("Should decode a page by a charset of <meta> tag", t, func() {
+		page := open("/meta")
+
+		So(innerText(page, "title"), ShouldEqual, values.NewString("こんにちは"))
+		So(getIn(page, "response", "charset"), ShouldEqual, values.NewString("shift_jis"))
+	})
+
+	Convey("Should prefer BOM over Content-Type header", t, func() {
+		page := open("/bom")
+
+		So(innerText(page, "title"), ShouldEqual, values.NewString("Grüße"))
+		So(getIn(page, "response", "charset"), ShouldEqual, values.NewString("utf-8"))
+	})
+
+	Convey("Should compare responses by charsets", t, func() {
+		utf8 := drivers.HTTPResponse{StatusCode: 200, Charset: "utf-8"}
+		sjis := drivers.HTTPResponse{StatusCode: 200, Charset: "shift_jis"}
+
+		So(utf8.Compare(sjis), ShouldEqual, 1)
+		So(sjis.Compare(utf8), ShouldEqual, -1)
+		So(utf8.Compare(utf8.Copy()), ShouldEqual, 0)
+	})
+
+	Convey("Should treat a page without a declared charset as UTF-8", t, func() {
+		page := open("/undeclared")
+
+		So(innerText(page, "title"), ShouldEqual, values.NewString("Grüße"))
+		So(getIn(page, "response", "charset"), ShouldEqual, values.NewString("utf-8"))
+	})
+}
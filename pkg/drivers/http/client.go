@@ -0,0 +1,192 @@
+package http
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MontFerret/ferret/pkg/drivers/common"
+)
+
+// client sends requests and retries them on network errors and retryable status codes,
+// if their methods are retryable.
+type client struct {
+	http    *http.Client
+	options *Options
+}
+
+func newClient(options *Options) *client {
+	hc := &http.Client{
+		CheckRedirect: options.redirectPolicy.check,
+	}
+
+	transport := newTransport(options)
+
+	if options.rateLimit != nil {
+		transport = &limitedTransport{
+			base:    transport,
+			limiter: newLimiter(*options.rateLimit),
+		}
+	}
+
+	// cached responses are not rate limited
+	if options.cacheDir != "" {
+		transport = newCachingTransport(transport, options.cacheDir, options.cacheMode)
+	}
+
+	// everything the driver gets is recorded
+	if options.recorder != nil {
+		transport = &recordingTransport{base: transport, recorder: options.recorder}
+	}
+
+	// replayed responses need neither network nor limits
+	if options.replay != nil {
+		transport = options.replay
+	}
+
+	hc.Transport = transport
+
+	if options.cookieJar != nil {
+		hc.Jar = options.cookieJar
+	}
+
+	return &client{hc, options}
+}
+
+func (c *client) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attempts := c.options.maxRetries
+
+	if attempts <= 0 || !c.isRetryableMethod(req.Method) {
+		attempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+		// a body is drained by each attempt
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+
+			if err != nil {
+				return nil, err
+			}
+
+			req.Body = body
+		}
+
+		resp, err := c.http.Do(req)
+
+		if err == nil && !c.isRetryable(resp.StatusCode) {
+			return resp, nil
+		}
+
+		if attempt == attempts || ctx.Err() != nil || isPermanent(err) {
+			return resp, err
+		}
+
+		delay := c.options.backoff(attempt)
+
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				delay = after
+			}
+		}
+
+		// there is no point in waiting beyond the deadline
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return resp, err
+		}
+
+		if resp != nil {
+			// drained bodies let connections be reused
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// isPermanent reports whether an error cannot be fixed by retrying.
+func isPermanent(err error) bool {
+	if e, ok := err.(*url.Error); ok {
+		err = e.Err
+	}
+
+	cause := errors.Cause(err)
+
+	return cause == common.ErrDisallowedByRobots || cause == ErrNotCached ||
+		cause == ErrRedirectNotAllowed || cause == ErrNotRecorded
+}
+
+func (c *client) isRetryable(code int) bool {
+	for _, retryable := range c.options.retryStatusCodes {
+		if retryable == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *client) isRetryableMethod(method string) bool {
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	for _, retryable := range c.options.retryMethods {
+		if strings.EqualFold(retryable, method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryAfter returns a delay requested by the Retry-After header of 429 and 503 responses.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	header := strings.TrimSpace(resp.Header.Get("Retry-After"))
+
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(header)
+
+	if err != nil {
+		return 0, false
+	}
+
+	delay := time.Until(date)
+
+	if delay < 0 {
+		delay = 0
+	}
+
+	return delay, true
+}
@@ -0,0 +1,169 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	h "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// newFlakyServer returns a server which responds with a given status code to the first requests.
+func newFlakyServer(failures int32, code int, retryAfter string) (*httptest.Server, *int32) {
+	var requests int32
+
+	server := httptest.NewServer(h.HandlerFunc(func(w h.ResponseWriter, _ *h.Request) {
+		if atomic.AddInt32(&requests, 1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+
+			w.WriteHeader(code)
+
+			return
+		}
+
+		fmt.Fprint(w, `<html><head><title>OK</title></head><body></body></html>`)
+	}))
+
+	return server, &requests
+}
+
+func TestClient(t *testing.T) {
+	Convey("Retries", t, func() {
+		Convey("Should retry 429 responses after Retry-After", func() {
+			server, requests := newFlakyServer(2, h.StatusTooManyRequests, "0")
+			defer server.Close()
+
+			_, err := http.NewDriver().Open(context.Background(), drivers.OpenPageParams{URL: server.URL})
+
+			So(err, ShouldBeNil)
+			So(atomic.LoadInt32(requests), ShouldEqual, 3)
+		})
+
+		Convey("Should not wait for Retry-After beyond the deadline", func() {
+			server, requests := newFlakyServer(1, h.StatusServiceUnavailable, "3600")
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			started := time.Now()
+			_, err := http.NewDriver().Open(ctx, drivers.OpenPageParams{URL: server.URL})
+
+			So(err, ShouldNotBeNil)
+			So(time.Since(started), ShouldBeLessThan, time.Second)
+			So(atomic.LoadInt32(requests), ShouldEqual, 1)
+		})
+
+		Convey("Should retry given status codes only", func() {
+			server, requests := newFlakyServer(1, h.StatusServiceUnavailable, "0")
+			defer server.Close()
+
+			drv := http.NewDriver(http.WithRetryStatusCodes(h.StatusTooManyRequests))
+			_, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL})
+
+			So(err, ShouldNotBeNil)
+			So(atomic.LoadInt32(requests), ShouldEqual, 1)
+		})
+
+		Convey("Should not retry non-idempotent methods by default", func() {
+			server, requests := newFlakyServer(1, h.StatusServiceUnavailable, "0")
+			defer server.Close()
+
+			params := drivers.OpenPageParams{URL: server.URL, Method: h.MethodPost, Body: []byte("q=1")}
+
+			_, err := http.NewDriver().Open(context.Background(), params)
+
+			So(err, ShouldNotBeNil)
+			So(atomic.LoadInt32(requests), ShouldEqual, 1)
+
+			_, err = http.NewDriver(http.WithRetryMethods(h.MethodGet, h.MethodPost)).Open(context.Background(), params)
+
+			So(err, ShouldBeNil)
+			So(atomic.LoadInt32(requests), ShouldEqual, 2)
+		})
+
+		Convey("Should retry downloads", func() {
+			server, requests := newFlakyServer(1, h.StatusServiceUnavailable, "0")
+			defer server.Close()
+
+			data, err := http.NewDriver().Download(context.Background(), server.URL)
+
+			So(err, ShouldBeNil)
+			So(string(data), ShouldContainSubstring, "OK")
+			So(atomic.LoadInt32(requests), ShouldEqual, 2)
+		})
+	})
+
+	Convey("Rate limits", t, func() {
+		Convey("Should limit requests per second", func() {
+			server, _ := newFlakyServer(0, 0, "")
+			defer server.Close()
+
+			drv := http.NewDriver(http.WithRateLimit(http.RateLimit{RequestsPerSecond: 20}))
+			started := time.Now()
+
+			for i := 0; i < 4; i++ {
+				_, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL})
+
+				So(err, ShouldBeNil)
+			}
+
+			So(time.Since(started), ShouldBeGreaterThanOrEqualTo, 150*time.Millisecond)
+		})
+
+		Convey("Should limit concurrent requests", func() {
+			var current, max int32
+
+			server := httptest.NewServer(h.HandlerFunc(func(w h.ResponseWriter, _ *h.Request) {
+				n := atomic.AddInt32(&current, 1)
+				defer atomic.AddInt32(&current, -1)
+
+				for {
+					m := atomic.LoadInt32(&max)
+
+					if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+						break
+					}
+				}
+
+				time.Sleep(20 * time.Millisecond)
+				fmt.Fprint(w, `<html><head><title>OK</title></head><body></body></html>`)
+			}))
+			defer server.Close()
+
+			drv := http.NewDriver(http.WithRateLimit(http.RateLimit{MaxConcurrent: 2}))
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 6)
+
+			for i := 0; i < 6; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					_, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL})
+					errs <- err
+				}()
+			}
+
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				So(err, ShouldBeNil)
+			}
+
+			So(atomic.LoadInt32(&max), ShouldBeLessThanOrEqualTo, 2)
+		})
+	})
+}
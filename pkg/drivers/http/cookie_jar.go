@@ -0,0 +1,302 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+)
+
+type (
+	cookieKey struct {
+		domain string
+		path   string
+		name   string
+	}
+
+	// cookieRecord is a cookie together with the URL of the response which has set it.
+	cookieRecord struct {
+		URL      string    `json:"url"`
+		Name     string    `json:"name"`
+		Value    string    `json:"value"`
+		Path     string    `json:"path,omitempty"`
+		Domain   string    `json:"domain,omitempty"`
+		Expires  time.Time `json:"expires,omitempty"`
+		Secure   bool      `json:"secure,omitempty"`
+		HTTPOnly bool      `json:"httpOnly,omitempty"`
+		SameSite string    `json:"sameSite,omitempty"`
+	}
+
+	// CookieJar keeps cookies set by responses and sends them with subsequent requests
+	// according to RFC 6265 domain and path matching.
+	// Unlike cookiejar.Jar, it can be exported and imported, so that sessions survive between runs.
+	CookieJar struct {
+		mu      sync.Mutex
+		jar     *cookiejar.Jar
+		records map[cookieKey]*cookieRecord
+	}
+)
+
+func NewCookieJar() *CookieJar {
+	// the error is always nil
+	jar, _ := cookiejar.New(nil)
+
+	return &CookieJar{
+		jar:     jar,
+		records: make(map[cookieKey]*cookieRecord),
+	}
+}
+
+// LoadCookieJar returns a cookie jar with cookies imported from a given JSON file.
+// A missing file results in an empty jar.
+func LoadCookieJar(path string) (*CookieJar, error) {
+	jar := NewCookieJar()
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		if os.IsNotExist(err) {
+			return jar, nil
+		}
+
+		return nil, err
+	}
+
+	defer file.Close()
+
+	if err := jar.Import(file); err != nil {
+		return nil, err
+	}
+
+	return jar, nil
+}
+
+func (j *CookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.jar.SetCookies(u, cookies)
+
+	now := time.Now()
+
+	for _, c := range cookies {
+		key := newCookieKey(u, c)
+
+		// a cookie gets removed by setting it expired
+		if c.MaxAge < 0 || (!c.Expires.IsZero() && c.Expires.Before(now)) {
+			delete(j.records, key)
+
+			continue
+		}
+
+		record := newCookieRecord(u, c)
+
+		if c.MaxAge > 0 {
+			record.Expires = now.Add(time.Duration(c.MaxAge) * time.Second)
+		}
+
+		j.records[key] = record
+	}
+}
+
+func (j *CookieJar) Cookies(u *url.URL) []*http.Cookie {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.jar.Cookies(u)
+}
+
+// HTTPCookies returns cookies which are sent to a given URL with all their attributes.
+func (j *CookieJar) HTTPCookies(u *url.URL) []drivers.HTTPCookie {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	matched := j.jar.Cookies(u)
+	res := make([]drivers.HTTPCookie, 0, len(matched))
+
+	for _, c := range matched {
+		record := j.find(u, c)
+
+		if record == nil {
+			res = append(res, drivers.HTTPCookie{Name: c.Name, Value: c.Value})
+
+			continue
+		}
+
+		res = append(res, record.toHTTPCookie())
+	}
+
+	return res
+}
+
+// find returns a record of a cookie matched by the underlying jar.
+// The jar returns names and values only, so the record with the longest matching path is picked,
+// which is the order the jar uses.
+func (j *CookieJar) find(u *url.URL, c *http.Cookie) *cookieRecord {
+	var found *cookieRecord
+
+	for key, record := range j.records {
+		if key.name != c.Name || record.Value != c.Value {
+			continue
+		}
+
+		if !domainMatch(u.Hostname(), key.domain) || !strings.HasPrefix(u.Path, strings.TrimSuffix(key.path, "/")) {
+			continue
+		}
+
+		if found == nil || len(record.Path) > len(found.Path) {
+			found = record
+		}
+	}
+
+	return found
+}
+
+// Export writes cookies which have not expired yet as JSON.
+func (j *CookieJar) Export(w io.Writer) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	now := time.Now()
+	records := make([]*cookieRecord, 0, len(j.records))
+
+	for _, record := range j.records {
+		if !record.Expires.IsZero() && record.Expires.Before(now) {
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(records)
+}
+
+// Import reads cookies written by Export and adds them to the jar.
+func (j *CookieJar) Import(r io.Reader) error {
+	records := make([]*cookieRecord, 0, 10)
+
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		u, err := url.Parse(record.URL)
+
+		if err != nil {
+			return err
+		}
+
+		j.SetCookies(u, []*http.Cookie{record.toCookie()})
+	}
+
+	return nil
+}
+
+// Save exports cookies to a given file.
+func (j *CookieJar) Save(path string) error {
+	file, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	if err := j.Export(file); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func newCookieKey(u *url.URL, c *http.Cookie) cookieKey {
+	domain := strings.TrimPrefix(strings.ToLower(c.Domain), ".")
+
+	if domain == "" {
+		domain = u.Hostname()
+	}
+
+	path := c.Path
+
+	if path == "" || !strings.HasPrefix(path, "/") {
+		path = defaultCookiePath(u.Path)
+	}
+
+	return cookieKey{domain, path, c.Name}
+}
+
+// defaultCookiePath returns the directory of a request path as defined by RFC 6265 section 5.1.4.
+func defaultCookiePath(path string) string {
+	if path == "" || path[0] != '/' {
+		return "/"
+	}
+
+	i := strings.LastIndex(path, "/")
+
+	if i == 0 {
+		return "/"
+	}
+
+	return path[:i]
+}
+
+func domainMatch(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
+
+func newCookieRecord(u *url.URL, c *http.Cookie) *cookieRecord {
+	record := &cookieRecord{
+		URL:      u.String(),
+		Name:     c.Name,
+		Value:    c.Value,
+		Path:     c.Path,
+		Domain:   c.Domain,
+		Expires:  c.Expires,
+		Secure:   c.Secure,
+		HTTPOnly: c.HttpOnly,
+	}
+
+	switch c.SameSite {
+	case http.SameSiteLaxMode:
+		record.SameSite = "Lax"
+	case http.SameSiteStrictMode:
+		record.SameSite = "Strict"
+	}
+
+	return record
+}
+
+func (r *cookieRecord) toCookie() *http.Cookie {
+	c := &http.Cookie{
+		Name:     r.Name,
+		Value:    r.Value,
+		Path:     r.Path,
+		Domain:   r.Domain,
+		Expires:  r.Expires,
+		Secure:   r.Secure,
+		HttpOnly: r.HTTPOnly,
+	}
+
+	switch r.SameSite {
+	case "Lax":
+		c.SameSite = http.SameSiteLaxMode
+	case "Strict":
+		c.SameSite = http.SameSiteStrictMode
+	}
+
+	return c
+}
+
+func (r *cookieRecord) toHTTPCookie() drivers.HTTPCookie {
+	return fromCookie(r.toCookie())
+}
@@ -0,0 +1,126 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	h "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newSessionServer() *httptest.Server {
+	mux := h.NewServeMux()
+	mux.HandleFunc("/login", func(w h.ResponseWriter, r *h.Request) {
+		h.SetCookie(w, &h.Cookie{Name: "session", Value: "secret", Path: "/", HttpOnly: true})
+		h.SetCookie(w, &h.Cookie{Name: "admin", Value: "yes", Path: "/admin"})
+		h.Redirect(w, r, "/profile", h.StatusFound)
+	})
+	mux.HandleFunc("/logout", func(w h.ResponseWriter, _ *h.Request) {
+		h.SetCookie(w, &h.Cookie{Name: "session", Path: "/", MaxAge: -1})
+		fmt.Fprint(w, `<html><head><title>Bye</title></head><body></body></html>`)
+	})
+	mux.HandleFunc("/profile", func(w h.ResponseWriter, r *h.Request) {
+		title := "Anonymous"
+
+		if c, err := r.Cookie("session"); err == nil {
+			title = c.Value
+		}
+
+		fmt.Fprintf(w, `<html><head><title>%s</title></head><body></body></html>`, title)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func getCookies(page drivers.HTMLPage) map[string]drivers.HTTPCookie {
+	arr, err := page.GetCookies(context.Background())
+
+	So(err, ShouldBeNil)
+
+	res := make(map[string]drivers.HTTPCookie)
+
+	arr.ForEach(func(value core.Value, _ int) bool {
+		c := value.(drivers.HTTPCookie)
+		res[c.Name] = c
+
+		return true
+	})
+
+	return res
+}
+
+func TestCookieJar(t *testing.T) {
+	server := newSessionServer()
+	defer server.Close()
+
+	open := func(drv *http.Driver, path string) drivers.HTMLPage {
+		page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + path})
+
+		So(err, ShouldBeNil)
+
+		return page
+	}
+
+	Convey("Should keep a session between pages", t, func() {
+		drv := http.NewDriver(http.WithCookieJar(http.NewCookieJar()))
+
+		page := open(drv, "/login")
+		So(getIn(page, "title"), ShouldEqual, values.NewString("secret"))
+
+		cookies := getCookies(page)
+		So(cookies, ShouldContainKey, "session")
+		So(cookies, ShouldNotContainKey, "admin")
+		So(cookies["session"].HTTPOnly, ShouldBeTrue)
+		So(cookies["session"].Path, ShouldEqual, "/")
+
+		page = open(drv, "/profile")
+		So(getIn(page, "title"), ShouldEqual, values.NewString("secret"))
+
+		Convey("Should delete expired cookies", func() {
+			page := open(drv, "/logout")
+			So(getCookies(page), ShouldNotContainKey, "session")
+
+			page = open(drv, "/profile")
+			So(getIn(page, "title"), ShouldEqual, values.NewString("Anonymous"))
+		})
+	})
+
+	Convey("Should not share cookies between pages without a jar", t, func() {
+		drv := http.NewDriver()
+
+		page := open(drv, "/login")
+		So(getIn(page, "title"), ShouldEqual, values.NewString("Anonymous"))
+
+		page = open(drv, "/logout")
+		So(getCookies(page), ShouldBeEmpty)
+	})
+
+	Convey("Should export and import cookies", t, func() {
+		jar := http.NewCookieJar()
+		open(http.NewDriver(http.WithCookieJar(jar)), "/login")
+
+		buf := new(bytes.Buffer)
+		So(jar.Export(buf), ShouldBeNil)
+
+		imported := http.NewCookieJar()
+		So(imported.Import(buf), ShouldBeNil)
+
+		page := open(http.NewDriver(http.WithCookieJar(imported)), "/profile")
+		So(getIn(page, "title"), ShouldEqual, values.NewString("secret"))
+		So(getCookies(page)["session"].HTTPOnly, ShouldBeTrue)
+
+		Convey("Should load an empty jar from a missing file", func() {
+			jar, err := http.LoadCookieJar("does-not-exist.json")
+
+			So(err, ShouldBeNil)
+			So(jar, ShouldNotBeNil)
+		})
+	})
+}
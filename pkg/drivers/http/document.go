@@ -39,7 +39,7 @@ func NewHTMLDocument(
 		return nil, core.Error(core.ErrMissedArgument, "document root selection")
 	}
 
-	el, err := NewHTMLElement(node.Selection)
+	el, err := newHTMLElement(node.Selection, url)
 
 	if err != nil {
 		return nil, err
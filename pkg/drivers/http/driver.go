@@ -3,8 +3,10 @@ package http
 import (
 	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
 	"net/http"
-	"net/url"
+	"strings"
 
 	"github.com/MontFerret/ferret/pkg/drivers"
 	"github.com/MontFerret/ferret/pkg/drivers/common"
@@ -12,58 +14,62 @@ import (
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 	"github.com/PuerkitoBio/goquery"
 	"github.com/pkg/errors"
-	"github.com/sethgrid/pester"
 )
 
 const DriverName = "http"
 
 type Driver struct {
-	client  *pester.Client
+	client  *client
 	options *Options
+	robots  *common.RobotsPolicy
 }
 
 func NewDriver(opts ...Option) *Driver {
 	drv := new(Driver)
 	drv.options = newOptions(opts)
+	drv.client = newClient(drv.options)
 
-	if drv.options.proxy == "" {
-		drv.client = pester.New()
-	} else {
-		client, err := newClientWithProxy(drv.options)
+	if drv.options.robots {
+		// robots.txt is fetched without checking its own redirects
+		robotsClient := &http.Client{Transport: drv.client.http.Transport}
+		drv.robots = common.NewRobotsPolicy(drv.options.userAgent, robotsClient)
+
+		checkRedirect := drv.client.http.CheckRedirect
+
+		drv.client.http.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			if err := checkRedirect(req, via); err != nil {
+				return err
+			}
 
-		if err != nil {
-			drv.client = pester.New()
-		} else {
-			drv.client = pester.NewExtendedClient(client)
+			return drv.robots.Wait(req.Context(), req.URL.String())
 		}
 	}
 
-	drv.client.Concurrency = drv.options.concurrency
-	drv.client.MaxRetries = drv.options.maxRetries
-	drv.client.Backoff = drv.options.backoff
-
 	return drv
 }
 
-func newClientWithProxy(options *Options) (*http.Client, error) {
-	proxyURL, err := url.Parse(options.proxy)
+func (drv *Driver) Name() string {
+	return DriverName
+}
 
-	if err != nil {
+func (drv *Driver) Open(ctx context.Context, params drivers.OpenPageParams) (drivers.HTMLPage, error) {
+	if err := drv.checkRobots(ctx, params.URL); err != nil {
 		return nil, err
 	}
 
-	proxy := http.ProxyURL(proxyURL)
-	tr := &http.Transport{Proxy: proxy}
+	method := params.Method
 
-	return &http.Client{Transport: tr}, nil
-}
+	if method == "" {
+		method = http.MethodGet
+	}
 
-func (drv *Driver) Name() string {
-	return DriverName
-}
+	var body io.Reader
 
-func (drv *Driver) Open(ctx context.Context, params drivers.OpenPageParams) (drivers.HTMLPage, error) {
-	req, err := http.NewRequest(http.MethodGet, params.URL, nil)
+	if params.Body != nil {
+		body = bytes.NewReader(params.Body)
+	}
+
+	req, err := http.NewRequest(strings.ToUpper(method), params.URL, body)
 
 	if err != nil {
 		return nil, err
@@ -76,6 +82,10 @@ func (drv *Driver) Open(ctx context.Context, params drivers.OpenPageParams) (dri
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Pragma", "no-cache")
 
+	if params.ContentType != "" {
+		req.Header.Set("Content-Type", params.ContentType)
+	}
+
 	if params.Header != nil {
 		for k := range params.Header {
 			req.Header.Add(k, params.Header.Get(k))
@@ -88,12 +98,19 @@ func (drv *Driver) Open(ctx context.Context, params drivers.OpenPageParams) (dri
 		}
 	}
 
+	jar := drv.options.cookieJar
+
 	if params.Cookies != nil {
 		for _, c := range params.Cookies {
-			req.AddCookie(&http.Cookie{
-				Name:  c.Name,
-				Value: c.Value,
-			})
+			// the jar adds its cookies to the request by itself
+			if jar != nil {
+				jar.SetCookies(req.URL, []*http.Cookie{toCookie(c)})
+			} else {
+				req.AddCookie(&http.Cookie{
+					Name:  c.Name,
+					Value: c.Value,
+				})
+			}
 
 			logger.
 				Debug().
@@ -131,17 +148,184 @@ func (drv *Driver) Open(ctx context.Context, params drivers.OpenPageParams) (dri
 
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	if !isAllowedStatus(resp.StatusCode, params.AllowedStatusCodes) {
 		return nil, errors.New(resp.Status)
 	}
 
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	doc, charset, err := newDocument(resp)
 
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to parse a document %s", params.URL)
 	}
 
-	return NewHTMLPage(doc, params.URL, params.Cookies)
+	var cookies []drivers.HTTPCookie
+
+	if jar != nil {
+		cookies = jar.HTTPCookies(resp.Request.URL)
+	} else {
+		cookies = mergeCookies(params.Cookies, resp.Cookies())
+	}
+
+	// relative URLs of the page are resolved against the URL after redirects
+	page, err := NewHTMLPage(doc, resp.Request.URL.String(), cookies)
+
+	if err != nil {
+		return nil, err
+	}
+
+	page.response = newResponse(resp)
+	page.response.Charset = charset
+	page.observer = common.ObservePage(ctx, drv.Name(), params.URL)
+
+	return page, nil
+}
+
+func isAllowedStatus(code int, allowed []int) bool {
+	if len(allowed) == 0 {
+		return code == http.StatusOK
+	}
+
+	for _, c := range allowed {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toCookie(c drivers.HTTPCookie) *http.Cookie {
+	res := &http.Cookie{
+		Name:     c.Name,
+		Value:    c.Value,
+		Path:     c.Path,
+		Domain:   c.Domain,
+		Expires:  c.Expires,
+		MaxAge:   c.MaxAge,
+		Secure:   c.Secure,
+		HttpOnly: c.HTTPOnly,
+	}
+
+	switch c.SameSite {
+	case drivers.SameSiteLaxMode:
+		res.SameSite = http.SameSiteLaxMode
+	case drivers.SameSiteStrictMode:
+		res.SameSite = http.SameSiteStrictMode
+	}
+
+	return res
+}
+
+func fromCookie(c *http.Cookie) drivers.HTTPCookie {
+	res := drivers.HTTPCookie{
+		Name:     c.Name,
+		Value:    c.Value,
+		Path:     c.Path,
+		Domain:   c.Domain,
+		Expires:  c.Expires,
+		MaxAge:   c.MaxAge,
+		Secure:   c.Secure,
+		HTTPOnly: c.HttpOnly,
+	}
+
+	switch c.SameSite {
+	case http.SameSiteLaxMode:
+		res.SameSite = drivers.SameSiteLaxMode
+	case http.SameSiteStrictMode:
+		res.SameSite = drivers.SameSiteStrictMode
+	default:
+		res.SameSite = drivers.SameSiteDefaultMode
+	}
+
+	return res
+}
+
+// mergeCookies returns given cookies overridden by cookies set by a response.
+func mergeCookies(cookies []drivers.HTTPCookie, received []*http.Cookie) []drivers.HTTPCookie {
+	if len(received) == 0 {
+		return cookies
+	}
+
+	res := make([]drivers.HTTPCookie, 0, len(cookies)+len(received))
+
+	for _, c := range cookies {
+		overridden := false
+
+		for _, r := range received {
+			if r.Name == c.Name {
+				overridden = true
+
+				break
+			}
+		}
+
+		if !overridden {
+			res = append(res, c)
+		}
+	}
+
+	for _, r := range received {
+		// expired cookies are deleted
+		if r.MaxAge < 0 {
+			continue
+		}
+
+		res = append(res, fromCookie(r))
+	}
+
+	return res
+}
+
+func newResponse(resp *http.Response) *drivers.HTTPResponse {
+	res := &drivers.HTTPResponse{
+		URL:        resp.Request.URL.String(),
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Headers:    drivers.HTTPHeader(resp.Header),
+	}
+
+	// each redirected request keeps the response which has caused it
+	for req := resp.Request; req.Response != nil; req = req.Response.Request {
+		res.Redirects = append([]string{req.Response.Request.URL.String()}, res.Redirects...)
+	}
+
+	return res
+}
+
+// Download retrieves a resource by a given url.
+// Requests share retries and rate limits with pages opened by the driver.
+func (drv *Driver) Download(ctx context.Context, url string) ([]byte, error) {
+	if err := drv.checkRobots(ctx, url); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if ua := common.GetUserAgent(drv.options.userAgent); ua != "" {
+		req.Header.Set("User-Agent", ua)
+	}
+
+	resp, err := drv.client.Do(req.WithContext(ctx))
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download %s", url)
+	}
+
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (drv *Driver) checkRobots(ctx context.Context, url string) error {
+	if drv.robots == nil {
+		return nil
+	}
+
+	return drv.robots.Wait(ctx, url)
 }
 
 func (drv *Driver) Parse(_ context.Context, str values.String) (drivers.HTMLPage, error) {
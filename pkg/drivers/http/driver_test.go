@@ -0,0 +1,149 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	h "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func getIn(page drivers.HTMLPage, path ...string) core.Value {
+	segments := make([]core.Value, 0, len(path))
+
+	for _, s := range path {
+		segments = append(segments, values.NewString(s))
+	}
+
+	out, err := page.GetIn(context.Background(), segments)
+
+	So(err, ShouldBeNil)
+
+	return out
+}
+
+func innerText(page drivers.HTMLPage, selector string) values.String {
+	el := page.GetMainFrame().QuerySelector(context.Background(), values.NewString(selector))
+
+	So(el, ShouldImplement, (*drivers.HTMLElement)(nil))
+
+	return el.(drivers.HTMLElement).GetInnerText(context.Background())
+}
+
+func TestDriver(t *testing.T) {
+	mux := h.NewServeMux()
+	mux.HandleFunc("/ok", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, `<html><head><title>OK</title></head><body></body></html>`)
+	})
+	mux.HandleFunc("/missing", func(w h.ResponseWriter, _ *h.Request) {
+		w.WriteHeader(h.StatusNotFound)
+		fmt.Fprint(w, `<html><head><title>Not Found</title></head><body></body></html>`)
+	})
+	mux.HandleFunc("/echo", func(w h.ResponseWriter, r *h.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		fmt.Fprintf(
+			w,
+			`<html><head><title>%s</title></head><body><p id="type">%s</p><p id="body">%s</p></body></html>`,
+			r.Method,
+			r.Header.Get("Content-Type"),
+			body,
+		)
+	})
+	mux.HandleFunc("/first", func(w h.ResponseWriter, r *h.Request) {
+		h.Redirect(w, r, "/second", h.StatusFound)
+	})
+	mux.HandleFunc("/second", func(w h.ResponseWriter, r *h.Request) {
+		h.Redirect(w, r, "/ok", h.StatusMovedPermanently)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	Convey(".Open", t, func() {
+		drv := http.NewDriver()
+
+		Convey("Should expose a response", func() {
+			page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/ok"})
+
+			So(err, ShouldBeNil)
+
+			So(getIn(page, "response", "status"), ShouldEqual, values.NewInt(200))
+			So(getIn(page, "response", "url"), ShouldEqual, values.NewString(server.URL+"/ok"))
+			So(getIn(page, "response", "headers", "Content-Type"), ShouldEqual, values.NewString("text/html; charset=utf-8"))
+			So(getIn(page, "response", "redirects").(*values.Array).Length(), ShouldEqual, 0)
+			So(getIn(page, "title"), ShouldEqual, values.NewString("OK"))
+		})
+
+		Convey("Should expose redirects", func() {
+			page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/first"})
+
+			So(err, ShouldBeNil)
+
+			So(getIn(page, "response", "url"), ShouldEqual, values.NewString(server.URL+"/ok"))
+
+			redirects := getIn(page, "response", "redirects").(*values.Array)
+			So(redirects.Length(), ShouldEqual, 2)
+			So(redirects.Get(0), ShouldEqual, values.NewString(server.URL+"/first"))
+			So(redirects.Get(1), ShouldEqual, values.NewString(server.URL+"/second"))
+		})
+
+		Convey("Should return an error for status codes other than 200 by default", func() {
+			_, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/missing"})
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Should open pages with allowed status codes", func() {
+			page, err := drv.Open(context.Background(), drivers.OpenPageParams{
+				URL:                server.URL + "/missing",
+				AllowedStatusCodes: []int{200, 404},
+			})
+
+			So(err, ShouldBeNil)
+			So(getIn(page, "response", "status"), ShouldEqual, values.NewInt(404))
+			So(getIn(page, "response", "statusText"), ShouldEqual, values.NewString("404 Not Found"))
+
+			data, err := getIn(page, "response").(drivers.HTTPResponse).MarshalJSON()
+			So(err, ShouldBeNil)
+			So(string(data), ShouldContainSubstring, `"statusText":"404 Not Found"`)
+			So(getIn(page, "title"), ShouldEqual, values.NewString("Not Found"))
+
+			_, err = drv.Open(context.Background(), drivers.OpenPageParams{
+				URL:                server.URL + "/ok",
+				AllowedStatusCodes: []int{404},
+			})
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Should send a request with a given method and body", func() {
+			page, err := drv.Open(context.Background(), drivers.OpenPageParams{
+				URL:         server.URL + "/echo",
+				Method:      "POST",
+				Body:        []byte(`{"query":"foo"}`),
+				ContentType: "application/json",
+			})
+
+			So(err, ShouldBeNil)
+			So(getIn(page, "title"), ShouldEqual, values.NewString("POST"))
+			So(innerText(page, "#type"), ShouldEqual, values.NewString("application/json"))
+			So(innerText(page, "#body"), ShouldEqual, values.NewString(`{"query":"foo"}`))
+		})
+
+		Convey("Should send GET requests by default", func() {
+			page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/echo"})
+
+			So(err, ShouldBeNil)
+			So(getIn(page, "title"), ShouldEqual, values.NewString("GET"))
+		})
+	})
+}
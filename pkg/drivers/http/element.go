@@ -18,14 +18,20 @@ type HTMLElement struct {
 	attrs     *values.Object
 	styles    *values.Object
 	children  *values.Array
+	// url of the document the element belongs to, used for resolving relative urls
+	url string
 }
 
 func NewHTMLElement(node *goquery.Selection) (drivers.HTMLElement, error) {
+	return newHTMLElement(node, "")
+}
+
+func newHTMLElement(node *goquery.Selection, url string) (*HTMLElement, error) {
 	if node == nil {
 		return nil, core.Error(core.ErrMissedArgument, "element selection")
 	}
 
-	return &HTMLElement{node, nil, nil, nil}, nil
+	return &HTMLElement{node, nil, nil, nil, url}, nil
 }
 
 func (el *HTMLElement) MarshalJSON() ([]byte, error) {
@@ -75,7 +81,7 @@ func (el *HTMLElement) Hash() uint64 {
 }
 
 func (el *HTMLElement) Copy() core.Value {
-	c, _ := NewHTMLElement(el.selection.Clone())
+	c, _ := newHTMLElement(el.selection.Clone(), el.url)
 
 	return c
 }
@@ -279,7 +285,7 @@ func (el *HTMLElement) QuerySelector(_ context.Context, selector values.String)
 		return values.None
 	}
 
-	res, err := NewHTMLElement(selection)
+	res, err := newHTMLElement(selection, el.url)
 
 	if err != nil {
 		return values.None
@@ -298,7 +304,7 @@ func (el *HTMLElement) QuerySelectorAll(_ context.Context, selector values.Strin
 	arr := values.NewArray(selection.Length())
 
 	selection.Each(func(i int, selection *goquery.Selection) {
-		el, err := NewHTMLElement(selection)
+		el, err := newHTMLElement(selection, el.url)
 
 		if err == nil {
 			arr.Push(el)
@@ -474,7 +480,7 @@ func (el *HTMLElement) parseChildren() *values.Array {
 	arr := values.NewArray(10)
 
 	children.Each(func(i int, selection *goquery.Selection) {
-		child, err := NewHTMLElement(selection)
+		child, err := newHTMLElement(selection, el.url)
 
 		if err == nil {
 			arr.Push(child)
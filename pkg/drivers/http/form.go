@@ -0,0 +1,197 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// SubmitForm sends a given form element the way a browser does and returns the resulting page.
+// Current values of form controls are overridden by given fields.
+func (drv *Driver) SubmitForm(
+	ctx context.Context,
+	form drivers.HTMLElement,
+	fields *values.Object,
+) (drivers.HTMLPage, error) {
+	el, ok := form.(*HTMLElement)
+
+	if !ok {
+		return nil, core.Errorf(core.ErrInvalidArgument, "expected an element of a static page")
+	}
+
+	params, err := newFormParams(el, fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return drv.Open(ctx, params)
+}
+
+func newFormParams(form *HTMLElement, fields *values.Object) (drivers.OpenPageParams, error) {
+	var params drivers.OpenPageParams
+
+	if !strings.EqualFold(goquery.NodeName(form.selection), "form") {
+		return params, core.Errorf(
+			core.ErrInvalidArgument,
+			"expected a form element, but got %s",
+			goquery.NodeName(form.selection),
+		)
+	}
+
+	base, err := url.Parse(form.url)
+
+	if err != nil {
+		return params, err
+	}
+
+	// a <base> element of the document overrides the document URL
+	if href, ok := form.selection.Parents().Last().Find("base[href]").First().Attr("href"); ok {
+		base, err = base.Parse(strings.TrimSpace(href))
+
+		if err != nil {
+			return params, err
+		}
+	}
+
+	action, err := base.Parse(strings.TrimSpace(form.selection.AttrOr("action", "")))
+
+	if err != nil {
+		return params, err
+	}
+
+	data := formValues(form.selection)
+
+	if fields != nil {
+		fields.ForEach(func(value core.Value, key string) bool {
+			data.Del(key)
+
+			switch v := value.(type) {
+			case *values.Array:
+				v.ForEach(func(item core.Value, _ int) bool {
+					data.Add(key, item.String())
+
+					return true
+				})
+			default:
+				// fields are removed by setting them to none
+				if value != values.None {
+					data.Add(key, value.String())
+				}
+			}
+
+			return true
+		})
+	}
+
+	params.URL = action.String()
+	params.Method = strings.ToUpper(form.selection.AttrOr("method", "GET"))
+
+	if params.Method != "POST" {
+		// data of GET forms replaces the query of the action
+		params.Method = "GET"
+		action.RawQuery = data.Encode()
+		params.URL = action.String()
+
+		return params, nil
+	}
+
+	if strings.EqualFold(form.selection.AttrOr("enctype", ""), "multipart/form-data") {
+		buf := new(bytes.Buffer)
+		writer := multipart.NewWriter(buf)
+
+		keys := make([]string, 0, len(data))
+
+		for key := range data {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for _, item := range data[key] {
+				if err := writer.WriteField(key, item); err != nil {
+					return params, err
+				}
+			}
+		}
+
+		if err := writer.Close(); err != nil {
+			return params, err
+		}
+
+		params.Body = buf.Bytes()
+		params.ContentType = writer.FormDataContentType()
+
+		return params, nil
+	}
+
+	params.Body = []byte(data.Encode())
+	params.ContentType = "application/x-www-form-urlencoded"
+
+	return params, nil
+}
+
+// formValues returns values of form controls which are sent on submission.
+func formValues(form *goquery.Selection) url.Values {
+	data := url.Values{}
+
+	form.Find("input, select, textarea").Each(func(_ int, control *goquery.Selection) {
+		name, exists := control.Attr("name")
+
+		if !exists || name == "" {
+			return
+		}
+
+		if _, disabled := control.Attr("disabled"); disabled {
+			return
+		}
+
+		switch goquery.NodeName(control) {
+		case "select":
+			options := control.Find("option")
+			selected := options.FilterFunction(func(_ int, option *goquery.Selection) bool {
+				_, exists := option.Attr("selected")
+
+				return exists
+			})
+
+			// the first option of a single select is selected by default
+			if selected.Length() == 0 {
+				if _, multiple := control.Attr("multiple"); multiple {
+					return
+				}
+
+				selected = options.First()
+			}
+
+			selected.Each(func(_ int, option *goquery.Selection) {
+				data.Add(name, option.AttrOr("value", strings.TrimSpace(option.Text())))
+			})
+		case "textarea":
+			data.Add(name, control.Text())
+		default:
+			switch strings.ToLower(control.AttrOr("type", "text")) {
+			case "checkbox", "radio":
+				if _, checked := control.Attr("checked"); checked {
+					data.Add(name, control.AttrOr("value", "on"))
+				}
+			case "submit", "button", "reset", "image", "file":
+				// buttons are sent only when clicked and files are not supported
+			default:
+				data.Add(name, control.AttrOr("value", ""))
+			}
+		}
+	})
+
+	return data
+}
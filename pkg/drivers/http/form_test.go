@@ -0,0 +1,150 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	h "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const formPage = `
+<html>
+	<head><title>Search</title></head>
+	<body>
+		<form id="get" action="/results">
+			<input type="hidden" name="token" value="abc" />
+			<input type="text" name="q" value="" />
+			<input type="checkbox" name="exact" value="1" />
+			<input type="checkbox" name="safe" checked />
+			<input type="text" name="disabled" value="x" disabled />
+			<select name="lang">
+				<option value="en">English</option>
+				<option value="fr" selected>French</option>
+			</select>
+			<input type="submit" name="go" value="Search" />
+		</form>
+		<form id="post" method="post" action="results">
+			<textarea name="comment">hello</textarea>
+			<input type="text" name="q" value="bar" />
+		</form>
+		<form id="multipart" method="POST" action="/results" enctype="multipart/form-data">
+			<input type="text" name="q" value="baz" />
+		</form>
+		<div id="not-form"></div>
+	</body>
+</html>
+`
+
+func TestSubmitForm(t *testing.T) {
+	mux := h.NewServeMux()
+	mux.HandleFunc("/search/form", func(w h.ResponseWriter, _ *h.Request) {
+		fmt.Fprint(w, formPage)
+	})
+	mux.HandleFunc("/moved", func(w h.ResponseWriter, r *h.Request) {
+		h.Redirect(w, r, "/search/form", h.StatusFound)
+	})
+	mux.HandleFunc("/search/based", func(w h.ResponseWriter, _ *h.Request) {
+		fmt.Fprint(w, `<html><head><base href="/other/" /></head><body><form id="based" action="results"></form></body></html>`)
+	})
+	mux.HandleFunc("/", func(w h.ResponseWriter, r *h.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		fmt.Fprintf(
+			w,
+			`<html><head><title>%s</title></head><body><p id="url">%s</p><p id="body">%s</p></body></html>`,
+			r.Method,
+			r.URL.String(),
+			body,
+		)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	drv := http.NewDriver()
+
+	formAt := func(path, id string) drivers.HTMLElement {
+		page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + path})
+
+		So(err, ShouldBeNil)
+
+		el := page.GetMainFrame().QuerySelector(context.Background(), values.NewString(id))
+
+		So(el, ShouldImplement, (*drivers.HTMLElement)(nil))
+
+		return el.(drivers.HTMLElement)
+	}
+
+	form := func(id string) drivers.HTMLElement {
+		return formAt("/search/form", id)
+	}
+
+	Convey(".SubmitForm", t, func() {
+		Convey("Should submit a GET form with current values", func() {
+			page, err := drv.SubmitForm(context.Background(), form("#get"), nil)
+
+			So(err, ShouldBeNil)
+			So(getIn(page, "title"), ShouldEqual, values.NewString("GET"))
+			So(innerText(page, "#url"), ShouldEqual, values.NewString("/results?lang=fr&q=&safe=on&token=abc"))
+		})
+
+		Convey("Should merge given values", func() {
+			fields := values.NewObjectWith(
+				values.NewObjectProperty("q", values.NewString("ferret")),
+				values.NewObjectProperty("exact", values.NewArray(0)),
+				values.NewObjectProperty("safe", values.None),
+				values.NewObjectProperty("lang", values.NewArrayWith(values.NewString("en"), values.NewString("de"))),
+			)
+
+			page, err := drv.SubmitForm(context.Background(), form("#get"), fields)
+
+			So(err, ShouldBeNil)
+			So(innerText(page, "#url"), ShouldEqual, values.NewString("/results?lang=en&lang=de&q=ferret&token=abc"))
+		})
+
+		Convey("Should submit a POST form to a relative action", func() {
+			page, err := drv.SubmitForm(context.Background(), form("#post"), nil)
+
+			So(err, ShouldBeNil)
+			So(getIn(page, "title"), ShouldEqual, values.NewString("POST"))
+			So(innerText(page, "#url"), ShouldEqual, values.NewString("/search/results"))
+			So(innerText(page, "#body"), ShouldEqual, values.NewString("comment=hello&q=bar"))
+		})
+
+		Convey("Should resolve an action against a URL after redirects", func() {
+			page, err := drv.SubmitForm(context.Background(), formAt("/moved", "#post"), nil)
+
+			So(err, ShouldBeNil)
+			So(innerText(page, "#url"), ShouldEqual, values.NewString("/search/results"))
+		})
+
+		Convey("Should resolve an action against a base element", func() {
+			page, err := drv.SubmitForm(context.Background(), formAt("/search/based", "#based"), nil)
+
+			So(err, ShouldBeNil)
+			So(innerText(page, "#url"), ShouldEqual, values.NewString("/other/results"))
+		})
+
+		Convey("Should submit a multipart form", func() {
+			page, err := drv.SubmitForm(context.Background(), form("#multipart"), nil)
+
+			So(err, ShouldBeNil)
+			So(getIn(page, "title"), ShouldEqual, values.NewString("POST"))
+			So(innerText(page, "#body").String(), ShouldContainSubstring, `name="q"`)
+			So(innerText(page, "#body").String(), ShouldContainSubstring, "baz")
+		})
+
+		Convey("Should return an error for elements other than forms", func() {
+			_, err := drv.SubmitForm(context.Background(), form("#not-form"), nil)
+
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,235 @@
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// HAR 1.2 format, see http://www.softwareishard.com/blog/har-12-spec/.
+// Only fields needed to replay responses are filled, along with required ones.
+type (
+	harFile struct {
+		Log harLog `json:"log"`
+	}
+
+	harLog struct {
+		Version string     `json:"version"`
+		Creator harCreator `json:"creator"`
+		Entries []harEntry `json:"entries"`
+	}
+
+	harCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	harEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         harTimings  `json:"timings"`
+	}
+
+	harRequest struct {
+		Method      string         `json:"method"`
+		URL         string         `json:"url"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		QueryString []harNameValue `json:"queryString"`
+		PostData    *harPostData   `json:"postData,omitempty"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	harResponse struct {
+		Status      int            `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		Content     harContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	harNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	harPostData struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+
+	harContent struct {
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+		Encoding string `json:"encoding,omitempty"`
+	}
+
+	harTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+)
+
+func newHARFile(entries []harEntry) *harFile {
+	if entries == nil {
+		entries = make([]harEntry, 0)
+	}
+
+	return &harFile{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "ferret", Version: "1.0"},
+			Entries: entries,
+		},
+	}
+}
+
+func readHARFile(r io.Reader) (*harFile, error) {
+	file := new(harFile)
+
+	if err := json.NewDecoder(r).Decode(file); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (f *harFile) write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(f)
+}
+
+func newHAREntry(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, start time.Time, elapsed time.Duration) harEntry {
+	ms := float64(elapsed) / float64(time.Millisecond)
+
+	entry := harEntry{
+		StartedDateTime: start.Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         req.URL.String(),
+			HTTPVersion: "HTTP/1.1",
+			Cookies:     make([]harNameValue, 0, len(req.Cookies())),
+			Headers:     toHARHeaders(req.Header),
+			QueryString: make([]harNameValue, 0, len(req.URL.Query())),
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: harResponse{
+			Status:      resp.StatusCode,
+			StatusText:  strings.TrimSpace(strings.TrimPrefix(resp.Status, strconv.Itoa(resp.StatusCode))),
+			HTTPVersion: resp.Proto,
+			Cookies:     make([]harNameValue, 0, len(resp.Cookies())),
+			Headers:     toHARHeaders(resp.Header),
+			Content:     toHARContent(respBody, resp.Header.Get("Content-Type")),
+			RedirectURL: resp.Header.Get("Location"),
+			HeadersSize: -1,
+			BodySize:    len(respBody),
+		},
+		Timings: harTimings{Wait: ms},
+	}
+
+	for _, c := range req.Cookies() {
+		entry.Request.Cookies = append(entry.Request.Cookies, harNameValue{c.Name, c.Value})
+	}
+
+	for name, values := range req.URL.Query() {
+		for _, value := range values {
+			entry.Request.QueryString = append(entry.Request.QueryString, harNameValue{name, value})
+		}
+	}
+
+	if reqBody != nil {
+		entry.Request.PostData = &harPostData{
+			MimeType: req.Header.Get("Content-Type"),
+			Text:     harText(reqBody),
+		}
+	}
+
+	for _, c := range resp.Cookies() {
+		entry.Response.Cookies = append(entry.Response.Cookies, harNameValue{c.Name, c.Value})
+	}
+
+	return entry
+}
+
+func toHARHeaders(header http.Header) []harNameValue {
+	res := make([]harNameValue, 0, len(header))
+
+	names := make([]string, 0, len(header))
+
+	for name := range header {
+		names = append(names, name)
+	}
+
+	// sorted headers keep recordings stable
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range header[name] {
+			res = append(res, harNameValue{name, value})
+		}
+	}
+
+	return res
+}
+
+func fromHARHeaders(headers []harNameValue) http.Header {
+	res := make(http.Header, len(headers))
+
+	for _, h := range headers {
+		res.Add(h.Name, h.Value)
+	}
+
+	return res
+}
+
+// toHARContent keeps text bodies as they are and encodes binary ones by base64.
+func toHARContent(body []byte, mimeType string) harContent {
+	content := harContent{
+		Size:     len(body),
+		MimeType: mimeType,
+	}
+
+	if utf8.Valid(body) {
+		content.Text = string(body)
+	} else {
+		content.Text = base64.StdEncoding.EncodeToString(body)
+		content.Encoding = "base64"
+	}
+
+	return content
+}
+
+// harText converts a body to a string which survives JSON encoding,
+// so that bodies of recorded requests can be compared with bodies of replayed ones.
+func harText(body []byte) string {
+	return string([]rune(string(body)))
+}
+
+func (c harContent) body() ([]byte, error) {
+	if c.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(c.Text)
+	}
+
+	return []byte(c.Text), nil
+}
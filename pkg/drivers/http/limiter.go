@@ -0,0 +1,175 @@
+package http
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type (
+	// RateLimit limits requests sent to a single host.
+	// Zero values mean no limits.
+	RateLimit struct {
+		// RequestsPerSecond is a maximum rate of requests.
+		RequestsPerSecond float64
+		// MaxConcurrent is a maximum number of requests in flight, including reading of response bodies.
+		MaxConcurrent int
+		// MinDelay is a minimum delay between requests, which is extended by a random duration up to Jitter.
+		MinDelay time.Duration
+		Jitter   time.Duration
+	}
+
+	hostLimiter struct {
+		mu    sync.Mutex
+		next  time.Time
+		slots chan struct{}
+	}
+
+	limiter struct {
+		mu     sync.Mutex
+		limit  RateLimit
+		hosts  map[string]*hostLimiter
+		random *rand.Rand
+	}
+
+	// limitedTransport applies rate limits to each request sent, including redirects and retries.
+	limitedTransport struct {
+		base    http.RoundTripper
+		limiter *limiter
+	}
+
+	limitedBody struct {
+		io.ReadCloser
+		once    sync.Once
+		release func()
+	}
+)
+
+func newLimiter(limit RateLimit) *limiter {
+	return &limiter{
+		limit:  limit,
+		hosts:  make(map[string]*hostLimiter),
+		random: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (l *limiter) host(name string) *hostLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	h, exists := l.hosts[name]
+
+	if !exists {
+		h = new(hostLimiter)
+
+		if l.limit.MaxConcurrent > 0 {
+			h.slots = make(chan struct{}, l.limit.MaxConcurrent)
+		}
+
+		l.hosts[name] = h
+	}
+
+	return h
+}
+
+func (l *limiter) interval() time.Duration {
+	var interval time.Duration
+
+	if l.limit.RequestsPerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / l.limit.RequestsPerSecond)
+	}
+
+	delay := l.limit.MinDelay
+
+	if l.limit.Jitter > 0 {
+		l.mu.Lock()
+		delay += time.Duration(l.random.Int63n(int64(l.limit.Jitter)))
+		l.mu.Unlock()
+	}
+
+	if delay > interval {
+		return delay
+	}
+
+	return interval
+}
+
+// acquire blocks until a request to a given host can be sent.
+// The returned function must be called once the request is done.
+func (l *limiter) acquire(ctx context.Context, host string) (func(), error) {
+	h := l.host(host)
+	release := func() {}
+
+	if h.slots != nil {
+		select {
+		case h.slots <- struct{}{}:
+			release = func() {
+				<-h.slots
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	interval := l.interval()
+
+	h.mu.Lock()
+	now := time.Now()
+	start := h.next
+
+	if start.Before(now) {
+		start = now
+	}
+
+	h.next = start.Add(interval)
+	h.mu.Unlock()
+
+	wait := start.Sub(now)
+
+	if wait <= 0 {
+		return release, nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return release, nil
+	case <-ctx.Done():
+		release()
+
+		return nil, ctx.Err()
+	}
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.acquire(req.Context(), req.URL.Host)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+
+	if err != nil {
+		release()
+
+		return nil, err
+	}
+
+	resp.Body = &limitedBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+func (b *limitedBody) Close() error {
+	err := b.ReadCloser.Close()
+
+	b.once.Do(b.release)
+
+	return err
+}
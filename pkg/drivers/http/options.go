@@ -1,6 +1,11 @@
 package http
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
 	"github.com/sethgrid/pester"
 )
 
@@ -13,14 +18,52 @@ type (
 		concurrency int
 		proxy       string
 		userAgent   string
+		cookieJar   *CookieJar
+		rateLimit   *RateLimit
+		robots      bool
+		cacheDir    string
+		cacheMode   CacheMode
+		// retryStatusCodes are status codes of responses which are retried along with network errors
+		retryStatusCodes []int
+		// retryMethods are methods of requests which are retried, others are sent once
+		retryMethods []string
+		rootCAs      *x509.CertPool
+		certificates []tls.Certificate
+		// insecureHosts are hosts whose certificates are not verified, all hosts if it is empty
+		insecureSkipVerify bool
+		insecureHosts      map[string]bool
+		timeouts           Timeouts
+		redirectPolicy     RedirectPolicy
+		transport          http.RoundTripper
+		recorder           *Recorder
+		replay             *Replay
 	}
 )
 
+var defaultRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// defaultRetryMethods are idempotent methods, which are safe to send more than once.
+var defaultRetryMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
 func newOptions(setters []Option) *Options {
 	opts := new(Options)
 	opts.backoff = pester.ExponentialBackoff
 	opts.concurrency = 3
 	opts.maxRetries = 5
+	opts.retryStatusCodes = defaultRetryStatusCodes
+	opts.retryMethods = defaultRetryMethods
 
 	for _, setter := range setters {
 		setter(opts)
@@ -53,6 +96,8 @@ func WithMaxRetries(value int) Option {
 	}
 }
 
+// WithConcurrency used to set a number of duplicate requests sent at once.
+// Deprecated: requests are not duplicated anymore, use WithRateLimit to limit concurrent requests.
 func WithConcurrency(value int) Option {
 	return func(opts *Options) {
 		opts.concurrency = value
@@ -70,3 +115,126 @@ func WithUserAgent(value string) Option {
 		opts.userAgent = value
 	}
 }
+
+// WithCookieJar sets a cookie jar shared by all pages opened by the driver,
+// so that cookies set by responses are sent with subsequent requests.
+func WithCookieJar(jar *CookieJar) Option {
+	return func(opts *Options) {
+		opts.cookieJar = jar
+	}
+}
+
+// WithRateLimit limits requests sent by the driver to each host.
+func WithRateLimit(limit RateLimit) Option {
+	return func(opts *Options) {
+		opts.rateLimit = &limit
+	}
+}
+
+// WithRetryStatusCodes sets status codes of responses which are retried.
+// By default, 429, 500, 502, 503 and 504 are retried.
+func WithRetryStatusCodes(codes ...int) Option {
+	return func(opts *Options) {
+		opts.retryStatusCodes = codes
+	}
+}
+
+// WithRetryMethods sets methods of requests which are retried.
+// By default, only idempotent methods are retried, i.e. GET, HEAD, OPTIONS, PUT and DELETE,
+// so that requests like POST are not sent twice. Requests with other methods are sent once.
+func WithRetryMethods(methods ...string) Option {
+	return func(opts *Options) {
+		opts.retryMethods = methods
+	}
+}
+
+// WithRobots makes the driver honour robots.txt of hosts for its user agent.
+// Requests to disallowed urls fail with an error caused by common.ErrDisallowedByRobots.
+func WithRobots() Option {
+	return func(opts *Options) {
+		opts.robots = true
+	}
+}
+
+// WithCache stores responses in a given directory and reuses them according to a given mode.
+func WithCache(dir string, mode CacheMode) Option {
+	return func(opts *Options) {
+		opts.cacheDir = dir
+		opts.cacheMode = mode
+	}
+}
+
+// WithRootCAs sets certificate authorities used to verify certificates of servers.
+// By default, the system ones are used.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(opts *Options) {
+		opts.rootCAs = pool
+	}
+}
+
+// WithClientCertificates sets certificates presented to servers requesting them, e.g. for mutual TLS.
+func WithClientCertificates(certs ...tls.Certificate) Option {
+	return func(opts *Options) {
+		opts.certificates = append(opts.certificates, certs...)
+	}
+}
+
+// WithInsecureSkipVerify disables verification of certificates of given hosts, or of all hosts if none are given.
+func WithInsecureSkipVerify(hosts ...string) Option {
+	return func(opts *Options) {
+		opts.insecureSkipVerify = true
+
+		if len(hosts) == 0 {
+			opts.insecureHosts = nil
+
+			return
+		}
+
+		if opts.insecureHosts == nil {
+			opts.insecureHosts = make(map[string]bool)
+		}
+
+		for _, host := range hosts {
+			opts.insecureHosts[strings.ToLower(host)] = true
+		}
+	}
+}
+
+// WithTimeouts limits durations of connecting and of waiting for responses.
+func WithTimeouts(timeouts Timeouts) Option {
+	return func(opts *Options) {
+		opts.timeouts = timeouts
+	}
+}
+
+// WithRedirectPolicy sets which redirects are followed.
+// Requests rejected by the policy fail with an error caused by ErrRedirectNotAllowed.
+func WithRedirectPolicy(policy RedirectPolicy) Option {
+	return func(opts *Options) {
+		opts.redirectPolicy = policy
+	}
+}
+
+// WithTransport sets a transport used to send requests instead of the default one.
+// Proxy, TLS and timeout options are not applied to a custom transport,
+// whereas rate limits and the cache are.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(opts *Options) {
+		opts.transport = transport
+	}
+}
+
+// WithRecorder records requests sent by the driver, including downloads, by a given recorder.
+func WithRecorder(recorder *Recorder) Option {
+	return func(opts *Options) {
+		opts.recorder = recorder
+	}
+}
+
+// WithReplay serves requests sent by the driver from a given replay instead of the network.
+// Requests which have not been recorded fail with an error caused by ErrNotRecorded.
+func WithReplay(replay *Replay) Option {
+	return func(opts *Options) {
+		opts.replay = replay
+	}
+}
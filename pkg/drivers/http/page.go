@@ -6,6 +6,7 @@ import (
 	"github.com/MontFerret/ferret/pkg/drivers/common"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
 	"github.com/PuerkitoBio/goquery"
 	"hash/fnv"
 )
@@ -14,6 +15,8 @@ type HTMLPage struct {
 	document *HTMLDocument
 	cookies  []drivers.HTTPCookie
 	frames   *values.Array
+	response *drivers.HTTPResponse
+	observer *common.PageObserver
 }
 
 func NewHTMLPage(
@@ -85,6 +88,8 @@ func (p *HTMLPage) Copy() core.Value {
 		return values.None
 	}
 
+	page.response = p.response
+
 	return page
 }
 
@@ -93,9 +98,23 @@ func (p *HTMLPage) Iterate(ctx context.Context) (core.Iterator, error) {
 }
 
 func (p *HTMLPage) GetIn(ctx context.Context, path []core.Value) (core.Value, error) {
+	if len(path) > 0 && path[0].Type() == types.String && path[0].(values.String) == "response" {
+		if p.response == nil {
+			return values.None, nil
+		}
+
+		return p.response.GetIn(ctx, path[1:])
+	}
+
 	return common.GetInPage(ctx, p, path)
 }
 
+// GetResponse returns the response the page has been loaded from,
+// or nil if the page has been parsed from a string.
+func (p *HTMLPage) GetResponse() *drivers.HTTPResponse {
+	return p.response
+}
+
 func (p *HTMLPage) SetIn(ctx context.Context, path []core.Value, value core.Value) error {
 	return common.SetInPage(ctx, p, path, value)
 }
@@ -105,6 +124,8 @@ func (p *HTMLPage) Length() values.Int {
 }
 
 func (p *HTMLPage) Close() error {
+	p.observer.Closed(nil)
+
 	return nil
 }
 
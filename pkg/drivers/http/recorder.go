@@ -0,0 +1,238 @@
+package http
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotRecorded is a cause of errors returned in replay mode for requests without recorded responses.
+var ErrNotRecorded = errors.New("request is not recorded")
+
+type (
+	// Recorder records requests sent by the driver along with their responses,
+	// which can be saved in HAR format and replayed by Replay later.
+	Recorder struct {
+		mu      sync.Mutex
+		entries []harEntry
+	}
+
+	// Replay serves responses recorded by Recorder, or by other tools producing HAR files, without network access.
+	// Requests are matched by method, url and body. Identical requests get recorded responses in order,
+	// and the last one once they run out.
+	Replay struct {
+		mu      sync.Mutex
+		entries []harEntry
+		used    []bool
+	}
+
+	recordingTransport struct {
+		base     http.RoundTripper
+		recorder *Recorder
+	}
+)
+
+func NewRecorder() *Recorder {
+	return new(Recorder)
+}
+
+// Export writes recorded requests to a given writer in HAR format.
+func (r *Recorder) Export(w io.Writer) error {
+	r.mu.Lock()
+	entries := append([]harEntry(nil), r.entries...)
+	r.mu.Unlock()
+
+	return newHARFile(entries).write(w)
+}
+
+// Save writes recorded requests to a given file in HAR format.
+func (r *Recorder) Save(path string) error {
+	file, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	if err := r.Export(file); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func (r *Recorder) add(entry harEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+// NewReplay reads recorded requests in HAR format from a given reader.
+func NewReplay(reader io.Reader) (*Replay, error) {
+	file, err := readHARFile(reader)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Replay{
+		entries: file.Log.Entries,
+		used:    make([]bool, len(file.Log.Entries)),
+	}, nil
+}
+
+// LoadReplay reads recorded requests from a given HAR file.
+func LoadReplay(path string) (*Replay, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return NewReplay(file)
+}
+
+func (r *Replay) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry, found := r.find(req, body)
+
+	if !found {
+		return nil, errors.Wrap(ErrNotRecorded, req.Method+" "+req.URL.String())
+	}
+
+	respBody, err := entry.Response.Content.body()
+
+	if err != nil {
+		return nil, err
+	}
+
+	header := fromHARHeaders(entry.Response.Headers)
+	// bodies are stored decoded
+	header.Del("Content-Encoding")
+	header.Del("Transfer-Encoding")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.Response.Status, entry.Response.StatusText),
+		StatusCode:    entry.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Replay) find(req *http.Request, body []byte) (harEntry, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	url := req.URL.String()
+	text := harText(body)
+	last := -1
+
+	for i, entry := range r.entries {
+		if entry.Request.Method != req.Method || entry.Request.URL != url {
+			continue
+		}
+
+		var recorded string
+
+		if entry.Request.PostData != nil {
+			recorded = entry.Request.PostData.Text
+		}
+
+		if recorded != text {
+			continue
+		}
+
+		if !r.used[i] {
+			r.used[i] = true
+
+			return entry, true
+		}
+
+		last = i
+	}
+
+	if last < 0 {
+		return harEntry{}, false
+	}
+
+	return r.entries[last], true
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	t.recorder.add(newHAREntry(req, body, resp, respBody, start, time.Since(start)))
+
+	return resp, nil
+}
+
+// readRequestBody returns a body of a given request leaving the request readable.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+
+		if err != nil {
+			return nil, err
+		}
+
+		defer body.Close()
+
+		return ioutil.ReadAll(body)
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
@@ -0,0 +1,138 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	h "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRecorder(t *testing.T) {
+	visits := 0
+
+	mux := h.NewServeMux()
+	mux.HandleFunc("/page", func(w h.ResponseWriter, _ *h.Request) {
+		visits++
+		fmt.Fprintf(w, `<html><head><title>Visit %d</title></head><body></body></html>`, visits)
+	})
+	mux.HandleFunc("/redirect", func(w h.ResponseWriter, r *h.Request) {
+		h.Redirect(w, r, "/page", h.StatusFound)
+	})
+	mux.HandleFunc("/echo", func(w h.ResponseWriter, r *h.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		fmt.Fprintf(w, `<html><head><title>%s</title></head><body></body></html>`, body)
+	})
+	mux.HandleFunc("/file.bin", func(w h.ResponseWriter, _ *h.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte{0xff, 0x00, 0xfe})
+	})
+
+	server := httptest.NewServer(mux)
+	baseURL := server.URL
+
+	dir, err := ioutil.TempDir("", "ferret-har")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "requests.har")
+
+	post := func(drv *http.Driver, body string) (drivers.HTMLPage, error) {
+		return drv.Open(context.Background(), drivers.OpenPageParams{
+			URL:         baseURL + "/echo",
+			Method:      "POST",
+			Body:        []byte(body),
+			ContentType: "text/plain",
+		})
+	}
+
+	Convey("Should record requests", t, func() {
+		recorder := http.NewRecorder()
+		drv := http.NewDriver(http.WithRecorder(recorder))
+
+		for i := 1; i <= 2; i++ {
+			page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: baseURL + "/page"})
+
+			So(err, ShouldBeNil)
+			So(innerText(page, "title"), ShouldEqual, values.NewString(fmt.Sprintf("Visit %d", i)))
+		}
+
+		_, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: baseURL + "/redirect"})
+		So(err, ShouldBeNil)
+
+		_, err = post(drv, "foo")
+		So(err, ShouldBeNil)
+
+		_, err = drv.Download(context.Background(), baseURL+"/file.bin")
+		So(err, ShouldBeNil)
+
+		So(recorder.Save(file), ShouldBeNil)
+
+		data, err := ioutil.ReadFile(file)
+		So(err, ShouldBeNil)
+		So(string(data), ShouldContainSubstring, `"version": "1.2"`)
+	})
+
+	server.Close()
+
+	Convey("Should replay recorded requests without network", t, func() {
+		replay, err := http.LoadReplay(file)
+		So(err, ShouldBeNil)
+
+		drv := http.NewDriver(http.WithReplay(replay))
+
+		Convey("In order of recording", func() {
+			for _, title := range []string{"Visit 1", "Visit 2", "Visit 3", "Visit 3"} {
+				page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: baseURL + "/page"})
+
+				So(err, ShouldBeNil)
+				So(innerText(page, "title"), ShouldEqual, values.NewString(title))
+			}
+		})
+
+		Convey("With redirects", func() {
+			page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: baseURL + "/redirect"})
+
+			So(err, ShouldBeNil)
+			So(getIn(page, "response", "url"), ShouldEqual, values.NewString(baseURL+"/page"))
+		})
+
+		Convey("Matching bodies", func() {
+			page, err := post(drv, "foo")
+
+			So(err, ShouldBeNil)
+			So(innerText(page, "title"), ShouldEqual, values.NewString("foo"))
+
+			_, err = post(drv, "bar")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Including downloads", func() {
+			data, err := drv.Download(context.Background(), baseURL+"/file.bin")
+
+			So(err, ShouldBeNil)
+			So(data, ShouldResemble, []byte{0xff, 0x00, 0xfe})
+		})
+
+		Convey("Failing on requests which have not been recorded", func() {
+			_, err := drv.Download(context.Background(), baseURL+"/other.bin")
+
+			So(err, ShouldNotBeNil)
+			So(strings.Contains(err.Error(), http.ErrNotRecorded.Error()), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,55 @@
+package http_test
+
+import (
+	"context"
+	"fmt"
+	h "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/common"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRobots(t *testing.T) {
+	mux := h.NewServeMux()
+	mux.HandleFunc("/robots.txt", func(w h.ResponseWriter, _ *h.Request) {
+		fmt.Fprint(w, "User-agent: *\nDisallow: /private\n")
+	})
+	mux.HandleFunc("/redirect", func(w h.ResponseWriter, r *h.Request) {
+		h.Redirect(w, r, "/private", h.StatusFound)
+	})
+	mux.HandleFunc("/", func(w h.ResponseWriter, _ *h.Request) {
+		fmt.Fprint(w, `<html><head><title>OK</title></head><body></body></html>`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	Convey("Should honour robots.txt", t, func() {
+		drv := http.NewDriver(http.WithRobots())
+
+		_, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/public"})
+		So(err, ShouldBeNil)
+
+		_, err = drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/private"})
+		So(errors.Cause(err), ShouldEqual, common.ErrDisallowedByRobots)
+
+		_, err = drv.Download(context.Background(), server.URL+"/private/file.zip")
+		So(errors.Cause(err), ShouldEqual, common.ErrDisallowedByRobots)
+
+		_, err = drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/redirect"})
+		So(err, ShouldNotBeNil)
+		So(strings.Contains(err.Error(), common.ErrDisallowedByRobots.Error()), ShouldBeTrue)
+	})
+
+	Convey("Should ignore robots.txt by default", t, func() {
+		_, err := http.NewDriver().Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/private"})
+
+		So(err, ShouldBeNil)
+	})
+}
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/http2"
+)
+
+// ErrRedirectNotAllowed is a cause of errors returned for redirects rejected by a redirect policy.
+var ErrRedirectNotAllowed = errors.New("redirect is not allowed")
+
+const defaultMaxRedirects = 10
+
+type (
+	// Timeouts limits stages of requests.
+	// Zero values mean defaults of http.DefaultTransport.
+	Timeouts struct {
+		// Dial is a maximum time to establish a connection.
+		Dial time.Duration
+		// TLSHandshake is a maximum time to wait for a TLS handshake.
+		TLSHandshake time.Duration
+		// ResponseHeader is a maximum time to wait for response headers after a request has been written.
+		ResponseHeader time.Duration
+	}
+
+	// RedirectPolicy defines which redirects are followed.
+	RedirectPolicy struct {
+		// MaxRedirects is a maximum number of redirects followed by a single request.
+		// Zero means 10, a negative value disables redirects, in which case redirect responses are returned as they are.
+		MaxRedirects int
+		// SameHost rejects redirects to other hosts.
+		SameHost bool
+	}
+
+	// hostTransport sends requests to some hosts by an alternative transport.
+	hostTransport struct {
+		base      http.RoundTripper
+		alternate http.RoundTripper
+		hosts     map[string]bool
+	}
+)
+
+// LoadCertPool returns the system pool of certificates extended by PEM encoded certificates of given files.
+func LoadCertPool(files ...string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.Errorf("no certificates found in %s", file)
+		}
+	}
+
+	return pool, nil
+}
+
+// newTransport returns a transport configured by proxy, TLS and timeout options,
+// or a custom one if it has been set.
+// http.DefaultTransport is used if none of the options are set.
+func newTransport(options *Options) http.RoundTripper {
+	if options.transport != nil {
+		return options.transport
+	}
+
+	if !hasTransportOptions(options) {
+		return http.DefaultTransport
+	}
+
+	if options.insecureSkipVerify && len(options.insecureHosts) > 0 {
+		return &hostTransport{
+			base:      newHTTPTransport(options, false),
+			alternate: newHTTPTransport(options, true),
+			hosts:     options.insecureHosts,
+		}
+	}
+
+	return newHTTPTransport(options, options.insecureSkipVerify)
+}
+
+func hasTransportOptions(options *Options) bool {
+	return options.proxy != "" ||
+		options.rootCAs != nil ||
+		len(options.certificates) > 0 ||
+		options.insecureSkipVerify ||
+		options.timeouts != Timeouts{}
+}
+
+func newHTTPTransport(options *Options, insecure bool) *http.Transport {
+	timeouts := options.timeouts
+
+	dial := timeouts.Dial
+
+	if dial <= 0 {
+		dial = 30 * time.Second
+	}
+
+	handshake := timeouts.TLSHandshake
+
+	if handshake <= 0 {
+		handshake = 10 * time.Second
+	}
+
+	// the same as http.DefaultTransport, apart from options
+	tr := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   dial,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   handshake,
+		ExpectContinueTimeout: 1 * time.Second,
+		ResponseHeaderTimeout: timeouts.ResponseHeader,
+		TLSClientConfig: &tls.Config{
+			RootCAs:            options.rootCAs,
+			Certificates:       options.certificates,
+			InsecureSkipVerify: insecure,
+		},
+	}
+
+	if options.proxy != "" {
+		if proxyURL, err := url.Parse(options.proxy); err == nil {
+			tr.Proxy = http.ProxyURL(proxyURL)
+		}
+	}
+
+	// HTTP/2 is not enabled automatically for transports with a custom TLS config or dialer.
+	// It fails only if the transport has been configured already, which is not the case.
+	http2.ConfigureTransport(tr)
+
+	return tr
+}
+
+func (t *hostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.hosts[strings.ToLower(req.URL.Hostname())] {
+		return t.alternate.RoundTrip(req)
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// check is used as http.Client.CheckRedirect.
+func (p RedirectPolicy) check(req *http.Request, via []*http.Request) error {
+	if p.MaxRedirects < 0 {
+		return http.ErrUseLastResponse
+	}
+
+	max := p.MaxRedirects
+
+	if max == 0 {
+		max = defaultMaxRedirects
+	}
+
+	if len(via) > max {
+		return errors.Wrapf(ErrRedirectNotAllowed, "stopped after %d redirects", max)
+	}
+
+	if p.SameHost && !strings.EqualFold(req.URL.Host, via[0].URL.Host) {
+		return errors.Wrapf(ErrRedirectNotAllowed, "redirect to another host %s", req.URL.Host)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package http_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	h "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type countingTransport struct {
+	base  h.RoundTripper
+	calls int32
+}
+
+func (t *countingTransport) RoundTrip(req *h.Request) (*h.Response, error) {
+	atomic.AddInt32(&t.calls, 1)
+
+	return t.base.RoundTrip(req)
+}
+
+func TestTransport(t *testing.T) {
+	mux := h.NewServeMux()
+	mux.HandleFunc("/ok", func(w h.ResponseWriter, r *h.Request) {
+		fmt.Fprintf(w, `<html><head><title>%d</title></head><body></body></html>`, len(r.TLS.PeerCertificates))
+	})
+
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	open := func(drv *http.Driver) (drivers.HTMLPage, error) {
+		return drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + "/ok"})
+	}
+
+	Convey("Should reject unknown certificates by default", t, func() {
+		_, err := open(http.NewDriver(http.WithMaxRetries(1)))
+
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should verify certificates by custom CAs", t, func() {
+		dir, err := ioutil.TempDir("", "ferret-tls")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		file := filepath.Join(dir, "ca.pem")
+		data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+		So(ioutil.WriteFile(file, data, 0644), ShouldBeNil)
+
+		pool, err := http.LoadCertPool(file)
+		So(err, ShouldBeNil)
+
+		_, err = open(http.NewDriver(http.WithRootCAs(pool)))
+		So(err, ShouldBeNil)
+
+		_, err = http.LoadCertPool(filepath.Join(dir, "missing.pem"))
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Should skip verification of given hosts only", t, func() {
+		_, err := open(http.NewDriver(http.WithInsecureSkipVerify("127.0.0.1")))
+		So(err, ShouldBeNil)
+
+		_, err = open(http.NewDriver(http.WithMaxRetries(1), http.WithInsecureSkipVerify("example.com")))
+		So(err, ShouldNotBeNil)
+
+		_, err = open(http.NewDriver(http.WithInsecureSkipVerify()))
+		So(err, ShouldBeNil)
+	})
+
+	Convey("Should present client certificates", t, func() {
+		server.TLS.ClientAuth = tls.RequireAnyClientCert
+		defer func() {
+			server.TLS.ClientAuth = tls.NoClientCert
+		}()
+
+		drv := http.NewDriver(
+			http.WithInsecureSkipVerify(),
+			http.WithClientCertificates(server.TLS.Certificates...),
+		)
+
+		page, err := open(drv)
+		So(err, ShouldBeNil)
+		So(innerText(page, "title"), ShouldEqual, values.NewString("1"))
+	})
+
+	Convey("Should use a custom transport", t, func() {
+		transport := &countingTransport{base: server.Client().Transport}
+
+		_, err := open(http.NewDriver(http.WithTransport(transport)))
+
+		So(err, ShouldBeNil)
+		So(atomic.LoadInt32(&transport.calls), ShouldEqual, 1)
+	})
+}
+
+func TestHTTP2(t *testing.T) {
+	server := httptest.NewUnstartedServer(h.HandlerFunc(func(w h.ResponseWriter, r *h.Request) {
+		fmt.Fprintf(w, `<html><head><title>%s</title></head><body></body></html>`, r.Proto)
+	}))
+	server.TLS = &tls.Config{NextProtos: []string{"h2", "http/1.1"}}
+	server.StartTLS()
+	defer server.Close()
+
+	Convey("Should keep HTTP/2 with TLS options", t, func() {
+		drv := http.NewDriver(http.WithInsecureSkipVerify())
+		page, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL})
+
+		So(err, ShouldBeNil)
+		So(innerText(page, "title"), ShouldEqual, values.NewString("HTTP/2.0"))
+	})
+}
+
+func TestTimeouts(t *testing.T) {
+	server := httptest.NewServer(h.HandlerFunc(func(w h.ResponseWriter, _ *h.Request) {
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, `<html><head><title>OK</title></head><body></body></html>`)
+	}))
+	defer server.Close()
+
+	Convey("Should time out waiting for response headers", t, func() {
+		drv := http.NewDriver(
+			http.WithMaxRetries(1),
+			http.WithTimeouts(http.Timeouts{ResponseHeader: 50 * time.Millisecond}),
+		)
+
+		_, err := drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL})
+
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestRedirectPolicy(t *testing.T) {
+	mux := h.NewServeMux()
+	mux.HandleFunc("/ok", func(w h.ResponseWriter, _ *h.Request) {
+		fmt.Fprint(w, `<html><head><title>OK</title></head><body></body></html>`)
+	})
+	mux.HandleFunc("/twice", func(w h.ResponseWriter, r *h.Request) {
+		h.Redirect(w, r, "/once", h.StatusFound)
+	})
+	mux.HandleFunc("/once", func(w h.ResponseWriter, r *h.Request) {
+		h.Redirect(w, r, "/ok", h.StatusFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/away", func(w h.ResponseWriter, r *h.Request) {
+		// the same server by another host name
+		h.Redirect(w, r, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)+"/ok", h.StatusFound)
+	})
+
+	open := func(drv *http.Driver, path string) (drivers.HTMLPage, error) {
+		return drv.Open(context.Background(), drivers.OpenPageParams{URL: server.URL + path})
+	}
+
+	Convey("Should limit a number of redirects", t, func() {
+		_, err := open(http.NewDriver(http.WithRedirectPolicy(http.RedirectPolicy{MaxRedirects: 2})), "/twice")
+		So(err, ShouldBeNil)
+
+		_, err = open(http.NewDriver(http.WithRedirectPolicy(http.RedirectPolicy{MaxRedirects: 1})), "/twice")
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, http.ErrRedirectNotAllowed.Error())
+	})
+
+	Convey("Should return redirect responses if redirects are disabled", t, func() {
+		drv := http.NewDriver(http.WithRedirectPolicy(http.RedirectPolicy{MaxRedirects: -1}))
+		page, err := drv.Open(context.Background(), drivers.OpenPageParams{
+			URL:                server.URL + "/once",
+			AllowedStatusCodes: []int{h.StatusFound},
+		})
+
+		So(err, ShouldBeNil)
+		So(getIn(page, "response", "status"), ShouldEqual, values.NewInt(h.StatusFound))
+	})
+
+	Convey("Should reject redirects to other hosts", t, func() {
+		_, err := open(http.NewDriver(), "/away")
+		So(err, ShouldBeNil)
+
+		_, err = open(http.NewDriver(http.WithRedirectPolicy(http.RedirectPolicy{SameHost: true})), "/away")
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, http.ErrRedirectNotAllowed.Error())
+
+		_, err = open(http.NewDriver(http.WithRedirectPolicy(http.RedirectPolicy{SameHost: true})), "/twice")
+		So(err, ShouldBeNil)
+	})
+}
@@ -0,0 +1,162 @@
+package drivers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// HTTPResponse HTTPResponse object.
+// URL is the final URL after redirects,
+// Redirects are URLs which have been redirected from, in the order of requests.
+// Charset is the encoding the body has been decoded from.
+type HTTPResponse struct {
+	URL        string
+	StatusCode int
+	Status     string
+	Headers    HTTPHeader
+	Redirects  []string
+	Charset    string
+}
+
+func (r HTTPResponse) Type() core.Type {
+	return HTTPResponseType
+}
+
+func (r HTTPResponse) String() string {
+	return fmt.Sprintf("%d %s", r.StatusCode, r.URL)
+}
+
+func (r HTTPResponse) Compare(other core.Value) int64 {
+	if other.Type() != HTTPResponseType {
+		return Compare(HTTPResponseType, other.Type())
+	}
+
+	or := other.(HTTPResponse)
+
+	if r.URL != or.URL {
+		return int64(strings.Compare(r.URL, or.URL))
+	}
+
+	if r.StatusCode > or.StatusCode {
+		return 1
+	} else if r.StatusCode < or.StatusCode {
+		return -1
+	}
+
+	if c := r.Headers.Compare(or.Headers); c != 0 {
+		return c
+	}
+
+	if c := strings.Compare(strings.Join(r.Redirects, " "), strings.Join(or.Redirects, " ")); c != 0 {
+		return int64(c)
+	}
+
+	return int64(strings.Compare(r.Charset, or.Charset))
+}
+
+func (r HTTPResponse) Unwrap() interface{} {
+	return r
+}
+
+func (r HTTPResponse) Hash() uint64 {
+	h := fnv.New64a()
+
+	h.Write([]byte(r.Type().String()))
+	h.Write([]byte(":"))
+	h.Write([]byte(r.URL))
+	h.Write([]byte(strconv.Itoa(r.StatusCode)))
+	h.Write([]byte(strconv.FormatUint(r.Headers.Hash(), 10)))
+
+	for _, redirect := range r.Redirects {
+		h.Write([]byte(redirect))
+	}
+
+	h.Write([]byte(r.Charset))
+
+	return h.Sum64()
+}
+
+func (r HTTPResponse) Copy() core.Value {
+	c := r
+	c.Headers = make(HTTPHeader, len(r.Headers))
+
+	for k, v := range r.Headers {
+		c.Headers[k] = append([]string(nil), v...)
+	}
+
+	c.Redirects = append([]string(nil), r.Redirects...)
+
+	return c
+}
+
+func (r HTTPResponse) MarshalJSON() ([]byte, error) {
+	redirects := r.Redirects
+
+	if redirects == nil {
+		redirects = []string{}
+	}
+
+	v := map[string]interface{}{
+		"url":        r.URL,
+		"status":     r.StatusCode,
+		"statusText": r.Status,
+		"headers":    r.Headers,
+		"redirects":  redirects,
+		"charset":    r.Charset,
+	}
+
+	return json.Marshal(v)
+}
+
+func (r HTTPResponse) GetIn(ctx context.Context, path []core.Value) (core.Value, error) {
+	if len(path) == 0 {
+		return r, nil
+	}
+
+	segment := path[0]
+
+	err := core.ValidateType(segment, types.String)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	switch segment.(values.String) {
+	case "url", "URL":
+		return values.NewString(r.URL), nil
+	case "status":
+		return values.NewInt(r.StatusCode), nil
+	case "statusText":
+		return values.NewString(r.Status), nil
+	case "headers":
+		if len(path) == 1 {
+			return r.Headers, nil
+		}
+
+		return r.Headers.GetIn(ctx, path[1:])
+	case "redirects":
+		arr := values.NewArray(len(r.Redirects))
+
+		for _, redirect := range r.Redirects {
+			arr.Push(values.NewString(redirect))
+		}
+
+		if len(path) == 1 {
+			return arr, nil
+		}
+
+		return values.GetIn(ctx, arr, path[1:])
+	case "charset":
+		return values.NewString(r.Charset), nil
+	default:
+		return values.None, nil
+	}
+}
@@ -8,6 +8,7 @@ var (
 	HTMLElementType  = core.NewType("HTMLElement")
 	HTMLDocumentType = core.NewType("HTMLDocument")
 	HTMLPageType     = core.NewType("HTMLPageType")
+	HTTPResponseType = core.NewType("HTTPResponse")
 )
 
 // Comparison table of builtin types
@@ -17,6 +18,7 @@ var typeComparisonTable = map[core.Type]uint64{
 	HTMLElementType:  2,
 	HTMLDocumentType: 3,
 	HTMLPageType:     4,
+	HTTPResponseType: 5,
 }
 
 func Compare(first, second core.Type) int64 {
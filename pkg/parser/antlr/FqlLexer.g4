@@ -36,11 +36,12 @@ MinusMinus: '--';
 PlusPlus: '++';
 
 // Logical operators
-And: 'AND' | '&&';
-Or: 'OR' | '||';
+And: A N D | '&&';
+Or: O R | '||';
 
 // Other operators
 Range: Dot Dot;
+Ellipsis: '...';
 Assign: '=';
 QuestionMark: '?';
 RegexNotMatch: '!~';
@@ -48,41 +49,62 @@ RegexMatch: '=~';
 
 // Keywords
 // Common Keywords
-For: 'FOR';
-Return: 'RETURN';
-Distinct: 'DISTINCT';
-Filter: 'FILTER';
-Sort: 'SORT';
-Limit: 'LIMIT';
-Let: 'LET';
-Collect: 'COLLECT';
-SortDirection: 'ASC' | 'DESC';
-None: 'NONE';
-Null: 'NULL';
-BooleanLiteral: 'TRUE' | 'true' | 'FALSE' | 'false';
+For: F O R;
+Return: R E T U R N;
+Distinct: D I S T I N C T;
+Filter: F I L T E R;
+Sort: S O R T;
+Limit: L I M I T;
+Let: L E T;
+Collect: C O L L E C T;
+SortDirection: A S C | D E S C;
+None: N O N E;
+Null: N U L L;
+BooleanLiteral: T R U E | F A L S E;
+
+// Sort options
+Nulls: N U L L S;
+Collate: C O L L A T E;
 
 // Group operators
-Into: 'INTO';
-Keep: 'KEEP';
-With: 'WITH';
-Count: 'COUNT';
-All: 'ALL';
-Any: 'ANY';
-Aggregate: 'AGGREGATE';
+Into: I N T O;
+Keep: K E E P;
+With: W I T H;
+Count: C O U N T;
+All: A L L;
+Any: A N Y;
+Aggregate: A G G R E G A T E;
+
+// Window operators
+Window: W I N D O W;
+Partition: P A R T I T I O N;
+
+// Conditional operators
+Switch: S W I T C H;
+When: W H E N;
+Case: C A S E;
+Default: D E F A U L T;
+End: E N D;
 
 // Unary operators
-Like: 'LIKE';
-Not: 'NOT' | '!';
-In: 'IN';
+Like: L I K E;
+Not: N O T | '!';
+In: I N;
 
 // Literals
 Param: '@';
 Identifier: Letter+ (Symbols (Identifier)*)* (Digit (Identifier)*)*;
 StringLiteral: SQString | DQSring;
 TemplateStringLiteral: '`' ('\\`' | ~'`')* '`';
-IntegerLiteral: [0-9]+;
+RawStringLiteral: [rR] '`' ('\\`' | ~'`')* '`';
+IntegerLiteral
+    : DecimalDigits
+    | '0' [xX] HexDigit+ ('_' HexDigit+)*
+    | '0' [bB] [01]+ ('_' [01]+)*
+    | '0' [oO] [0-7]+ ('_' [0-7]+)*
+    ;
 FloatLiteral
-    : DecimalIntegerLiteral Dot [0-9]+ ExponentPart?
+    : DecimalIntegerLiteral Dot DecimalDigits ExponentPart?
     | DecimalIntegerLiteral ExponentPart?
     ;
 
@@ -94,13 +116,16 @@ fragment HexDigit
     ;
 fragment DecimalIntegerLiteral
     : '0'
-    | [1-9] [0-9]*
+    | [1-9] ('_'? [0-9])*
+    ;
+fragment DecimalDigits
+    : [0-9]+ ('_' [0-9]+)*
     ;
 fragment ExponentPart
     : [eE] [+-]? [0-9]+
     ;
 fragment Letter
-    : 'A'..'Z' | 'a'..'z'
+    : [\p{L}]
     ;
 fragment Symbols: '_';
 fragment Digit
@@ -108,4 +133,32 @@ fragment Digit
     ;
 fragment DQSring: '"' ( '\\'. | '""' | ~('"'| '\\') )* '"';
 fragment SQString: '\'' ('\\'. | '\'\'' | ~('\'' | '\\'))* '\'';
-fragment NamespaceSeparator: '::';
\ No newline at end of file
+fragment NamespaceSeparator: '::';
+
+// Case-insensitive letters
+fragment A: [aA];
+fragment B: [bB];
+fragment C: [cC];
+fragment D: [dD];
+fragment E: [eE];
+fragment F: [fF];
+fragment G: [gG];
+fragment H: [hH];
+fragment I: [iI];
+fragment J: [jJ];
+fragment K: [kK];
+fragment L: [lL];
+fragment M: [mM];
+fragment N: [nN];
+fragment O: [oO];
+fragment P: [pP];
+fragment Q: [qQ];
+fragment R: [rR];
+fragment S: [sS];
+fragment T: [tT];
+fragment U: [uU];
+fragment V: [vV];
+fragment W: [wW];
+fragment X: [xX];
+fragment Y: [yY];
+fragment Z: [zZ];
\ No newline at end of file
@@ -33,11 +33,11 @@ forExpression
     ;
 
 forExpressionValueVariable
-    : Identifier
+    : identifier
     ;
 
 forExpressionKeyVariable
-    : Identifier
+    : identifier
     ;
 
 forExpressionSource
@@ -55,6 +55,7 @@ forExpressionClause
     | sortClause
     | filterClause
     | collectClause
+    | windowClause
     ;
 
 forExpressionStatement
@@ -81,8 +82,7 @@ limitClause
     ;
 
 limitClauseValue
-    : IntegerLiteral
-    | param
+    : expression
     ;
 
 sortClause
@@ -90,20 +90,28 @@ sortClause
     ;
 
 sortClauseExpression
-    : expression SortDirection?
+    : expression SortDirection? sortClauseNulls? sortClauseCollation?
+    ;
+
+sortClauseNulls
+    : Nulls Identifier
+    ;
+
+sortClauseCollation
+    : Collate Identifier
     ;
 
 collectClause
-    : Collect collectCounter
-    | Collect collectAggregator
-    | Collect collectGrouping collectAggregator
-    | Collect collectGrouping collectGroupVariable
-    | Collect collectGrouping collectCounter
-    | Collect collectGrouping
+    : Collect collectCounter collectOptions?
+    | Collect collectAggregator collectOptions?
+    | Collect collectGrouping collectAggregator collectOptions?
+    | Collect collectGrouping collectGroupVariable collectOptions?
+    | Collect collectGrouping collectCounter collectOptions?
+    | Collect collectGrouping collectOptions?
     ;
 
 collectSelector
-    : Identifier Assign expression
+    : identifier Assign expression
     ;
 
 collectGrouping
@@ -115,30 +123,56 @@ collectAggregator
     ;
 
 collectAggregateSelector
-    : Identifier Assign functionCallExpression
+    : identifier Assign functionCallExpression
     ;
 
 collectGroupVariable
     : Into collectSelector
-    | Into Identifier (Keep Identifier)?
+    | Into identifier (Keep identifier)?
     ;
 
 collectCounter
-    : With Count Into Identifier
+    : With Count Into identifier
+    ;
+
+collectOptions
+    : Identifier objectLiteral
+    ;
+
+windowClause
+    : Window windowPartition? objectLiteral? collectAggregator
+    ;
+
+windowPartition
+    : Partition expression
     ;
 
 variableDeclaration
-    : Let Identifier Assign expression
-    | Let Identifier Assign OpenParen forExpression CloseParen
-    | Let Identifier Assign forTernaryExpression
+    : Let identifier Assign expression
+    | Let identifier Assign OpenParen forExpression CloseParen
+    | Let identifier Assign forTernaryExpression
     ;
 
 param
-    : Param Identifier
+    : Param (Identifier | reservedWord)
     ;
 
 variable
+    : identifier
+    ;
+
+// keywords which do not start statements or clauses can be used as names of variables
+identifier
     : Identifier
+    | SortDirection
+    | Nulls
+    | Collate
+    | Count
+    | All
+    | Any
+    | Partition
+    | Default
+    | End
     ;
 
 rangeOperator
@@ -160,6 +194,7 @@ booleanLiteral
 stringLiteral
     : StringLiteral
     | TemplateStringLiteral
+    | RawStringLiteral
     ;
 
 integerLiteral
@@ -176,18 +211,23 @@ noneLiteral
     ;
 
 arrayElementList
-    : expression (Comma + expression)*
+    : arrayElement (Comma + arrayElement)*
+    ;
+
+arrayElement
+    : Ellipsis? expression
     ;
 
 propertyAssignment
     : propertyName Colon expression
     | computedPropertyName Colon expression
     | shorthandPropertyName
+    | Ellipsis expression
     ;
 
 memberExpression
-    : Identifier (Dot propertyName (computedPropertyName)*)+
-    | Identifier computedPropertyName (Dot propertyName (computedPropertyName)*)* (computedPropertyName (Dot propertyName)*)*
+    : identifier (Dot propertyName (computedPropertyName)*)+
+    | identifier computedPropertyName (Dot propertyName (computedPropertyName)*)* (computedPropertyName (Dot propertyName)*)*
     ;
 
 shorthandPropertyName
@@ -201,6 +241,40 @@ computedPropertyName
 propertyName
     : Identifier
     | stringLiteral
+    | reservedWord
+    ;
+
+reservedWord
+    : For
+    | Return
+    | Distinct
+    | Filter
+    | Sort
+    | Limit
+    | Let
+    | Collect
+    | SortDirection
+    | Nulls
+    | Collate
+    | None
+    | Null
+    | BooleanLiteral
+    | Into
+    | Keep
+    | With
+    | Count
+    | All
+    | Any
+    | Aggregate
+    | Window
+    | Partition
+    | Switch
+    | When
+    | Case
+    | Default
+    | End
+    | Like
+    | In
     ;
 
 expressionGroup
@@ -216,7 +290,11 @@ functionCallExpression
     ;
 
 arguments
-    : OpenParen(expression (Comma expression)*)?CloseParen
+    : OpenParen(argument (Comma argument)*)?CloseParen
+    ;
+
+argument
+    : Ellipsis? expression
     ;
 
 expression
@@ -225,6 +303,8 @@ expression
     | expression additiveOperator expression
     | functionCallExpression
     | expressionGroup
+    | switchExpression
+    | whenExpression
     | expression arrayOperator (inOperator | equalityOperator) expression
     | expression inOperator expression
     | expression equalityOperator expression
@@ -244,6 +324,22 @@ expression
     | param
     ;
 
+switchExpression
+    : Switch expression (switchCase)+ (switchDefault)? End
+    ;
+
+whenExpression
+    : When (switchCase)+ (switchDefault)? End
+    ;
+
+switchCase
+    : Case expression (Comma expression)* Colon expression
+    ;
+
+switchDefault
+    : Default Colon expression
+    ;
+
 forTernaryExpression
     : expression QuestionMark expression? Colon OpenParen forExpression CloseParen
     | expression QuestionMark OpenParen forExpression CloseParen Colon expression
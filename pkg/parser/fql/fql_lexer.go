@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 65, 529,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 76, 798,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -28,228 +28,528 @@ var serializedLexerAtn = []uint16{
 	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
 	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
 	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9,
-	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 3, 2, 3, 2, 3, 2, 3, 2, 7,
-	2, 152, 10, 2, 12, 2, 14, 2, 155, 11, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 166, 10, 3, 12, 3, 14, 3, 169, 11, 3, 3,
-	3, 3, 3, 3, 4, 6, 4, 174, 10, 4, 13, 4, 14, 4, 175, 3, 4, 3, 4, 3, 5, 3,
-	5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3,
-	10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15,
-	3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3,
-	20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24,
-	3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3,
-	28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 241, 10, 29, 3, 30, 3, 30,
-	3, 30, 3, 30, 5, 30, 247, 10, 30, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3,
-	33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36,
-	3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3,
-	38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39,
-	3, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3,
-	41, 3, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43,
-	3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3,
-	44, 3, 44, 5, 44, 319, 10, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 46,
-	3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3,
-	47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47,
-	3, 47, 5, 47, 349, 10, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3,
-	49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51,
-	3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3,
-	53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54,
-	3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 5,
-	56, 399, 10, 56, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 59, 6, 59, 407,
-	10, 59, 13, 59, 14, 59, 408, 3, 59, 3, 59, 7, 59, 413, 10, 59, 12, 59,
-	14, 59, 416, 11, 59, 7, 59, 418, 10, 59, 12, 59, 14, 59, 421, 11, 59, 3,
-	59, 3, 59, 7, 59, 425, 10, 59, 12, 59, 14, 59, 428, 11, 59, 7, 59, 430,
-	10, 59, 12, 59, 14, 59, 433, 11, 59, 3, 60, 3, 60, 5, 60, 437, 10, 60,
-	3, 61, 3, 61, 3, 61, 3, 61, 7, 61, 443, 10, 61, 12, 61, 14, 61, 446, 11,
-	61, 3, 61, 3, 61, 3, 62, 6, 62, 451, 10, 62, 13, 62, 14, 62, 452, 3, 63,
-	3, 63, 3, 63, 6, 63, 458, 10, 63, 13, 63, 14, 63, 459, 3, 63, 5, 63, 463,
-	10, 63, 3, 63, 3, 63, 5, 63, 467, 10, 63, 5, 63, 469, 10, 63, 3, 64, 3,
-	64, 3, 64, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 7, 66, 479, 10, 66, 12, 66,
-	14, 66, 482, 11, 66, 5, 66, 484, 10, 66, 3, 67, 3, 67, 5, 67, 488, 10,
-	67, 3, 67, 6, 67, 491, 10, 67, 13, 67, 14, 67, 492, 3, 68, 3, 68, 3, 69,
-	3, 69, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 7, 71, 507,
-	10, 71, 12, 71, 14, 71, 510, 11, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72,
-	3, 72, 3, 72, 3, 72, 7, 72, 520, 10, 72, 12, 72, 14, 72, 523, 11, 72, 3,
-	72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 153, 2, 74, 3, 3, 5, 4, 7, 5, 9, 6,
-	11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29,
-	16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47,
-	25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65,
-	34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83,
-	43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101,
-	52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117,
-	60, 119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129, 2, 131, 2, 133, 2,
-	135, 2, 137, 2, 139, 2, 141, 2, 143, 2, 145, 2, 3, 2, 13, 5, 2, 12, 12,
-	15, 15, 8234, 8235, 6, 2, 11, 11, 13, 14, 34, 34, 162, 162, 3, 2, 98, 98,
-	3, 2, 50, 59, 5, 2, 50, 59, 67, 72, 99, 104, 3, 2, 51, 59, 4, 2, 71, 71,
-	103, 103, 4, 2, 45, 45, 47, 47, 4, 2, 67, 92, 99, 124, 4, 2, 36, 36, 94,
-	94, 4, 2, 41, 41, 94, 94, 2, 552, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2,
-	7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2,
-	2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2,
-	2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2,
-	2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3,
-	2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45,
-	3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2,
-	53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2,
-	2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2,
-	2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2,
-	2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3,
-	2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91,
-	3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2,
-	99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2,
-	2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113,
-	3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2,
-	2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3,
-	2, 2, 2, 3, 147, 3, 2, 2, 2, 5, 161, 3, 2, 2, 2, 7, 173, 3, 2, 2, 2, 9,
-	179, 3, 2, 2, 2, 11, 183, 3, 2, 2, 2, 13, 185, 3, 2, 2, 2, 15, 187, 3,
-	2, 2, 2, 17, 189, 3, 2, 2, 2, 19, 191, 3, 2, 2, 2, 21, 193, 3, 2, 2, 2,
-	23, 195, 3, 2, 2, 2, 25, 197, 3, 2, 2, 2, 27, 199, 3, 2, 2, 2, 29, 201,
-	3, 2, 2, 2, 31, 203, 3, 2, 2, 2, 33, 205, 3, 2, 2, 2, 35, 207, 3, 2, 2,
-	2, 37, 210, 3, 2, 2, 2, 39, 213, 3, 2, 2, 2, 41, 216, 3, 2, 2, 2, 43, 219,
-	3, 2, 2, 2, 45, 221, 3, 2, 2, 2, 47, 223, 3, 2, 2, 2, 49, 225, 3, 2, 2,
-	2, 51, 227, 3, 2, 2, 2, 53, 229, 3, 2, 2, 2, 55, 232, 3, 2, 2, 2, 57, 240,
-	3, 2, 2, 2, 59, 246, 3, 2, 2, 2, 61, 248, 3, 2, 2, 2, 63, 251, 3, 2, 2,
-	2, 65, 253, 3, 2, 2, 2, 67, 255, 3, 2, 2, 2, 69, 258, 3, 2, 2, 2, 71, 261,
-	3, 2, 2, 2, 73, 265, 3, 2, 2, 2, 75, 272, 3, 2, 2, 2, 77, 281, 3, 2, 2,
-	2, 79, 288, 3, 2, 2, 2, 81, 293, 3, 2, 2, 2, 83, 299, 3, 2, 2, 2, 85, 303,
-	3, 2, 2, 2, 87, 318, 3, 2, 2, 2, 89, 320, 3, 2, 2, 2, 91, 325, 3, 2, 2,
-	2, 93, 348, 3, 2, 2, 2, 95, 350, 3, 2, 2, 2, 97, 355, 3, 2, 2, 2, 99, 360,
-	3, 2, 2, 2, 101, 365, 3, 2, 2, 2, 103, 371, 3, 2, 2, 2, 105, 375, 3, 2,
-	2, 2, 107, 379, 3, 2, 2, 2, 109, 389, 3, 2, 2, 2, 111, 398, 3, 2, 2, 2,
-	113, 400, 3, 2, 2, 2, 115, 403, 3, 2, 2, 2, 117, 406, 3, 2, 2, 2, 119,
-	436, 3, 2, 2, 2, 121, 438, 3, 2, 2, 2, 123, 450, 3, 2, 2, 2, 125, 468,
-	3, 2, 2, 2, 127, 470, 3, 2, 2, 2, 129, 473, 3, 2, 2, 2, 131, 483, 3, 2,
-	2, 2, 133, 485, 3, 2, 2, 2, 135, 494, 3, 2, 2, 2, 137, 496, 3, 2, 2, 2,
-	139, 498, 3, 2, 2, 2, 141, 500, 3, 2, 2, 2, 143, 513, 3, 2, 2, 2, 145,
-	526, 3, 2, 2, 2, 147, 148, 7, 49, 2, 2, 148, 149, 7, 44, 2, 2, 149, 153,
-	3, 2, 2, 2, 150, 152, 11, 2, 2, 2, 151, 150, 3, 2, 2, 2, 152, 155, 3, 2,
-	2, 2, 153, 154, 3, 2, 2, 2, 153, 151, 3, 2, 2, 2, 154, 156, 3, 2, 2, 2,
-	155, 153, 3, 2, 2, 2, 156, 157, 7, 44, 2, 2, 157, 158, 7, 49, 2, 2, 158,
-	159, 3, 2, 2, 2, 159, 160, 8, 2, 2, 2, 160, 4, 3, 2, 2, 2, 161, 162, 7,
-	49, 2, 2, 162, 163, 7, 49, 2, 2, 163, 167, 3, 2, 2, 2, 164, 166, 10, 2,
-	2, 2, 165, 164, 3, 2, 2, 2, 166, 169, 3, 2, 2, 2, 167, 165, 3, 2, 2, 2,
-	167, 168, 3, 2, 2, 2, 168, 170, 3, 2, 2, 2, 169, 167, 3, 2, 2, 2, 170,
-	171, 8, 3, 2, 2, 171, 6, 3, 2, 2, 2, 172, 174, 9, 3, 2, 2, 173, 172, 3,
-	2, 2, 2, 174, 175, 3, 2, 2, 2, 175, 173, 3, 2, 2, 2, 175, 176, 3, 2, 2,
-	2, 176, 177, 3, 2, 2, 2, 177, 178, 8, 4, 2, 2, 178, 8, 3, 2, 2, 2, 179,
-	180, 9, 2, 2, 2, 180, 181, 3, 2, 2, 2, 181, 182, 8, 5, 2, 2, 182, 10, 3,
-	2, 2, 2, 183, 184, 7, 60, 2, 2, 184, 12, 3, 2, 2, 2, 185, 186, 7, 61, 2,
-	2, 186, 14, 3, 2, 2, 2, 187, 188, 7, 48, 2, 2, 188, 16, 3, 2, 2, 2, 189,
-	190, 7, 46, 2, 2, 190, 18, 3, 2, 2, 2, 191, 192, 7, 93, 2, 2, 192, 20,
-	3, 2, 2, 2, 193, 194, 7, 95, 2, 2, 194, 22, 3, 2, 2, 2, 195, 196, 7, 42,
-	2, 2, 196, 24, 3, 2, 2, 2, 197, 198, 7, 43, 2, 2, 198, 26, 3, 2, 2, 2,
-	199, 200, 7, 125, 2, 2, 200, 28, 3, 2, 2, 2, 201, 202, 7, 127, 2, 2, 202,
-	30, 3, 2, 2, 2, 203, 204, 7, 64, 2, 2, 204, 32, 3, 2, 2, 2, 205, 206, 7,
-	62, 2, 2, 206, 34, 3, 2, 2, 2, 207, 208, 7, 63, 2, 2, 208, 209, 7, 63,
-	2, 2, 209, 36, 3, 2, 2, 2, 210, 211, 7, 64, 2, 2, 211, 212, 7, 63, 2, 2,
-	212, 38, 3, 2, 2, 2, 213, 214, 7, 62, 2, 2, 214, 215, 7, 63, 2, 2, 215,
-	40, 3, 2, 2, 2, 216, 217, 7, 35, 2, 2, 217, 218, 7, 63, 2, 2, 218, 42,
-	3, 2, 2, 2, 219, 220, 7, 44, 2, 2, 220, 44, 3, 2, 2, 2, 221, 222, 7, 49,
-	2, 2, 222, 46, 3, 2, 2, 2, 223, 224, 7, 39, 2, 2, 224, 48, 3, 2, 2, 2,
-	225, 226, 7, 45, 2, 2, 226, 50, 3, 2, 2, 2, 227, 228, 7, 47, 2, 2, 228,
-	52, 3, 2, 2, 2, 229, 230, 7, 47, 2, 2, 230, 231, 7, 47, 2, 2, 231, 54,
-	3, 2, 2, 2, 232, 233, 7, 45, 2, 2, 233, 234, 7, 45, 2, 2, 234, 56, 3, 2,
-	2, 2, 235, 236, 7, 67, 2, 2, 236, 237, 7, 80, 2, 2, 237, 241, 7, 70, 2,
-	2, 238, 239, 7, 40, 2, 2, 239, 241, 7, 40, 2, 2, 240, 235, 3, 2, 2, 2,
-	240, 238, 3, 2, 2, 2, 241, 58, 3, 2, 2, 2, 242, 243, 7, 81, 2, 2, 243,
-	247, 7, 84, 2, 2, 244, 245, 7, 126, 2, 2, 245, 247, 7, 126, 2, 2, 246,
-	242, 3, 2, 2, 2, 246, 244, 3, 2, 2, 2, 247, 60, 3, 2, 2, 2, 248, 249, 5,
-	15, 8, 2, 249, 250, 5, 15, 8, 2, 250, 62, 3, 2, 2, 2, 251, 252, 7, 63,
-	2, 2, 252, 64, 3, 2, 2, 2, 253, 254, 7, 65, 2, 2, 254, 66, 3, 2, 2, 2,
-	255, 256, 7, 35, 2, 2, 256, 257, 7, 128, 2, 2, 257, 68, 3, 2, 2, 2, 258,
-	259, 7, 63, 2, 2, 259, 260, 7, 128, 2, 2, 260, 70, 3, 2, 2, 2, 261, 262,
-	7, 72, 2, 2, 262, 263, 7, 81, 2, 2, 263, 264, 7, 84, 2, 2, 264, 72, 3,
-	2, 2, 2, 265, 266, 7, 84, 2, 2, 266, 267, 7, 71, 2, 2, 267, 268, 7, 86,
-	2, 2, 268, 269, 7, 87, 2, 2, 269, 270, 7, 84, 2, 2, 270, 271, 7, 80, 2,
-	2, 271, 74, 3, 2, 2, 2, 272, 273, 7, 70, 2, 2, 273, 274, 7, 75, 2, 2, 274,
-	275, 7, 85, 2, 2, 275, 276, 7, 86, 2, 2, 276, 277, 7, 75, 2, 2, 277, 278,
-	7, 80, 2, 2, 278, 279, 7, 69, 2, 2, 279, 280, 7, 86, 2, 2, 280, 76, 3,
-	2, 2, 2, 281, 282, 7, 72, 2, 2, 282, 283, 7, 75, 2, 2, 283, 284, 7, 78,
-	2, 2, 284, 285, 7, 86, 2, 2, 285, 286, 7, 71, 2, 2, 286, 287, 7, 84, 2,
-	2, 287, 78, 3, 2, 2, 2, 288, 289, 7, 85, 2, 2, 289, 290, 7, 81, 2, 2, 290,
-	291, 7, 84, 2, 2, 291, 292, 7, 86, 2, 2, 292, 80, 3, 2, 2, 2, 293, 294,
-	7, 78, 2, 2, 294, 295, 7, 75, 2, 2, 295, 296, 7, 79, 2, 2, 296, 297, 7,
-	75, 2, 2, 297, 298, 7, 86, 2, 2, 298, 82, 3, 2, 2, 2, 299, 300, 7, 78,
-	2, 2, 300, 301, 7, 71, 2, 2, 301, 302, 7, 86, 2, 2, 302, 84, 3, 2, 2, 2,
-	303, 304, 7, 69, 2, 2, 304, 305, 7, 81, 2, 2, 305, 306, 7, 78, 2, 2, 306,
-	307, 7, 78, 2, 2, 307, 308, 7, 71, 2, 2, 308, 309, 7, 69, 2, 2, 309, 310,
-	7, 86, 2, 2, 310, 86, 3, 2, 2, 2, 311, 312, 7, 67, 2, 2, 312, 313, 7, 85,
-	2, 2, 313, 319, 7, 69, 2, 2, 314, 315, 7, 70, 2, 2, 315, 316, 7, 71, 2,
-	2, 316, 317, 7, 85, 2, 2, 317, 319, 7, 69, 2, 2, 318, 311, 3, 2, 2, 2,
-	318, 314, 3, 2, 2, 2, 319, 88, 3, 2, 2, 2, 320, 321, 7, 80, 2, 2, 321,
-	322, 7, 81, 2, 2, 322, 323, 7, 80, 2, 2, 323, 324, 7, 71, 2, 2, 324, 90,
-	3, 2, 2, 2, 325, 326, 7, 80, 2, 2, 326, 327, 7, 87, 2, 2, 327, 328, 7,
-	78, 2, 2, 328, 329, 7, 78, 2, 2, 329, 92, 3, 2, 2, 2, 330, 331, 7, 86,
-	2, 2, 331, 332, 7, 84, 2, 2, 332, 333, 7, 87, 2, 2, 333, 349, 7, 71, 2,
-	2, 334, 335, 7, 118, 2, 2, 335, 336, 7, 116, 2, 2, 336, 337, 7, 119, 2,
-	2, 337, 349, 7, 103, 2, 2, 338, 339, 7, 72, 2, 2, 339, 340, 7, 67, 2, 2,
-	340, 341, 7, 78, 2, 2, 341, 342, 7, 85, 2, 2, 342, 349, 7, 71, 2, 2, 343,
-	344, 7, 104, 2, 2, 344, 345, 7, 99, 2, 2, 345, 346, 7, 110, 2, 2, 346,
-	347, 7, 117, 2, 2, 347, 349, 7, 103, 2, 2, 348, 330, 3, 2, 2, 2, 348, 334,
-	3, 2, 2, 2, 348, 338, 3, 2, 2, 2, 348, 343, 3, 2, 2, 2, 349, 94, 3, 2,
-	2, 2, 350, 351, 7, 75, 2, 2, 351, 352, 7, 80, 2, 2, 352, 353, 7, 86, 2,
-	2, 353, 354, 7, 81, 2, 2, 354, 96, 3, 2, 2, 2, 355, 356, 7, 77, 2, 2, 356,
-	357, 7, 71, 2, 2, 357, 358, 7, 71, 2, 2, 358, 359, 7, 82, 2, 2, 359, 98,
-	3, 2, 2, 2, 360, 361, 7, 89, 2, 2, 361, 362, 7, 75, 2, 2, 362, 363, 7,
-	86, 2, 2, 363, 364, 7, 74, 2, 2, 364, 100, 3, 2, 2, 2, 365, 366, 7, 69,
-	2, 2, 366, 367, 7, 81, 2, 2, 367, 368, 7, 87, 2, 2, 368, 369, 7, 80, 2,
-	2, 369, 370, 7, 86, 2, 2, 370, 102, 3, 2, 2, 2, 371, 372, 7, 67, 2, 2,
-	372, 373, 7, 78, 2, 2, 373, 374, 7, 78, 2, 2, 374, 104, 3, 2, 2, 2, 375,
-	376, 7, 67, 2, 2, 376, 377, 7, 80, 2, 2, 377, 378, 7, 91, 2, 2, 378, 106,
-	3, 2, 2, 2, 379, 380, 7, 67, 2, 2, 380, 381, 7, 73, 2, 2, 381, 382, 7,
-	73, 2, 2, 382, 383, 7, 84, 2, 2, 383, 384, 7, 71, 2, 2, 384, 385, 7, 73,
-	2, 2, 385, 386, 7, 67, 2, 2, 386, 387, 7, 86, 2, 2, 387, 388, 7, 71, 2,
-	2, 388, 108, 3, 2, 2, 2, 389, 390, 7, 78, 2, 2, 390, 391, 7, 75, 2, 2,
-	391, 392, 7, 77, 2, 2, 392, 393, 7, 71, 2, 2, 393, 110, 3, 2, 2, 2, 394,
-	395, 7, 80, 2, 2, 395, 396, 7, 81, 2, 2, 396, 399, 7, 86, 2, 2, 397, 399,
-	7, 35, 2, 2, 398, 394, 3, 2, 2, 2, 398, 397, 3, 2, 2, 2, 399, 112, 3, 2,
-	2, 2, 400, 401, 7, 75, 2, 2, 401, 402, 7, 80, 2, 2, 402, 114, 3, 2, 2,
-	2, 403, 404, 7, 66, 2, 2, 404, 116, 3, 2, 2, 2, 405, 407, 5, 135, 68, 2,
-	406, 405, 3, 2, 2, 2, 407, 408, 3, 2, 2, 2, 408, 406, 3, 2, 2, 2, 408,
-	409, 3, 2, 2, 2, 409, 419, 3, 2, 2, 2, 410, 414, 5, 137, 69, 2, 411, 413,
-	5, 117, 59, 2, 412, 411, 3, 2, 2, 2, 413, 416, 3, 2, 2, 2, 414, 412, 3,
-	2, 2, 2, 414, 415, 3, 2, 2, 2, 415, 418, 3, 2, 2, 2, 416, 414, 3, 2, 2,
-	2, 417, 410, 3, 2, 2, 2, 418, 421, 3, 2, 2, 2, 419, 417, 3, 2, 2, 2, 419,
-	420, 3, 2, 2, 2, 420, 431, 3, 2, 2, 2, 421, 419, 3, 2, 2, 2, 422, 426,
-	5, 139, 70, 2, 423, 425, 5, 117, 59, 2, 424, 423, 3, 2, 2, 2, 425, 428,
-	3, 2, 2, 2, 426, 424, 3, 2, 2, 2, 426, 427, 3, 2, 2, 2, 427, 430, 3, 2,
-	2, 2, 428, 426, 3, 2, 2, 2, 429, 422, 3, 2, 2, 2, 430, 433, 3, 2, 2, 2,
-	431, 429, 3, 2, 2, 2, 431, 432, 3, 2, 2, 2, 432, 118, 3, 2, 2, 2, 433,
-	431, 3, 2, 2, 2, 434, 437, 5, 143, 72, 2, 435, 437, 5, 141, 71, 2, 436,
-	434, 3, 2, 2, 2, 436, 435, 3, 2, 2, 2, 437, 120, 3, 2, 2, 2, 438, 444,
-	7, 98, 2, 2, 439, 440, 7, 94, 2, 2, 440, 443, 7, 98, 2, 2, 441, 443, 10,
-	4, 2, 2, 442, 439, 3, 2, 2, 2, 442, 441, 3, 2, 2, 2, 443, 446, 3, 2, 2,
-	2, 444, 442, 3, 2, 2, 2, 444, 445, 3, 2, 2, 2, 445, 447, 3, 2, 2, 2, 446,
-	444, 3, 2, 2, 2, 447, 448, 7, 98, 2, 2, 448, 122, 3, 2, 2, 2, 449, 451,
-	9, 5, 2, 2, 450, 449, 3, 2, 2, 2, 451, 452, 3, 2, 2, 2, 452, 450, 3, 2,
-	2, 2, 452, 453, 3, 2, 2, 2, 453, 124, 3, 2, 2, 2, 454, 455, 5, 131, 66,
-	2, 455, 457, 5, 15, 8, 2, 456, 458, 9, 5, 2, 2, 457, 456, 3, 2, 2, 2, 458,
-	459, 3, 2, 2, 2, 459, 457, 3, 2, 2, 2, 459, 460, 3, 2, 2, 2, 460, 462,
-	3, 2, 2, 2, 461, 463, 5, 133, 67, 2, 462, 461, 3, 2, 2, 2, 462, 463, 3,
-	2, 2, 2, 463, 469, 3, 2, 2, 2, 464, 466, 5, 131, 66, 2, 465, 467, 5, 133,
-	67, 2, 466, 465, 3, 2, 2, 2, 466, 467, 3, 2, 2, 2, 467, 469, 3, 2, 2, 2,
-	468, 454, 3, 2, 2, 2, 468, 464, 3, 2, 2, 2, 469, 126, 3, 2, 2, 2, 470,
-	471, 5, 117, 59, 2, 471, 472, 5, 145, 73, 2, 472, 128, 3, 2, 2, 2, 473,
-	474, 9, 6, 2, 2, 474, 130, 3, 2, 2, 2, 475, 484, 7, 50, 2, 2, 476, 480,
-	9, 7, 2, 2, 477, 479, 9, 5, 2, 2, 478, 477, 3, 2, 2, 2, 479, 482, 3, 2,
-	2, 2, 480, 478, 3, 2, 2, 2, 480, 481, 3, 2, 2, 2, 481, 484, 3, 2, 2, 2,
-	482, 480, 3, 2, 2, 2, 483, 475, 3, 2, 2, 2, 483, 476, 3, 2, 2, 2, 484,
-	132, 3, 2, 2, 2, 485, 487, 9, 8, 2, 2, 486, 488, 9, 9, 2, 2, 487, 486,
-	3, 2, 2, 2, 487, 488, 3, 2, 2, 2, 488, 490, 3, 2, 2, 2, 489, 491, 9, 5,
-	2, 2, 490, 489, 3, 2, 2, 2, 491, 492, 3, 2, 2, 2, 492, 490, 3, 2, 2, 2,
-	492, 493, 3, 2, 2, 2, 493, 134, 3, 2, 2, 2, 494, 495, 9, 10, 2, 2, 495,
-	136, 3, 2, 2, 2, 496, 497, 7, 97, 2, 2, 497, 138, 3, 2, 2, 2, 498, 499,
-	4, 50, 59, 2, 499, 140, 3, 2, 2, 2, 500, 508, 7, 36, 2, 2, 501, 502, 7,
-	94, 2, 2, 502, 507, 11, 2, 2, 2, 503, 504, 7, 36, 2, 2, 504, 507, 7, 36,
-	2, 2, 505, 507, 10, 11, 2, 2, 506, 501, 3, 2, 2, 2, 506, 503, 3, 2, 2,
-	2, 506, 505, 3, 2, 2, 2, 507, 510, 3, 2, 2, 2, 508, 506, 3, 2, 2, 2, 508,
-	509, 3, 2, 2, 2, 509, 511, 3, 2, 2, 2, 510, 508, 3, 2, 2, 2, 511, 512,
-	7, 36, 2, 2, 512, 142, 3, 2, 2, 2, 513, 521, 7, 41, 2, 2, 514, 515, 7,
-	94, 2, 2, 515, 520, 11, 2, 2, 2, 516, 517, 7, 41, 2, 2, 517, 520, 7, 41,
-	2, 2, 518, 520, 10, 12, 2, 2, 519, 514, 3, 2, 2, 2, 519, 516, 3, 2, 2,
-	2, 519, 518, 3, 2, 2, 2, 520, 523, 3, 2, 2, 2, 521, 519, 3, 2, 2, 2, 521,
-	522, 3, 2, 2, 2, 522, 524, 3, 2, 2, 2, 523, 521, 3, 2, 2, 2, 524, 525,
-	7, 41, 2, 2, 525, 144, 3, 2, 2, 2, 526, 527, 7, 60, 2, 2, 527, 528, 7,
-	60, 2, 2, 528, 146, 3, 2, 2, 2, 32, 2, 153, 167, 175, 240, 246, 318, 348,
-	398, 408, 414, 419, 426, 431, 436, 442, 444, 452, 459, 462, 466, 468, 480,
-	483, 487, 492, 506, 508, 519, 521, 3, 2, 3, 2,
+	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75,
+	4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 4, 80, 9, 80, 4,
+	81, 9, 81, 4, 82, 9, 82, 4, 83, 9, 83, 4, 84, 9, 84, 4, 85, 9, 85, 4, 86,
+	9, 86, 4, 87, 9, 87, 4, 88, 9, 88, 4, 89, 9, 89, 4, 90, 9, 90, 4, 91, 9,
+	91, 4, 92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96,
+	4, 97, 9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101,
+	4, 102, 9, 102, 4, 103, 9, 103, 4, 104, 9, 104, 4, 105, 9, 105, 4, 106,
+	9, 106, 4, 107, 9, 107, 4, 108, 9, 108, 4, 109, 9, 109, 4, 110, 9, 110,
+	4, 111, 9, 111, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 228, 10, 2, 12, 2, 14, 2,
+	231, 11, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3,
+	242, 10, 3, 12, 3, 14, 3, 245, 11, 3, 3, 3, 3, 3, 3, 4, 6, 4, 250, 10,
+	4, 13, 4, 14, 4, 251, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3,
+	7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12, 3,
+	12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3, 17, 3, 17,
+	3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3,
+	21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26,
+	3, 26, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3,
+	29, 3, 29, 3, 29, 5, 29, 318, 10, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30,
+	5, 30, 325, 10, 30, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3,
+	33, 3, 33, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 37,
+	3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3,
+	39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40,
+	3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3,
+	42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44,
+	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3,
+	45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 5, 45, 403, 10, 45, 3, 46, 3, 46,
+	3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3,
+	48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 5, 48, 426,
+	10, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50,
+	3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3,
+	52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 54,
+	3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3,
+	56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
+	3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3,
+	59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60,
+	3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3,
+	62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63,
+	3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3,
+	65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 537, 10, 66, 3, 67, 3, 67,
+	3, 67, 3, 68, 3, 68, 3, 69, 6, 69, 545, 10, 69, 13, 69, 14, 69, 546, 3,
+	69, 3, 69, 7, 69, 551, 10, 69, 12, 69, 14, 69, 554, 11, 69, 7, 69, 556,
+	10, 69, 12, 69, 14, 69, 559, 11, 69, 3, 69, 3, 69, 7, 69, 563, 10, 69,
+	12, 69, 14, 69, 566, 11, 69, 7, 69, 568, 10, 69, 12, 69, 14, 69, 571, 11,
+	69, 3, 70, 3, 70, 5, 70, 575, 10, 70, 3, 71, 3, 71, 3, 71, 3, 71, 7, 71,
+	581, 10, 71, 12, 71, 14, 71, 584, 11, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3,
+	72, 3, 72, 3, 72, 7, 72, 593, 10, 72, 12, 72, 14, 72, 596, 11, 72, 3, 72,
+	3, 72, 3, 73, 3, 73, 3, 73, 3, 73, 6, 73, 604, 10, 73, 13, 73, 14, 73,
+	605, 3, 73, 3, 73, 6, 73, 610, 10, 73, 13, 73, 14, 73, 611, 7, 73, 614,
+	10, 73, 12, 73, 14, 73, 617, 11, 73, 3, 73, 3, 73, 3, 73, 6, 73, 622, 10,
+	73, 13, 73, 14, 73, 623, 3, 73, 3, 73, 6, 73, 628, 10, 73, 13, 73, 14,
+	73, 629, 7, 73, 632, 10, 73, 12, 73, 14, 73, 635, 11, 73, 3, 73, 3, 73,
+	3, 73, 6, 73, 640, 10, 73, 13, 73, 14, 73, 641, 3, 73, 3, 73, 6, 73, 646,
+	10, 73, 13, 73, 14, 73, 647, 7, 73, 650, 10, 73, 12, 73, 14, 73, 653, 11,
+	73, 5, 73, 655, 10, 73, 3, 74, 3, 74, 3, 74, 3, 74, 5, 74, 661, 10, 74,
+	3, 74, 3, 74, 5, 74, 665, 10, 74, 5, 74, 667, 10, 74, 3, 75, 3, 75, 3,
+	75, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 5, 77, 677, 10, 77, 3, 77, 7, 77,
+	680, 10, 77, 12, 77, 14, 77, 683, 11, 77, 5, 77, 685, 10, 77, 3, 78, 6,
+	78, 688, 10, 78, 13, 78, 14, 78, 689, 3, 78, 3, 78, 6, 78, 694, 10, 78,
+	13, 78, 14, 78, 695, 7, 78, 698, 10, 78, 12, 78, 14, 78, 701, 11, 78, 3,
+	79, 3, 79, 5, 79, 705, 10, 79, 3, 79, 6, 79, 708, 10, 79, 13, 79, 14, 79,
+	709, 3, 80, 3, 80, 3, 81, 3, 81, 3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3,
+	83, 3, 83, 3, 83, 7, 83, 724, 10, 83, 12, 83, 14, 83, 727, 11, 83, 3, 83,
+	3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 7, 84, 737, 10, 84, 12,
+	84, 14, 84, 740, 11, 84, 3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86,
+	3, 87, 3, 87, 3, 88, 3, 88, 3, 89, 3, 89, 3, 90, 3, 90, 3, 91, 3, 91, 3,
+	92, 3, 92, 3, 93, 3, 93, 3, 94, 3, 94, 3, 95, 3, 95, 3, 96, 3, 96, 3, 97,
+	3, 97, 3, 98, 3, 98, 3, 99, 3, 99, 3, 100, 3, 100, 3, 101, 3, 101, 3, 102,
+	3, 102, 3, 103, 3, 103, 3, 104, 3, 104, 3, 105, 3, 105, 3, 106, 3, 106,
+	3, 107, 3, 107, 3, 108, 3, 108, 3, 109, 3, 109, 3, 110, 3, 110, 3, 111,
+	3, 111, 3, 229, 2, 112, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17,
+	10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35,
+	19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53,
+	28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71,
+	37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89,
+	46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54,
+	107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62,
+	123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137, 70,
+	139, 71, 141, 72, 143, 73, 145, 74, 147, 75, 149, 76, 151, 2, 153, 2, 155,
+	2, 157, 2, 159, 2, 161, 2, 163, 2, 165, 2, 167, 2, 169, 2, 171, 2, 173,
+	2, 175, 2, 177, 2, 179, 2, 181, 2, 183, 2, 185, 2, 187, 2, 189, 2, 191,
+	2, 193, 2, 195, 2, 197, 2, 199, 2, 201, 2, 203, 2, 205, 2, 207, 2, 209,
+	2, 211, 2, 213, 2, 215, 2, 217, 2, 219, 2, 221, 2, 3, 2, 39, 5, 2, 12,
+	12, 15, 15, 8234, 8235, 6, 2, 11, 11, 13, 14, 34, 34, 162, 162, 3, 2, 98,
+	98, 4, 2, 84, 84, 116, 116, 4, 2, 90, 90, 122, 122, 4, 2, 68, 68, 100,
+	100, 3, 2, 50, 51, 4, 2, 81, 81, 113, 113, 3, 2, 50, 57, 5, 2, 50, 59,
+	67, 72, 99, 104, 3, 2, 51, 59, 3, 2, 50, 59, 4, 2, 71, 71, 103, 103, 4,
+	2, 45, 45, 47, 47, 4, 2, 36, 36, 94, 94, 4, 2, 41, 41, 94, 94, 4, 2, 67,
+	67, 99, 99, 4, 2, 69, 69, 101, 101, 4, 2, 70, 70, 102, 102, 4, 2, 72, 72,
+	104, 104, 4, 2, 73, 73, 105, 105, 4, 2, 74, 74, 106, 106, 4, 2, 75, 75,
+	107, 107, 4, 2, 76, 76, 108, 108, 4, 2, 77, 77, 109, 109, 4, 2, 78, 78,
+	110, 110, 4, 2, 79, 79, 111, 111, 4, 2, 80, 80, 112, 112, 4, 2, 82, 82,
+	114, 114, 4, 2, 83, 83, 115, 115, 4, 2, 85, 85, 117, 117, 4, 2, 86, 86,
+	118, 118, 4, 2, 87, 87, 119, 119, 4, 2, 88, 88, 120, 120, 4, 2, 89, 89,
+	121, 121, 4, 2, 91, 91, 123, 123, 4, 2, 92, 92, 124, 124, 3, 686, 2, 67,
+	2, 92, 2, 99, 2, 124, 2, 172, 2, 172, 2, 183, 2, 183, 2, 188, 2, 188, 2,
+	194, 2, 216, 2, 218, 2, 248, 2, 250, 2, 707, 2, 712, 2, 723, 2, 738, 2,
+	742, 2, 750, 2, 750, 2, 752, 2, 752, 2, 882, 2, 886, 2, 888, 2, 889, 2,
+	892, 2, 895, 2, 897, 2, 897, 2, 904, 2, 904, 2, 906, 2, 908, 2, 910, 2,
+	910, 2, 912, 2, 931, 2, 933, 2, 1015, 2, 1017, 2, 1155, 2, 1164, 2, 1329,
+	2, 1331, 2, 1368, 2, 1371, 2, 1371, 2, 1378, 2, 1418, 2, 1490, 2, 1516,
+	2, 1521, 2, 1524, 2, 1570, 2, 1612, 2, 1648, 2, 1649, 2, 1651, 2, 1749,
+	2, 1751, 2, 1751, 2, 1767, 2, 1768, 2, 1776, 2, 1777, 2, 1788, 2, 1790,
+	2, 1793, 2, 1793, 2, 1810, 2, 1810, 2, 1812, 2, 1841, 2, 1871, 2, 1959,
+	2, 1971, 2, 1971, 2, 1996, 2, 2028, 2, 2038, 2, 2039, 2, 2044, 2, 2044,
+	2, 2050, 2, 2071, 2, 2076, 2, 2076, 2, 2086, 2, 2086, 2, 2090, 2, 2090,
+	2, 2114, 2, 2138, 2, 2146, 2, 2156, 2, 2162, 2, 2185, 2, 2187, 2, 2193,
+	2, 2210, 2, 2251, 2, 2310, 2, 2363, 2, 2367, 2, 2367, 2, 2386, 2, 2386,
+	2, 2394, 2, 2403, 2, 2419, 2, 2434, 2, 2439, 2, 2446, 2, 2449, 2, 2450,
+	2, 2453, 2, 2474, 2, 2476, 2, 2482, 2, 2484, 2, 2484, 2, 2488, 2, 2491,
+	2, 2495, 2, 2495, 2, 2512, 2, 2512, 2, 2526, 2, 2527, 2, 2529, 2, 2531,
+	2, 2546, 2, 2547, 2, 2558, 2, 2558, 2, 2567, 2, 2572, 2, 2577, 2, 2578,
+	2, 2581, 2, 2602, 2, 2604, 2, 2610, 2, 2612, 2, 2613, 2, 2615, 2, 2616,
+	2, 2618, 2, 2619, 2, 2651, 2, 2654, 2, 2656, 2, 2656, 2, 2676, 2, 2678,
+	2, 2695, 2, 2703, 2, 2705, 2, 2707, 2, 2709, 2, 2730, 2, 2732, 2, 2738,
+	2, 2740, 2, 2741, 2, 2743, 2, 2747, 2, 2751, 2, 2751, 2, 2770, 2, 2770,
+	2, 2786, 2, 2787, 2, 2811, 2, 2811, 2, 2823, 2, 2830, 2, 2833, 2, 2834,
+	2, 2837, 2, 2858, 2, 2860, 2, 2866, 2, 2868, 2, 2869, 2, 2871, 2, 2875,
+	2, 2879, 2, 2879, 2, 2910, 2, 2911, 2, 2913, 2, 2915, 2, 2931, 2, 2931,
+	2, 2949, 2, 2949, 2, 2951, 2, 2956, 2, 2960, 2, 2962, 2, 2964, 2, 2967,
+	2, 2971, 2, 2972, 2, 2974, 2, 2974, 2, 2976, 2, 2977, 2, 2981, 2, 2982,
+	2, 2986, 2, 2988, 2, 2992, 2, 3003, 2, 3026, 2, 3026, 2, 3079, 2, 3086,
+	2, 3088, 2, 3090, 2, 3092, 2, 3114, 2, 3116, 2, 3131, 2, 3135, 2, 3135,
+	2, 3162, 2, 3164, 2, 3166, 2, 3167, 2, 3170, 2, 3171, 2, 3202, 2, 3202,
+	2, 3207, 2, 3214, 2, 3216, 2, 3218, 2, 3220, 2, 3242, 2, 3244, 2, 3253,
+	2, 3255, 2, 3259, 2, 3263, 2, 3263, 2, 3294, 2, 3296, 2, 3298, 2, 3299,
+	2, 3315, 2, 3316, 2, 3334, 2, 3342, 2, 3344, 2, 3346, 2, 3348, 2, 3388,
+	2, 3391, 2, 3391, 2, 3408, 2, 3408, 2, 3414, 2, 3416, 2, 3425, 2, 3427,
+	2, 3452, 2, 3457, 2, 3463, 2, 3480, 2, 3484, 2, 3507, 2, 3509, 2, 3517,
+	2, 3519, 2, 3519, 2, 3522, 2, 3528, 2, 3587, 2, 3634, 2, 3636, 2, 3637,
+	2, 3650, 2, 3656, 2, 3715, 2, 3716, 2, 3718, 2, 3718, 2, 3720, 2, 3724,
+	2, 3726, 2, 3749, 2, 3751, 2, 3751, 2, 3753, 2, 3762, 2, 3764, 2, 3765,
+	2, 3775, 2, 3775, 2, 3778, 2, 3782, 2, 3784, 2, 3784, 2, 3806, 2, 3809,
+	2, 3842, 2, 3842, 2, 3906, 2, 3913, 2, 3915, 2, 3950, 2, 3978, 2, 3982,
+	2, 4098, 2, 4140, 2, 4161, 2, 4161, 2, 4178, 2, 4183, 2, 4188, 2, 4191,
+	2, 4195, 2, 4195, 2, 4199, 2, 4200, 2, 4208, 2, 4210, 2, 4215, 2, 4227,
+	2, 4240, 2, 4240, 2, 4258, 2, 4295, 2, 4297, 2, 4297, 2, 4303, 2, 4303,
+	2, 4306, 2, 4348, 2, 4350, 2, 4682, 2, 4684, 2, 4687, 2, 4690, 2, 4696,
+	2, 4698, 2, 4698, 2, 4700, 2, 4703, 2, 4706, 2, 4746, 2, 4748, 2, 4751,
+	2, 4754, 2, 4786, 2, 4788, 2, 4791, 2, 4794, 2, 4800, 2, 4802, 2, 4802,
+	2, 4804, 2, 4807, 2, 4810, 2, 4824, 2, 4826, 2, 4882, 2, 4884, 2, 4887,
+	2, 4890, 2, 4956, 2, 4994, 2, 5009, 2, 5026, 2, 5111, 2, 5114, 2, 5119,
+	2, 5123, 2, 5742, 2, 5745, 2, 5761, 2, 5763, 2, 5788, 2, 5794, 2, 5868,
+	2, 5875, 2, 5882, 2, 5890, 2, 5907, 2, 5921, 2, 5939, 2, 5954, 2, 5971,
+	2, 5986, 2, 5998, 2, 6000, 2, 6002, 2, 6018, 2, 6069, 2, 6105, 2, 6105,
+	2, 6110, 2, 6110, 2, 6178, 2, 6266, 2, 6274, 2, 6278, 2, 6281, 2, 6314,
+	2, 6316, 2, 6316, 2, 6322, 2, 6391, 2, 6402, 2, 6432, 2, 6482, 2, 6511,
+	2, 6514, 2, 6518, 2, 6530, 2, 6573, 2, 6578, 2, 6603, 2, 6658, 2, 6680,
+	2, 6690, 2, 6742, 2, 6825, 2, 6825, 2, 6919, 2, 6965, 2, 6983, 2, 6990,
+	2, 7045, 2, 7074, 2, 7088, 2, 7089, 2, 7100, 2, 7143, 2, 7170, 2, 7205,
+	2, 7247, 2, 7249, 2, 7260, 2, 7295, 2, 7298, 2, 7308, 2, 7314, 2, 7356,
+	2, 7359, 2, 7361, 2, 7403, 2, 7406, 2, 7408, 2, 7413, 2, 7415, 2, 7416,
+	2, 7420, 2, 7420, 2, 7426, 2, 7617, 2, 7682, 2, 7959, 2, 7962, 2, 7967,
+	2, 7970, 2, 8007, 2, 8010, 2, 8015, 2, 8018, 2, 8025, 2, 8027, 2, 8027,
+	2, 8029, 2, 8029, 2, 8031, 2, 8031, 2, 8033, 2, 8063, 2, 8066, 2, 8118,
+	2, 8120, 2, 8126, 2, 8128, 2, 8128, 2, 8132, 2, 8134, 2, 8136, 2, 8142,
+	2, 8146, 2, 8149, 2, 8152, 2, 8157, 2, 8162, 2, 8174, 2, 8180, 2, 8182,
+	2, 8184, 2, 8190, 2, 8307, 2, 8307, 2, 8321, 2, 8321, 2, 8338, 2, 8350,
+	2, 8452, 2, 8452, 2, 8457, 2, 8457, 2, 8460, 2, 8469, 2, 8471, 2, 8471,
+	2, 8475, 2, 8479, 2, 8486, 2, 8486, 2, 8488, 2, 8488, 2, 8490, 2, 8490,
+	2, 8492, 2, 8495, 2, 8497, 2, 8507, 2, 8510, 2, 8513, 2, 8519, 2, 8523,
+	2, 8528, 2, 8528, 2, 8581, 2, 8582, 2, 11266, 2, 11494, 2, 11501, 2, 11504,
+	2, 11508, 2, 11509, 2, 11522, 2, 11559, 2, 11561, 2, 11561, 2, 11567, 2,
+	11567, 2, 11570, 2, 11625, 2, 11633, 2, 11633, 2, 11650, 2, 11672, 2, 11682,
+	2, 11688, 2, 11690, 2, 11696, 2, 11698, 2, 11704, 2, 11706, 2, 11712, 2,
+	11714, 2, 11720, 2, 11722, 2, 11728, 2, 11730, 2, 11736, 2, 11738, 2, 11744,
+	2, 11825, 2, 11825, 2, 12295, 2, 12296, 2, 12339, 2, 12343, 2, 12349, 2,
+	12350, 2, 12355, 2, 12440, 2, 12447, 2, 12449, 2, 12451, 2, 12540, 2, 12542,
+	2, 12545, 2, 12551, 2, 12593, 2, 12595, 2, 12688, 2, 12706, 2, 12737, 2,
+	12786, 2, 12801, 2, 13314, 2, 19905, 2, 19970, 2, 42126, 2, 42194, 2, 42239,
+	2, 42242, 2, 42510, 2, 42514, 2, 42529, 2, 42540, 2, 42541, 2, 42562, 2,
+	42608, 2, 42625, 2, 42655, 2, 42658, 2, 42727, 2, 42777, 2, 42785, 2, 42788,
+	2, 42890, 2, 42893, 2, 42974, 2, 42995, 2, 43011, 2, 43013, 2, 43015, 2,
+	43017, 2, 43020, 2, 43022, 2, 43044, 2, 43074, 2, 43125, 2, 43140, 2, 43189,
+	2, 43252, 2, 43257, 2, 43261, 2, 43261, 2, 43263, 2, 43264, 2, 43276, 2,
+	43303, 2, 43314, 2, 43336, 2, 43362, 2, 43390, 2, 43398, 2, 43444, 2, 43473,
+	2, 43473, 2, 43490, 2, 43494, 2, 43496, 2, 43505, 2, 43516, 2, 43520, 2,
+	43522, 2, 43562, 2, 43586, 2, 43588, 2, 43590, 2, 43597, 2, 43618, 2, 43640,
+	2, 43644, 2, 43644, 2, 43648, 2, 43697, 2, 43699, 2, 43699, 2, 43703, 2,
+	43704, 2, 43707, 2, 43711, 2, 43714, 2, 43714, 2, 43716, 2, 43716, 2, 43741,
+	2, 43743, 2, 43746, 2, 43756, 2, 43764, 2, 43766, 2, 43779, 2, 43784, 2,
+	43787, 2, 43792, 2, 43795, 2, 43800, 2, 43810, 2, 43816, 2, 43818, 2, 43824,
+	2, 43826, 2, 43868, 2, 43870, 2, 43883, 2, 43890, 2, 44004, 2, 44034, 2,
+	55205, 2, 55218, 2, 55240, 2, 55245, 2, 55293, 2, 63746, 2, 64111, 2, 64114,
+	2, 64219, 2, 64258, 2, 64264, 2, 64277, 2, 64281, 2, 64287, 2, 64287, 2,
+	64289, 2, 64298, 2, 64300, 2, 64312, 2, 64314, 2, 64318, 2, 64320, 2, 64320,
+	2, 64322, 2, 64323, 2, 64325, 2, 64326, 2, 64328, 2, 64435, 2, 64469, 2,
+	64831, 2, 64850, 2, 64913, 2, 64916, 2, 64969, 2, 65010, 2, 65021, 2, 65138,
+	2, 65142, 2, 65144, 2, 65278, 2, 65315, 2, 65340, 2, 65347, 2, 65372, 2,
+	65384, 2, 65472, 2, 65476, 2, 65481, 2, 65484, 2, 65489, 2, 65492, 2, 65497,
+	2, 65500, 2, 65502, 2, 2, 3, 13, 3, 15, 3, 40, 3, 42, 3, 60, 3, 62, 3,
+	63, 3, 65, 3, 79, 3, 82, 3, 95, 3, 130, 3, 252, 3, 642, 3, 670, 3, 674,
+	3, 722, 3, 770, 3, 801, 3, 815, 3, 834, 3, 836, 3, 843, 3, 850, 3, 887,
+	3, 898, 3, 927, 3, 930, 3, 965, 3, 970, 3, 977, 3, 1026, 3, 1183, 3, 1202,
+	3, 1237, 3, 1242, 3, 1277, 3, 1282, 3, 1321, 3, 1330, 3, 1381, 3, 1394,
+	3, 1404, 3, 1406, 3, 1420, 3, 1422, 3, 1428, 3, 1430, 3, 1431, 3, 1433,
+	3, 1443, 3, 1445, 3, 1459, 3, 1461, 3, 1467, 3, 1469, 3, 1470, 3, 1474,
+	3, 1525, 3, 1538, 3, 1848, 3, 1858, 3, 1879, 3, 1890, 3, 1897, 3, 1922,
+	3, 1927, 3, 1929, 3, 1970, 3, 1972, 3, 1980, 3, 2050, 3, 2055, 3, 2058,
+	3, 2058, 3, 2060, 3, 2103, 3, 2105, 3, 2106, 3, 2110, 3, 2110, 3, 2113,
+	3, 2135, 3, 2146, 3, 2168, 3, 2178, 3, 2208, 3, 2274, 3, 2292, 3, 2294,
+	3, 2295, 3, 2306, 3, 2327, 3, 2338, 3, 2363, 3, 2370, 3, 2395, 3, 2434,
+	3, 2489, 3, 2496, 3, 2497, 3, 2562, 3, 2562, 3, 2578, 3, 2581, 3, 2583,
+	3, 2585, 3, 2587, 3, 2615, 3, 2658, 3, 2686, 3, 2690, 3, 2718, 3, 2754,
+	3, 2761, 3, 2763, 3, 2790, 3, 2818, 3, 2871, 3, 2882, 3, 2903, 3, 2914,
+	3, 2932, 3, 2946, 3, 2963, 3, 3074, 3, 3146, 3, 3202, 3, 3252, 3, 3266,
+	3, 3316, 3, 3330, 3, 3365, 3, 3404, 3, 3431, 3, 3441, 3, 3463, 3, 3714,
+	3, 3755, 3, 3762, 3, 3763, 3, 3780, 3, 3785, 3, 3842, 3, 3870, 3, 3881,
+	3, 3881, 3, 3890, 3, 3911, 3, 3954, 3, 3971, 3, 4018, 3, 4038, 3, 4066,
+	3, 4088, 3, 4101, 3, 4153, 3, 4211, 3, 4212, 3, 4215, 3, 4215, 3, 4229,
+	3, 4273, 3, 4306, 3, 4330, 3, 4357, 3, 4392, 3, 4422, 3, 4422, 3, 4425,
+	3, 4425, 3, 4434, 3, 4468, 3, 4472, 3, 4472, 3, 4485, 3, 4532, 3, 4547,
+	3, 4550, 3, 4572, 3, 4572, 3, 4574, 3, 4574, 3, 4610, 3, 4627, 3, 4629,
+	3, 4653, 3, 4673, 3, 4674, 3, 4738, 3, 4744, 3, 4746, 3, 4746, 3, 4748,
+	3, 4751, 3, 4753, 3, 4767, 3, 4769, 3, 4778, 3, 4786, 3, 4832, 3, 4871,
+	3, 4878, 3, 4881, 3, 4882, 3, 4885, 3, 4906, 3, 4908, 3, 4914, 3, 4916,
+	3, 4917, 3, 4919, 3, 4923, 3, 4927, 3, 4927, 3, 4946, 3, 4946, 3, 4959,
+	3, 4963, 3, 4994, 3, 5003, 3, 5005, 3, 5005, 3, 5008, 3, 5008, 3, 5010,
+	3, 5047, 3, 5049, 3, 5049, 3, 5075, 3, 5075, 3, 5077, 3, 5077, 3, 5122,
+	3, 5174, 3, 5193, 3, 5196, 3, 5217, 3, 5219, 3, 5250, 3, 5297, 3, 5318,
+	3, 5319, 3, 5321, 3, 5321, 3, 5506, 3, 5552, 3, 5594, 3, 5597, 3, 5634,
+	3, 5681, 3, 5702, 3, 5702, 3, 5762, 3, 5804, 3, 5818, 3, 5818, 3, 5890,
+	3, 5916, 3, 5954, 3, 5960, 3, 6146, 3, 6189, 3, 6306, 3, 6369, 3, 6401,
+	3, 6408, 3, 6411, 3, 6411, 3, 6414, 3, 6421, 3, 6423, 3, 6424, 3, 6426,
+	3, 6449, 3, 6465, 3, 6465, 3, 6467, 3, 6467, 3, 6562, 3, 6569, 3, 6572,
+	3, 6610, 3, 6627, 3, 6627, 3, 6629, 3, 6629, 3, 6658, 3, 6658, 3, 6669,
+	3, 6708, 3, 6716, 3, 6716, 3, 6738, 3, 6738, 3, 6750, 3, 6795, 3, 6815,
+	3, 6815, 3, 6834, 3, 6906, 3, 7106, 3, 7138, 3, 7170, 3, 7178, 3, 7180,
+	3, 7216, 3, 7234, 3, 7234, 3, 7284, 3, 7313, 3, 7426, 3, 7432, 3, 7434,
+	3, 7435, 3, 7437, 3, 7474, 3, 7496, 3, 7496, 3, 7522, 3, 7527, 3, 7529,
+	3, 7530, 3, 7532, 3, 7563, 3, 7578, 3, 7578, 3, 7602, 3, 7645, 3, 7906,
+	3, 7924, 3, 7940, 3, 7940, 3, 7942, 3, 7954, 3, 7956, 3, 7989, 3, 8114,
+	3, 8114, 3, 8194, 3, 9115, 3, 9346, 3, 9541, 3, 12178, 3, 12274, 3, 12290,
+	3, 13361, 3, 13379, 3, 13384, 3, 13410, 3, 17404, 3, 17410, 3, 17992, 3,
+	24834, 3, 24863, 3, 26626, 3, 27194, 3, 27202, 3, 27232, 3, 27250, 3, 27328,
+	3, 27346, 3, 27375, 3, 27394, 3, 27441, 3, 27458, 3, 27461, 3, 27493, 3,
+	27513, 3, 27519, 3, 27537, 3, 27970, 3, 28014, 3, 28226, 3, 28289, 3, 28322,
+	3, 28346, 3, 28349, 3, 28373, 3, 28418, 3, 28492, 3, 28498, 3, 28498, 3,
+	28565, 3, 28577, 3, 28642, 3, 28643, 3, 28645, 3, 28645, 3, 28660, 3, 28661,
+	3, 28674, 3, 36055, 3, 36097, 3, 36128, 3, 36226, 3, 36340, 3, 45042, 3,
+	45045, 3, 45047, 3, 45053, 3, 45055, 3, 45056, 3, 45058, 3, 45348, 3, 45364,
+	3, 45364, 3, 45394, 3, 45396, 3, 45399, 3, 45399, 3, 45414, 3, 45417, 3,
+	45426, 3, 45821, 3, 48130, 3, 48236, 3, 48242, 3, 48254, 3, 48258, 3, 48266,
+	3, 48274, 3, 48283, 3, 54274, 3, 54358, 3, 54360, 3, 54430, 3, 54432, 3,
+	54433, 3, 54436, 3, 54436, 3, 54439, 3, 54440, 3, 54443, 3, 54446, 3, 54448,
+	3, 54459, 3, 54461, 3, 54461, 3, 54463, 3, 54469, 3, 54471, 3, 54535, 3,
+	54537, 3, 54540, 3, 54543, 3, 54550, 3, 54552, 3, 54558, 3, 54560, 3, 54587,
+	3, 54589, 3, 54592, 3, 54594, 3, 54598, 3, 54600, 3, 54600, 3, 54604, 3,
+	54610, 3, 54612, 3, 54951, 3, 54954, 3, 54978, 3, 54980, 3, 55004, 3, 55006,
+	3, 55036, 3, 55038, 3, 55062, 3, 55064, 3, 55094, 3, 55096, 3, 55120, 3,
+	55122, 3, 55152, 3, 55154, 3, 55178, 3, 55180, 3, 55210, 3, 55212, 3, 55236,
+	3, 55238, 3, 55245, 3, 57090, 3, 57120, 3, 57127, 3, 57132, 3, 57394, 3,
+	57455, 3, 57602, 3, 57646, 3, 57657, 3, 57663, 3, 57680, 3, 57680, 3, 58002,
+	3, 58031, 3, 58050, 3, 58093, 3, 58578, 3, 58605, 3, 58834, 3, 58863, 3,
+	58866, 3, 58866, 3, 59074, 3, 59104, 3, 59106, 3, 59108, 3, 59110, 3, 59111,
+	3, 59113, 3, 59119, 3, 59122, 3, 59126, 3, 59136, 3, 59137, 3, 59362, 3,
+	59368, 3, 59370, 3, 59373, 3, 59375, 3, 59376, 3, 59378, 3, 59392, 3, 59394,
+	3, 59590, 3, 59650, 3, 59717, 3, 59725, 3, 59725, 3, 60930, 3, 60933, 3,
+	60935, 3, 60961, 3, 60963, 3, 60964, 3, 60966, 3, 60966, 3, 60969, 3, 60969,
+	3, 60971, 3, 60980, 3, 60982, 3, 60985, 3, 60987, 3, 60987, 3, 60989, 3,
+	60989, 3, 60996, 3, 60996, 3, 61001, 3, 61001, 3, 61003, 3, 61003, 3, 61005,
+	3, 61005, 3, 61007, 3, 61009, 3, 61011, 3, 61012, 3, 61014, 3, 61014, 3,
+	61017, 3, 61017, 3, 61019, 3, 61019, 3, 61021, 3, 61021, 3, 61023, 3, 61023,
+	3, 61025, 3, 61025, 3, 61027, 3, 61028, 3, 61030, 3, 61030, 3, 61033, 3,
+	61036, 3, 61038, 3, 61044, 3, 61046, 3, 61049, 3, 61051, 3, 61054, 3, 61056,
+	3, 61056, 3, 61058, 3, 61067, 3, 61069, 3, 61085, 3, 61091, 3, 61093, 3,
+	61095, 3, 61099, 3, 61101, 3, 61117, 3, 2, 4, 42721, 4, 42754, 4, 47135,
+	4, 47138, 4, 52911, 4, 52914, 4, 60386, 4, 60402, 4, 61023, 4, 63490, 4,
+	64031, 4, 2, 5, 4940, 5, 4946, 5, 13435, 5, 808, 2, 3, 3, 2, 2, 2, 2, 5,
+	3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13,
+	3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2,
+	21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2,
+	2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2,
+	2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2,
+	2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3,
+	2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59,
+	3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2,
+	67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2,
+	2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2,
+	2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2,
+	2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3,
+	2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2,
+	105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2,
+	2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119,
+	3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2,
+	2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3,
+	2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2,
+	141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147, 3, 2,
+	2, 2, 2, 149, 3, 2, 2, 2, 3, 223, 3, 2, 2, 2, 5, 237, 3, 2, 2, 2, 7, 249,
+	3, 2, 2, 2, 9, 255, 3, 2, 2, 2, 11, 259, 3, 2, 2, 2, 13, 261, 3, 2, 2,
+	2, 15, 263, 3, 2, 2, 2, 17, 265, 3, 2, 2, 2, 19, 267, 3, 2, 2, 2, 21, 269,
+	3, 2, 2, 2, 23, 271, 3, 2, 2, 2, 25, 273, 3, 2, 2, 2, 27, 275, 3, 2, 2,
+	2, 29, 277, 3, 2, 2, 2, 31, 279, 3, 2, 2, 2, 33, 281, 3, 2, 2, 2, 35, 283,
+	3, 2, 2, 2, 37, 286, 3, 2, 2, 2, 39, 289, 3, 2, 2, 2, 41, 292, 3, 2, 2,
+	2, 43, 295, 3, 2, 2, 2, 45, 297, 3, 2, 2, 2, 47, 299, 3, 2, 2, 2, 49, 301,
+	3, 2, 2, 2, 51, 303, 3, 2, 2, 2, 53, 305, 3, 2, 2, 2, 55, 308, 3, 2, 2,
+	2, 57, 317, 3, 2, 2, 2, 59, 324, 3, 2, 2, 2, 61, 326, 3, 2, 2, 2, 63, 329,
+	3, 2, 2, 2, 65, 333, 3, 2, 2, 2, 67, 335, 3, 2, 2, 2, 69, 337, 3, 2, 2,
+	2, 71, 340, 3, 2, 2, 2, 73, 343, 3, 2, 2, 2, 75, 347, 3, 2, 2, 2, 77, 354,
+	3, 2, 2, 2, 79, 363, 3, 2, 2, 2, 81, 370, 3, 2, 2, 2, 83, 375, 3, 2, 2,
+	2, 85, 381, 3, 2, 2, 2, 87, 385, 3, 2, 2, 2, 89, 402, 3, 2, 2, 2, 91, 404,
+	3, 2, 2, 2, 93, 409, 3, 2, 2, 2, 95, 425, 3, 2, 2, 2, 97, 427, 3, 2, 2,
+	2, 99, 433, 3, 2, 2, 2, 101, 441, 3, 2, 2, 2, 103, 446, 3, 2, 2, 2, 105,
+	451, 3, 2, 2, 2, 107, 456, 3, 2, 2, 2, 109, 462, 3, 2, 2, 2, 111, 466,
+	3, 2, 2, 2, 113, 470, 3, 2, 2, 2, 115, 480, 3, 2, 2, 2, 117, 487, 3, 2,
+	2, 2, 119, 497, 3, 2, 2, 2, 121, 504, 3, 2, 2, 2, 123, 509, 3, 2, 2, 2,
+	125, 514, 3, 2, 2, 2, 127, 522, 3, 2, 2, 2, 129, 526, 3, 2, 2, 2, 131,
+	536, 3, 2, 2, 2, 133, 538, 3, 2, 2, 2, 135, 541, 3, 2, 2, 2, 137, 544,
+	3, 2, 2, 2, 139, 574, 3, 2, 2, 2, 141, 576, 3, 2, 2, 2, 143, 587, 3, 2,
+	2, 2, 145, 654, 3, 2, 2, 2, 147, 666, 3, 2, 2, 2, 149, 668, 3, 2, 2, 2,
+	151, 671, 3, 2, 2, 2, 153, 684, 3, 2, 2, 2, 155, 687, 3, 2, 2, 2, 157,
+	702, 3, 2, 2, 2, 159, 711, 3, 2, 2, 2, 161, 713, 3, 2, 2, 2, 163, 715,
+	3, 2, 2, 2, 165, 717, 3, 2, 2, 2, 167, 730, 3, 2, 2, 2, 169, 743, 3, 2,
+	2, 2, 171, 746, 3, 2, 2, 2, 173, 748, 3, 2, 2, 2, 175, 750, 3, 2, 2, 2,
+	177, 752, 3, 2, 2, 2, 179, 754, 3, 2, 2, 2, 181, 756, 3, 2, 2, 2, 183,
+	758, 3, 2, 2, 2, 185, 760, 3, 2, 2, 2, 187, 762, 3, 2, 2, 2, 189, 764,
+	3, 2, 2, 2, 191, 766, 3, 2, 2, 2, 193, 768, 3, 2, 2, 2, 195, 770, 3, 2,
+	2, 2, 197, 772, 3, 2, 2, 2, 199, 774, 3, 2, 2, 2, 201, 776, 3, 2, 2, 2,
+	203, 778, 3, 2, 2, 2, 205, 780, 3, 2, 2, 2, 207, 782, 3, 2, 2, 2, 209,
+	784, 3, 2, 2, 2, 211, 786, 3, 2, 2, 2, 213, 788, 3, 2, 2, 2, 215, 790,
+	3, 2, 2, 2, 217, 792, 3, 2, 2, 2, 219, 794, 3, 2, 2, 2, 221, 796, 3, 2,
+	2, 2, 223, 224, 7, 49, 2, 2, 224, 225, 7, 44, 2, 2, 225, 229, 3, 2, 2,
+	2, 226, 228, 11, 2, 2, 2, 227, 226, 3, 2, 2, 2, 228, 231, 3, 2, 2, 2, 229,
+	230, 3, 2, 2, 2, 229, 227, 3, 2, 2, 2, 230, 232, 3, 2, 2, 2, 231, 229,
+	3, 2, 2, 2, 232, 233, 7, 44, 2, 2, 233, 234, 7, 49, 2, 2, 234, 235, 3,
+	2, 2, 2, 235, 236, 8, 2, 2, 2, 236, 4, 3, 2, 2, 2, 237, 238, 7, 49, 2,
+	2, 238, 239, 7, 49, 2, 2, 239, 243, 3, 2, 2, 2, 240, 242, 10, 2, 2, 2,
+	241, 240, 3, 2, 2, 2, 242, 245, 3, 2, 2, 2, 243, 241, 3, 2, 2, 2, 243,
+	244, 3, 2, 2, 2, 244, 246, 3, 2, 2, 2, 245, 243, 3, 2, 2, 2, 246, 247,
+	8, 3, 2, 2, 247, 6, 3, 2, 2, 2, 248, 250, 9, 3, 2, 2, 249, 248, 3, 2, 2,
+	2, 250, 251, 3, 2, 2, 2, 251, 249, 3, 2, 2, 2, 251, 252, 3, 2, 2, 2, 252,
+	253, 3, 2, 2, 2, 253, 254, 8, 4, 2, 2, 254, 8, 3, 2, 2, 2, 255, 256, 9,
+	2, 2, 2, 256, 257, 3, 2, 2, 2, 257, 258, 8, 5, 2, 2, 258, 10, 3, 2, 2,
+	2, 259, 260, 7, 60, 2, 2, 260, 12, 3, 2, 2, 2, 261, 262, 7, 61, 2, 2, 262,
+	14, 3, 2, 2, 2, 263, 264, 7, 48, 2, 2, 264, 16, 3, 2, 2, 2, 265, 266, 7,
+	46, 2, 2, 266, 18, 3, 2, 2, 2, 267, 268, 7, 93, 2, 2, 268, 20, 3, 2, 2,
+	2, 269, 270, 7, 95, 2, 2, 270, 22, 3, 2, 2, 2, 271, 272, 7, 42, 2, 2, 272,
+	24, 3, 2, 2, 2, 273, 274, 7, 43, 2, 2, 274, 26, 3, 2, 2, 2, 275, 276, 7,
+	125, 2, 2, 276, 28, 3, 2, 2, 2, 277, 278, 7, 127, 2, 2, 278, 30, 3, 2,
+	2, 2, 279, 280, 7, 64, 2, 2, 280, 32, 3, 2, 2, 2, 281, 282, 7, 62, 2, 2,
+	282, 34, 3, 2, 2, 2, 283, 284, 7, 63, 2, 2, 284, 285, 7, 63, 2, 2, 285,
+	36, 3, 2, 2, 2, 286, 287, 7, 64, 2, 2, 287, 288, 7, 63, 2, 2, 288, 38,
+	3, 2, 2, 2, 289, 290, 7, 62, 2, 2, 290, 291, 7, 63, 2, 2, 291, 40, 3, 2,
+	2, 2, 292, 293, 7, 35, 2, 2, 293, 294, 7, 63, 2, 2, 294, 42, 3, 2, 2, 2,
+	295, 296, 7, 44, 2, 2, 296, 44, 3, 2, 2, 2, 297, 298, 7, 49, 2, 2, 298,
+	46, 3, 2, 2, 2, 299, 300, 7, 39, 2, 2, 300, 48, 3, 2, 2, 2, 301, 302, 7,
+	45, 2, 2, 302, 50, 3, 2, 2, 2, 303, 304, 7, 47, 2, 2, 304, 52, 3, 2, 2,
+	2, 305, 306, 7, 47, 2, 2, 306, 307, 7, 47, 2, 2, 307, 54, 3, 2, 2, 2, 308,
+	309, 7, 45, 2, 2, 309, 310, 7, 45, 2, 2, 310, 56, 3, 2, 2, 2, 311, 312,
+	5, 171, 86, 2, 312, 313, 5, 197, 99, 2, 313, 314, 5, 177, 89, 2, 314, 318,
+	3, 2, 2, 2, 315, 316, 7, 40, 2, 2, 316, 318, 7, 40, 2, 2, 317, 311, 3,
+	2, 2, 2, 317, 315, 3, 2, 2, 2, 318, 58, 3, 2, 2, 2, 319, 320, 5, 199, 100,
+	2, 320, 321, 5, 205, 103, 2, 321, 325, 3, 2, 2, 2, 322, 323, 7, 126, 2,
+	2, 323, 325, 7, 126, 2, 2, 324, 319, 3, 2, 2, 2, 324, 322, 3, 2, 2, 2,
+	325, 60, 3, 2, 2, 2, 326, 327, 5, 15, 8, 2, 327, 328, 5, 15, 8, 2, 328,
+	62, 3, 2, 2, 2, 329, 330, 7, 48, 2, 2, 330, 331, 7, 48, 2, 2, 331, 332,
+	7, 48, 2, 2, 332, 64, 3, 2, 2, 2, 333, 334, 7, 63, 2, 2, 334, 66, 3, 2,
+	2, 2, 335, 336, 7, 65, 2, 2, 336, 68, 3, 2, 2, 2, 337, 338, 7, 35, 2, 2,
+	338, 339, 7, 128, 2, 2, 339, 70, 3, 2, 2, 2, 340, 341, 7, 63, 2, 2, 341,
+	342, 7, 128, 2, 2, 342, 72, 3, 2, 2, 2, 343, 344, 5, 181, 91, 2, 344, 345,
+	5, 199, 100, 2, 345, 346, 5, 205, 103, 2, 346, 74, 3, 2, 2, 2, 347, 348,
+	5, 205, 103, 2, 348, 349, 5, 179, 90, 2, 349, 350, 5, 209, 105, 2, 350,
+	351, 5, 211, 106, 2, 351, 352, 5, 205, 103, 2, 352, 353, 5, 197, 99, 2,
+	353, 76, 3, 2, 2, 2, 354, 355, 5, 177, 89, 2, 355, 356, 5, 187, 94, 2,
+	356, 357, 5, 207, 104, 2, 357, 358, 5, 209, 105, 2, 358, 359, 5, 187, 94,
+	2, 359, 360, 5, 197, 99, 2, 360, 361, 5, 175, 88, 2, 361, 362, 5, 209,
+	105, 2, 362, 78, 3, 2, 2, 2, 363, 364, 5, 181, 91, 2, 364, 365, 5, 187,
+	94, 2, 365, 366, 5, 193, 97, 2, 366, 367, 5, 209, 105, 2, 367, 368, 5,
+	179, 90, 2, 368, 369, 5, 205, 103, 2, 369, 80, 3, 2, 2, 2, 370, 371, 5,
+	207, 104, 2, 371, 372, 5, 199, 100, 2, 372, 373, 5, 205, 103, 2, 373, 374,
+	5, 209, 105, 2, 374, 82, 3, 2, 2, 2, 375, 376, 5, 193, 97, 2, 376, 377,
+	5, 187, 94, 2, 377, 378, 5, 195, 98, 2, 378, 379, 5, 187, 94, 2, 379, 380,
+	5, 209, 105, 2, 380, 84, 3, 2, 2, 2, 381, 382, 5, 193, 97, 2, 382, 383,
+	5, 179, 90, 2, 383, 384, 5, 209, 105, 2, 384, 86, 3, 2, 2, 2, 385, 386,
+	5, 175, 88, 2, 386, 387, 5, 199, 100, 2, 387, 388, 5, 193, 97, 2, 388,
+	389, 5, 193, 97, 2, 389, 390, 5, 179, 90, 2, 390, 391, 5, 175, 88, 2, 391,
+	392, 5, 209, 105, 2, 392, 88, 3, 2, 2, 2, 393, 394, 5, 171, 86, 2, 394,
+	395, 5, 207, 104, 2, 395, 396, 5, 175, 88, 2, 396, 403, 3, 2, 2, 2, 397,
+	398, 5, 177, 89, 2, 398, 399, 5, 179, 90, 2, 399, 400, 5, 207, 104, 2,
+	400, 401, 5, 175, 88, 2, 401, 403, 3, 2, 2, 2, 402, 393, 3, 2, 2, 2, 402,
+	397, 3, 2, 2, 2, 403, 90, 3, 2, 2, 2, 404, 405, 5, 197, 99, 2, 405, 406,
+	5, 199, 100, 2, 406, 407, 5, 197, 99, 2, 407, 408, 5, 179, 90, 2, 408,
+	92, 3, 2, 2, 2, 409, 410, 5, 197, 99, 2, 410, 411, 5, 211, 106, 2, 411,
+	412, 5, 193, 97, 2, 412, 413, 5, 193, 97, 2, 413, 94, 3, 2, 2, 2, 414,
+	415, 5, 209, 105, 2, 415, 416, 5, 205, 103, 2, 416, 417, 5, 211, 106, 2,
+	417, 418, 5, 179, 90, 2, 418, 426, 3, 2, 2, 2, 419, 420, 5, 181, 91, 2,
+	420, 421, 5, 171, 86, 2, 421, 422, 5, 193, 97, 2, 422, 423, 5, 207, 104,
+	2, 423, 424, 5, 179, 90, 2, 424, 426, 3, 2, 2, 2, 425, 414, 3, 2, 2, 2,
+	425, 419, 3, 2, 2, 2, 426, 96, 3, 2, 2, 2, 427, 428, 5, 197, 99, 2, 428,
+	429, 5, 211, 106, 2, 429, 430, 5, 193, 97, 2, 430, 431, 5, 193, 97, 2,
+	431, 432, 5, 207, 104, 2, 432, 98, 3, 2, 2, 2, 433, 434, 5, 175, 88, 2,
+	434, 435, 5, 199, 100, 2, 435, 436, 5, 193, 97, 2, 436, 437, 5, 193, 97,
+	2, 437, 438, 5, 171, 86, 2, 438, 439, 5, 209, 105, 2, 439, 440, 5, 179,
+	90, 2, 440, 100, 3, 2, 2, 2, 441, 442, 5, 187, 94, 2, 442, 443, 5, 197,
+	99, 2, 443, 444, 5, 209, 105, 2, 444, 445, 5, 199, 100, 2, 445, 102, 3,
+	2, 2, 2, 446, 447, 5, 191, 96, 2, 447, 448, 5, 179, 90, 2, 448, 449, 5,
+	179, 90, 2, 449, 450, 5, 201, 101, 2, 450, 104, 3, 2, 2, 2, 451, 452, 5,
+	215, 108, 2, 452, 453, 5, 187, 94, 2, 453, 454, 5, 209, 105, 2, 454, 455,
+	5, 185, 93, 2, 455, 106, 3, 2, 2, 2, 456, 457, 5, 175, 88, 2, 457, 458,
+	5, 199, 100, 2, 458, 459, 5, 211, 106, 2, 459, 460, 5, 197, 99, 2, 460,
+	461, 5, 209, 105, 2, 461, 108, 3, 2, 2, 2, 462, 463, 5, 171, 86, 2, 463,
+	464, 5, 193, 97, 2, 464, 465, 5, 193, 97, 2, 465, 110, 3, 2, 2, 2, 466,
+	467, 5, 171, 86, 2, 467, 468, 5, 197, 99, 2, 468, 469, 5, 219, 110, 2,
+	469, 112, 3, 2, 2, 2, 470, 471, 5, 171, 86, 2, 471, 472, 5, 183, 92, 2,
+	472, 473, 5, 183, 92, 2, 473, 474, 5, 205, 103, 2, 474, 475, 5, 179, 90,
+	2, 475, 476, 5, 183, 92, 2, 476, 477, 5, 171, 86, 2, 477, 478, 5, 209,
+	105, 2, 478, 479, 5, 179, 90, 2, 479, 114, 3, 2, 2, 2, 480, 481, 5, 215,
+	108, 2, 481, 482, 5, 187, 94, 2, 482, 483, 5, 197, 99, 2, 483, 484, 5,
+	177, 89, 2, 484, 485, 5, 199, 100, 2, 485, 486, 5, 215, 108, 2, 486, 116,
+	3, 2, 2, 2, 487, 488, 5, 201, 101, 2, 488, 489, 5, 171, 86, 2, 489, 490,
+	5, 205, 103, 2, 490, 491, 5, 209, 105, 2, 491, 492, 5, 187, 94, 2, 492,
+	493, 5, 209, 105, 2, 493, 494, 5, 187, 94, 2, 494, 495, 5, 199, 100, 2,
+	495, 496, 5, 197, 99, 2, 496, 118, 3, 2, 2, 2, 497, 498, 5, 207, 104, 2,
+	498, 499, 5, 215, 108, 2, 499, 500, 5, 187, 94, 2, 500, 501, 5, 209, 105,
+	2, 501, 502, 5, 175, 88, 2, 502, 503, 5, 185, 93, 2, 503, 120, 3, 2, 2,
+	2, 504, 505, 5, 215, 108, 2, 505, 506, 5, 185, 93, 2, 506, 507, 5, 179,
+	90, 2, 507, 508, 5, 197, 99, 2, 508, 122, 3, 2, 2, 2, 509, 510, 5, 175,
+	88, 2, 510, 511, 5, 171, 86, 2, 511, 512, 5, 207, 104, 2, 512, 513, 5,
+	179, 90, 2, 513, 124, 3, 2, 2, 2, 514, 515, 5, 177, 89, 2, 515, 516, 5,
+	179, 90, 2, 516, 517, 5, 181, 91, 2, 517, 518, 5, 171, 86, 2, 518, 519,
+	5, 211, 106, 2, 519, 520, 5, 193, 97, 2, 520, 521, 5, 209, 105, 2, 521,
+	126, 3, 2, 2, 2, 522, 523, 5, 179, 90, 2, 523, 524, 5, 197, 99, 2, 524,
+	525, 5, 177, 89, 2, 525, 128, 3, 2, 2, 2, 526, 527, 5, 193, 97, 2, 527,
+	528, 5, 187, 94, 2, 528, 529, 5, 191, 96, 2, 529, 530, 5, 179, 90, 2, 530,
+	130, 3, 2, 2, 2, 531, 532, 5, 197, 99, 2, 532, 533, 5, 199, 100, 2, 533,
+	534, 5, 209, 105, 2, 534, 537, 3, 2, 2, 2, 535, 537, 7, 35, 2, 2, 536,
+	531, 3, 2, 2, 2, 536, 535, 3, 2, 2, 2, 537, 132, 3, 2, 2, 2, 538, 539,
+	5, 187, 94, 2, 539, 540, 5, 197, 99, 2, 540, 134, 3, 2, 2, 2, 541, 542,
+	7, 66, 2, 2, 542, 136, 3, 2, 2, 2, 543, 545, 5, 159, 80, 2, 544, 543, 3,
+	2, 2, 2, 545, 546, 3, 2, 2, 2, 546, 544, 3, 2, 2, 2, 546, 547, 3, 2, 2,
+	2, 547, 557, 3, 2, 2, 2, 548, 552, 5, 161, 81, 2, 549, 551, 5, 137, 69,
+	2, 550, 549, 3, 2, 2, 2, 551, 554, 3, 2, 2, 2, 552, 550, 3, 2, 2, 2, 552,
+	553, 3, 2, 2, 2, 553, 556, 3, 2, 2, 2, 554, 552, 3, 2, 2, 2, 555, 548,
+	3, 2, 2, 2, 556, 559, 3, 2, 2, 2, 557, 555, 3, 2, 2, 2, 557, 558, 3, 2,
+	2, 2, 558, 569, 3, 2, 2, 2, 559, 557, 3, 2, 2, 2, 560, 564, 5, 163, 82,
+	2, 561, 563, 5, 137, 69, 2, 562, 561, 3, 2, 2, 2, 563, 566, 3, 2, 2, 2,
+	564, 562, 3, 2, 2, 2, 564, 565, 3, 2, 2, 2, 565, 568, 3, 2, 2, 2, 566,
+	564, 3, 2, 2, 2, 567, 560, 3, 2, 2, 2, 568, 571, 3, 2, 2, 2, 569, 567,
+	3, 2, 2, 2, 569, 570, 3, 2, 2, 2, 570, 138, 3, 2, 2, 2, 571, 569, 3, 2,
+	2, 2, 572, 575, 5, 167, 84, 2, 573, 575, 5, 165, 83, 2, 574, 572, 3, 2,
+	2, 2, 574, 573, 3, 2, 2, 2, 575, 140, 3, 2, 2, 2, 576, 582, 7, 98, 2, 2,
+	577, 578, 7, 94, 2, 2, 578, 581, 7, 98, 2, 2, 579, 581, 10, 4, 2, 2, 580,
+	577, 3, 2, 2, 2, 580, 579, 3, 2, 2, 2, 581, 584, 3, 2, 2, 2, 582, 580,
+	3, 2, 2, 2, 582, 583, 3, 2, 2, 2, 583, 585, 3, 2, 2, 2, 584, 582, 3, 2,
+	2, 2, 585, 586, 7, 98, 2, 2, 586, 142, 3, 2, 2, 2, 587, 588, 9, 5, 2, 2,
+	588, 594, 7, 98, 2, 2, 589, 590, 7, 94, 2, 2, 590, 593, 7, 98, 2, 2, 591,
+	593, 10, 4, 2, 2, 592, 589, 3, 2, 2, 2, 592, 591, 3, 2, 2, 2, 593, 596,
+	3, 2, 2, 2, 594, 592, 3, 2, 2, 2, 594, 595, 3, 2, 2, 2, 595, 597, 3, 2,
+	2, 2, 596, 594, 3, 2, 2, 2, 597, 598, 7, 98, 2, 2, 598, 144, 3, 2, 2, 2,
+	599, 655, 5, 155, 78, 2, 600, 601, 7, 50, 2, 2, 601, 603, 9, 6, 2, 2, 602,
+	604, 5, 151, 76, 2, 603, 602, 3, 2, 2, 2, 604, 605, 3, 2, 2, 2, 605, 603,
+	3, 2, 2, 2, 605, 606, 3, 2, 2, 2, 606, 615, 3, 2, 2, 2, 607, 609, 7, 97,
+	2, 2, 608, 610, 5, 151, 76, 2, 609, 608, 3, 2, 2, 2, 610, 611, 3, 2, 2,
+	2, 611, 609, 3, 2, 2, 2, 611, 612, 3, 2, 2, 2, 612, 614, 3, 2, 2, 2, 613,
+	607, 3, 2, 2, 2, 614, 617, 3, 2, 2, 2, 615, 613, 3, 2, 2, 2, 615, 616,
+	3, 2, 2, 2, 616, 655, 3, 2, 2, 2, 617, 615, 3, 2, 2, 2, 618, 619, 7, 50,
+	2, 2, 619, 621, 9, 7, 2, 2, 620, 622, 9, 8, 2, 2, 621, 620, 3, 2, 2, 2,
+	622, 623, 3, 2, 2, 2, 623, 621, 3, 2, 2, 2, 623, 624, 3, 2, 2, 2, 624,
+	633, 3, 2, 2, 2, 625, 627, 7, 97, 2, 2, 626, 628, 9, 8, 2, 2, 627, 626,
+	3, 2, 2, 2, 628, 629, 3, 2, 2, 2, 629, 627, 3, 2, 2, 2, 629, 630, 3, 2,
+	2, 2, 630, 632, 3, 2, 2, 2, 631, 625, 3, 2, 2, 2, 632, 635, 3, 2, 2, 2,
+	633, 631, 3, 2, 2, 2, 633, 634, 3, 2, 2, 2, 634, 655, 3, 2, 2, 2, 635,
+	633, 3, 2, 2, 2, 636, 637, 7, 50, 2, 2, 637, 639, 9, 9, 2, 2, 638, 640,
+	9, 10, 2, 2, 639, 638, 3, 2, 2, 2, 640, 641, 3, 2, 2, 2, 641, 639, 3, 2,
+	2, 2, 641, 642, 3, 2, 2, 2, 642, 651, 3, 2, 2, 2, 643, 645, 7, 97, 2, 2,
+	644, 646, 9, 10, 2, 2, 645, 644, 3, 2, 2, 2, 646, 647, 3, 2, 2, 2, 647,
+	645, 3, 2, 2, 2, 647, 648, 3, 2, 2, 2, 648, 650, 3, 2, 2, 2, 649, 643,
+	3, 2, 2, 2, 650, 653, 3, 2, 2, 2, 651, 649, 3, 2, 2, 2, 651, 652, 3, 2,
+	2, 2, 652, 655, 3, 2, 2, 2, 653, 651, 3, 2, 2, 2, 654, 599, 3, 2, 2, 2,
+	654, 600, 3, 2, 2, 2, 654, 618, 3, 2, 2, 2, 654, 636, 3, 2, 2, 2, 655,
+	146, 3, 2, 2, 2, 656, 657, 5, 153, 77, 2, 657, 658, 5, 15, 8, 2, 658, 660,
+	5, 155, 78, 2, 659, 661, 5, 157, 79, 2, 660, 659, 3, 2, 2, 2, 660, 661,
+	3, 2, 2, 2, 661, 667, 3, 2, 2, 2, 662, 664, 5, 153, 77, 2, 663, 665, 5,
+	157, 79, 2, 664, 663, 3, 2, 2, 2, 664, 665, 3, 2, 2, 2, 665, 667, 3, 2,
+	2, 2, 666, 656, 3, 2, 2, 2, 666, 662, 3, 2, 2, 2, 667, 148, 3, 2, 2, 2,
+	668, 669, 5, 137, 69, 2, 669, 670, 5, 169, 85, 2, 670, 150, 3, 2, 2, 2,
+	671, 672, 9, 11, 2, 2, 672, 152, 3, 2, 2, 2, 673, 685, 7, 50, 2, 2, 674,
+	681, 9, 12, 2, 2, 675, 677, 7, 97, 2, 2, 676, 675, 3, 2, 2, 2, 676, 677,
+	3, 2, 2, 2, 677, 678, 3, 2, 2, 2, 678, 680, 9, 13, 2, 2, 679, 676, 3, 2,
+	2, 2, 680, 683, 3, 2, 2, 2, 681, 679, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2,
+	682, 685, 3, 2, 2, 2, 683, 681, 3, 2, 2, 2, 684, 673, 3, 2, 2, 2, 684,
+	674, 3, 2, 2, 2, 685, 154, 3, 2, 2, 2, 686, 688, 9, 13, 2, 2, 687, 686,
+	3, 2, 2, 2, 688, 689, 3, 2, 2, 2, 689, 687, 3, 2, 2, 2, 689, 690, 3, 2,
+	2, 2, 690, 699, 3, 2, 2, 2, 691, 693, 7, 97, 2, 2, 692, 694, 9, 13, 2,
+	2, 693, 692, 3, 2, 2, 2, 694, 695, 3, 2, 2, 2, 695, 693, 3, 2, 2, 2, 695,
+	696, 3, 2, 2, 2, 696, 698, 3, 2, 2, 2, 697, 691, 3, 2, 2, 2, 698, 701,
+	3, 2, 2, 2, 699, 697, 3, 2, 2, 2, 699, 700, 3, 2, 2, 2, 700, 156, 3, 2,
+	2, 2, 701, 699, 3, 2, 2, 2, 702, 704, 9, 14, 2, 2, 703, 705, 9, 15, 2,
+	2, 704, 703, 3, 2, 2, 2, 704, 705, 3, 2, 2, 2, 705, 707, 3, 2, 2, 2, 706,
+	708, 9, 13, 2, 2, 707, 706, 3, 2, 2, 2, 708, 709, 3, 2, 2, 2, 709, 707,
+	3, 2, 2, 2, 709, 710, 3, 2, 2, 2, 710, 158, 3, 2, 2, 2, 711, 712, 9, 39,
+	2, 2, 712, 160, 3, 2, 2, 2, 713, 714, 7, 97, 2, 2, 714, 162, 3, 2, 2, 2,
+	715, 716, 4, 50, 59, 2, 716, 164, 3, 2, 2, 2, 717, 725, 7, 36, 2, 2, 718,
+	719, 7, 94, 2, 2, 719, 724, 11, 2, 2, 2, 720, 721, 7, 36, 2, 2, 721, 724,
+	7, 36, 2, 2, 722, 724, 10, 16, 2, 2, 723, 718, 3, 2, 2, 2, 723, 720, 3,
+	2, 2, 2, 723, 722, 3, 2, 2, 2, 724, 727, 3, 2, 2, 2, 725, 723, 3, 2, 2,
+	2, 725, 726, 3, 2, 2, 2, 726, 728, 3, 2, 2, 2, 727, 725, 3, 2, 2, 2, 728,
+	729, 7, 36, 2, 2, 729, 166, 3, 2, 2, 2, 730, 738, 7, 41, 2, 2, 731, 732,
+	7, 94, 2, 2, 732, 737, 11, 2, 2, 2, 733, 734, 7, 41, 2, 2, 734, 737, 7,
+	41, 2, 2, 735, 737, 10, 17, 2, 2, 736, 731, 3, 2, 2, 2, 736, 733, 3, 2,
+	2, 2, 736, 735, 3, 2, 2, 2, 737, 740, 3, 2, 2, 2, 738, 736, 3, 2, 2, 2,
+	738, 739, 3, 2, 2, 2, 739, 741, 3, 2, 2, 2, 740, 738, 3, 2, 2, 2, 741,
+	742, 7, 41, 2, 2, 742, 168, 3, 2, 2, 2, 743, 744, 7, 60, 2, 2, 744, 745,
+	7, 60, 2, 2, 745, 170, 3, 2, 2, 2, 746, 747, 9, 18, 2, 2, 747, 172, 3,
+	2, 2, 2, 748, 749, 9, 7, 2, 2, 749, 174, 3, 2, 2, 2, 750, 751, 9, 19, 2,
+	2, 751, 176, 3, 2, 2, 2, 752, 753, 9, 20, 2, 2, 753, 178, 3, 2, 2, 2, 754,
+	755, 9, 14, 2, 2, 755, 180, 3, 2, 2, 2, 756, 757, 9, 21, 2, 2, 757, 182,
+	3, 2, 2, 2, 758, 759, 9, 22, 2, 2, 759, 184, 3, 2, 2, 2, 760, 761, 9, 23,
+	2, 2, 761, 186, 3, 2, 2, 2, 762, 763, 9, 24, 2, 2, 763, 188, 3, 2, 2, 2,
+	764, 765, 9, 25, 2, 2, 765, 190, 3, 2, 2, 2, 766, 767, 9, 26, 2, 2, 767,
+	192, 3, 2, 2, 2, 768, 769, 9, 27, 2, 2, 769, 194, 3, 2, 2, 2, 770, 771,
+	9, 28, 2, 2, 771, 196, 3, 2, 2, 2, 772, 773, 9, 29, 2, 2, 773, 198, 3,
+	2, 2, 2, 774, 775, 9, 9, 2, 2, 775, 200, 3, 2, 2, 2, 776, 777, 9, 30, 2,
+	2, 777, 202, 3, 2, 2, 2, 778, 779, 9, 31, 2, 2, 779, 204, 3, 2, 2, 2, 780,
+	781, 9, 5, 2, 2, 781, 206, 3, 2, 2, 2, 782, 783, 9, 32, 2, 2, 783, 208,
+	3, 2, 2, 2, 784, 785, 9, 33, 2, 2, 785, 210, 3, 2, 2, 2, 786, 787, 9, 34,
+	2, 2, 787, 212, 3, 2, 2, 2, 788, 789, 9, 35, 2, 2, 789, 214, 3, 2, 2, 2,
+	790, 791, 9, 36, 2, 2, 791, 216, 3, 2, 2, 2, 792, 793, 9, 6, 2, 2, 793,
+	218, 3, 2, 2, 2, 794, 795, 9, 37, 2, 2, 795, 220, 3, 2, 2, 2, 796, 797,
+	9, 38, 2, 2, 797, 222, 3, 2, 2, 2, 46, 2, 229, 243, 251, 317, 324, 402,
+	425, 536, 546, 552, 557, 564, 569, 574, 580, 582, 592, 594, 605, 611, 615,
+	623, 629, 633, 641, 647, 651, 654, 660, 664, 666, 676, 681, 684, 689, 695,
+	699, 704, 709, 723, 725, 736, 738, 3, 2, 3, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -266,10 +566,9 @@ var lexerModeNames = []string{
 var lexerLiteralNames = []string{
 	"", "", "", "", "", "':'", "';'", "'.'", "','", "'['", "']'", "'('", "')'",
 	"'{'", "'}'", "'>'", "'<'", "'=='", "'>='", "'<='", "'!='", "'*'", "'/'",
-	"'%'", "'+'", "'-'", "'--'", "'++'", "", "", "", "'='", "'?'", "'!~'",
-	"'=~'", "'FOR'", "'RETURN'", "'DISTINCT'", "'FILTER'", "'SORT'", "'LIMIT'",
-	"'LET'", "'COLLECT'", "", "'NONE'", "'NULL'", "", "'INTO'", "'KEEP'", "'WITH'",
-	"'COUNT'", "'ALL'", "'ANY'", "'AGGREGATE'", "'LIKE'", "", "'IN'", "'@'",
+	"'%'", "'+'", "'-'", "'--'", "'++'", "", "", "", "'...'", "'='", "'?'",
+	"'!~'", "'=~'", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'@'",
 }
 
 var lexerSymbolicNames = []string{
@@ -277,12 +576,13 @@ var lexerSymbolicNames = []string{
 	"Colon", "SemiColon", "Dot", "Comma", "OpenBracket", "CloseBracket", "OpenParen",
 	"CloseParen", "OpenBrace", "CloseBrace", "Gt", "Lt", "Eq", "Gte", "Lte",
 	"Neq", "Multi", "Div", "Mod", "Plus", "Minus", "MinusMinus", "PlusPlus",
-	"And", "Or", "Range", "Assign", "QuestionMark", "RegexNotMatch", "RegexMatch",
-	"For", "Return", "Distinct", "Filter", "Sort", "Limit", "Let", "Collect",
-	"SortDirection", "None", "Null", "BooleanLiteral", "Into", "Keep", "With",
-	"Count", "All", "Any", "Aggregate", "Like", "Not", "In", "Param", "Identifier",
-	"StringLiteral", "TemplateStringLiteral", "IntegerLiteral", "FloatLiteral",
-	"NamespaceSegment",
+	"And", "Or", "Range", "Ellipsis", "Assign", "QuestionMark", "RegexNotMatch",
+	"RegexMatch", "For", "Return", "Distinct", "Filter", "Sort", "Limit", "Let",
+	"Collect", "SortDirection", "None", "Null", "BooleanLiteral", "Nulls",
+	"Collate", "Into", "Keep", "With", "Count", "All", "Any", "Aggregate",
+	"Window", "Partition", "Switch", "When", "Case", "Default", "End", "Like",
+	"Not", "In", "Param", "Identifier", "StringLiteral", "TemplateStringLiteral",
+	"RawStringLiteral", "IntegerLiteral", "FloatLiteral", "NamespaceSegment",
 }
 
 var lexerRuleNames = []string{
@@ -290,13 +590,17 @@ var lexerRuleNames = []string{
 	"Colon", "SemiColon", "Dot", "Comma", "OpenBracket", "CloseBracket", "OpenParen",
 	"CloseParen", "OpenBrace", "CloseBrace", "Gt", "Lt", "Eq", "Gte", "Lte",
 	"Neq", "Multi", "Div", "Mod", "Plus", "Minus", "MinusMinus", "PlusPlus",
-	"And", "Or", "Range", "Assign", "QuestionMark", "RegexNotMatch", "RegexMatch",
-	"For", "Return", "Distinct", "Filter", "Sort", "Limit", "Let", "Collect",
-	"SortDirection", "None", "Null", "BooleanLiteral", "Into", "Keep", "With",
-	"Count", "All", "Any", "Aggregate", "Like", "Not", "In", "Param", "Identifier",
-	"StringLiteral", "TemplateStringLiteral", "IntegerLiteral", "FloatLiteral",
-	"NamespaceSegment", "HexDigit", "DecimalIntegerLiteral", "ExponentPart",
-	"Letter", "Symbols", "Digit", "DQSring", "SQString", "NamespaceSeparator",
+	"And", "Or", "Range", "Ellipsis", "Assign", "QuestionMark", "RegexNotMatch",
+	"RegexMatch", "For", "Return", "Distinct", "Filter", "Sort", "Limit", "Let",
+	"Collect", "SortDirection", "None", "Null", "BooleanLiteral", "Nulls",
+	"Collate", "Into", "Keep", "With", "Count", "All", "Any", "Aggregate",
+	"Window", "Partition", "Switch", "When", "Case", "Default", "End", "Like",
+	"Not", "In", "Param", "Identifier", "StringLiteral", "TemplateStringLiteral",
+	"RawStringLiteral", "IntegerLiteral", "FloatLiteral", "NamespaceSegment",
+	"HexDigit", "DecimalIntegerLiteral", "DecimalDigits", "ExponentPart", "Letter",
+	"Symbols", "Digit", "DQSring", "SQString", "NamespaceSeparator", "A", "B",
+	"C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O", "P", "Q",
+	"R", "S", "T", "U", "V", "W", "X", "Y", "Z",
 }
 
 type FqlLexer struct {
@@ -364,37 +668,48 @@ const (
 	FqlLexerAnd                   = 28
 	FqlLexerOr                    = 29
 	FqlLexerRange                 = 30
-	FqlLexerAssign                = 31
-	FqlLexerQuestionMark          = 32
-	FqlLexerRegexNotMatch         = 33
-	FqlLexerRegexMatch            = 34
-	FqlLexerFor                   = 35
-	FqlLexerReturn                = 36
-	FqlLexerDistinct              = 37
-	FqlLexerFilter                = 38
-	FqlLexerSort                  = 39
-	FqlLexerLimit                 = 40
-	FqlLexerLet                   = 41
-	FqlLexerCollect               = 42
-	FqlLexerSortDirection         = 43
-	FqlLexerNone                  = 44
-	FqlLexerNull                  = 45
-	FqlLexerBooleanLiteral        = 46
-	FqlLexerInto                  = 47
-	FqlLexerKeep                  = 48
-	FqlLexerWith                  = 49
-	FqlLexerCount                 = 50
-	FqlLexerAll                   = 51
-	FqlLexerAny                   = 52
-	FqlLexerAggregate             = 53
-	FqlLexerLike                  = 54
-	FqlLexerNot                   = 55
-	FqlLexerIn                    = 56
-	FqlLexerParam                 = 57
-	FqlLexerIdentifier            = 58
-	FqlLexerStringLiteral         = 59
-	FqlLexerTemplateStringLiteral = 60
-	FqlLexerIntegerLiteral        = 61
-	FqlLexerFloatLiteral          = 62
-	FqlLexerNamespaceSegment      = 63
+	FqlLexerEllipsis              = 31
+	FqlLexerAssign                = 32
+	FqlLexerQuestionMark          = 33
+	FqlLexerRegexNotMatch         = 34
+	FqlLexerRegexMatch            = 35
+	FqlLexerFor                   = 36
+	FqlLexerReturn                = 37
+	FqlLexerDistinct              = 38
+	FqlLexerFilter                = 39
+	FqlLexerSort                  = 40
+	FqlLexerLimit                 = 41
+	FqlLexerLet                   = 42
+	FqlLexerCollect               = 43
+	FqlLexerSortDirection         = 44
+	FqlLexerNone                  = 45
+	FqlLexerNull                  = 46
+	FqlLexerBooleanLiteral        = 47
+	FqlLexerNulls                 = 48
+	FqlLexerCollate               = 49
+	FqlLexerInto                  = 50
+	FqlLexerKeep                  = 51
+	FqlLexerWith                  = 52
+	FqlLexerCount                 = 53
+	FqlLexerAll                   = 54
+	FqlLexerAny                   = 55
+	FqlLexerAggregate             = 56
+	FqlLexerWindow                = 57
+	FqlLexerPartition             = 58
+	FqlLexerSwitch                = 59
+	FqlLexerWhen                  = 60
+	FqlLexerCase                  = 61
+	FqlLexerDefault               = 62
+	FqlLexerEnd                   = 63
+	FqlLexerLike                  = 64
+	FqlLexerNot                   = 65
+	FqlLexerIn                    = 66
+	FqlLexerParam                 = 67
+	FqlLexerIdentifier            = 68
+	FqlLexerStringLiteral         = 69
+	FqlLexerTemplateStringLiteral = 70
+	FqlLexerRawStringLiteral      = 71
+	FqlLexerIntegerLiteral        = 72
+	FqlLexerFloatLiteral          = 73
+	FqlLexerNamespaceSegment      = 74
 )
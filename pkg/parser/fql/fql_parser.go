@@ -15,7 +15,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 65, 563,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 76, 692,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -26,247 +26,308 @@ var parserATN = []uint16{
 	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44, 9, 44,
 	4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9, 49, 4,
 	50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54, 4, 55,
-	9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 3, 2, 3, 2, 3, 3, 7, 3, 118, 10, 3,
-	12, 3, 14, 3, 121, 11, 3, 3, 3, 3, 3, 3, 4, 3, 4, 5, 4, 127, 10, 4, 3,
-	5, 3, 5, 5, 5, 131, 10, 5, 3, 6, 3, 6, 5, 6, 135, 10, 6, 3, 6, 3, 6, 3,
-	6, 5, 6, 140, 10, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 5, 6, 148, 10,
-	6, 3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 154, 10, 7, 3, 7, 3, 7, 3, 7, 7, 7, 159,
-	10, 7, 12, 7, 14, 7, 162, 11, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3,
-	10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 177, 10, 10, 3, 11,
-	3, 11, 3, 11, 3, 11, 5, 11, 183, 10, 11, 3, 12, 3, 12, 5, 12, 187, 10,
-	12, 3, 13, 3, 13, 5, 13, 191, 10, 13, 3, 14, 3, 14, 5, 14, 195, 10, 14,
-	3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 5, 16, 204, 10, 16, 3,
-	17, 3, 17, 5, 17, 208, 10, 17, 3, 18, 3, 18, 3, 18, 3, 18, 7, 18, 214,
-	10, 18, 12, 18, 14, 18, 217, 11, 18, 3, 19, 3, 19, 5, 19, 221, 10, 19,
-	3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3,
-	20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 5, 20, 241, 10, 20,
-	3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 7, 22, 250, 10, 22, 12,
-	22, 14, 22, 253, 11, 22, 3, 23, 3, 23, 3, 23, 3, 23, 7, 23, 259, 10, 23,
-	12, 23, 14, 23, 262, 11, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25,
-	3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 274, 10, 25, 5, 25, 276, 10, 25, 3,
-	26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27,
-	3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 298,
-	10, 27, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 5, 30,
-	308, 10, 30, 3, 30, 3, 30, 3, 30, 3, 30, 5, 30, 314, 10, 30, 3, 31, 3,
-	31, 5, 31, 318, 10, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 7, 32,
-	326, 10, 32, 12, 32, 14, 32, 329, 11, 32, 5, 32, 331, 10, 32, 3, 32, 5,
-	32, 334, 10, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34, 3, 34, 3, 35, 3, 35,
-	3, 36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 6, 38, 350, 10, 38, 13, 38, 14,
-	38, 351, 3, 38, 7, 38, 355, 10, 38, 12, 38, 14, 38, 358, 11, 38, 3, 39,
-	3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 369, 10,
-	39, 3, 40, 3, 40, 3, 40, 3, 40, 7, 40, 375, 10, 40, 12, 40, 14, 40, 378,
-	11, 40, 6, 40, 380, 10, 40, 13, 40, 14, 40, 381, 3, 40, 3, 40, 3, 40, 3,
-	40, 3, 40, 7, 40, 389, 10, 40, 12, 40, 14, 40, 392, 11, 40, 7, 40, 394,
-	10, 40, 12, 40, 14, 40, 397, 11, 40, 3, 40, 3, 40, 3, 40, 7, 40, 402, 10,
-	40, 12, 40, 14, 40, 405, 11, 40, 7, 40, 407, 10, 40, 12, 40, 14, 40, 410,
-	11, 40, 5, 40, 412, 10, 40, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3,
-	43, 3, 43, 5, 43, 422, 10, 43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 7, 45,
-	429, 10, 45, 12, 45, 14, 45, 432, 11, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3,
-	47, 3, 47, 3, 47, 3, 47, 7, 47, 442, 10, 47, 12, 47, 14, 47, 445, 11, 47,
-	5, 47, 447, 10, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3,
-	48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48,
-	3, 48, 5, 48, 468, 10, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3,
-	48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 5, 48, 482, 10, 48, 3, 48, 3, 48,
-	3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3,
-	48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 5, 48, 505,
-	10, 48, 3, 48, 3, 48, 7, 48, 509, 10, 48, 12, 48, 14, 48, 512, 11, 48,
-	3, 49, 3, 49, 3, 49, 5, 49, 517, 10, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3,
-	49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 5, 49, 542, 10,
-	49, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 5, 51, 549, 10, 51, 3, 52, 3, 52,
-	3, 53, 3, 53, 3, 54, 3, 54, 3, 55, 3, 55, 3, 56, 3, 56, 3, 57, 3, 57, 3,
-	57, 2, 3, 94, 58, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
+	9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4, 60, 9,
+	60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65, 9, 65,
+	4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9, 70, 3,
+	2, 3, 2, 3, 3, 7, 3, 144, 10, 3, 12, 3, 14, 3, 147, 11, 3, 3, 3, 3, 3,
+	3, 4, 3, 4, 5, 4, 153, 10, 4, 3, 5, 3, 5, 5, 5, 157, 10, 5, 3, 6, 3, 6,
+	5, 6, 161, 10, 6, 3, 6, 3, 6, 3, 6, 5, 6, 166, 10, 6, 3, 6, 3, 6, 3, 6,
+	3, 6, 3, 6, 3, 6, 5, 6, 174, 10, 6, 3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 180,
+	10, 7, 3, 7, 3, 7, 3, 7, 7, 7, 185, 10, 7, 12, 7, 14, 7, 188, 11, 7, 3,
+	7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3,
+	10, 3, 10, 5, 10, 203, 10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 5, 11,
+	210, 10, 11, 3, 12, 3, 12, 5, 12, 214, 10, 12, 3, 13, 3, 13, 5, 13, 218,
+	10, 13, 3, 14, 3, 14, 5, 14, 222, 10, 14, 3, 15, 3, 15, 3, 15, 3, 16, 3,
+	16, 3, 16, 3, 16, 5, 16, 231, 10, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18,
+	3, 18, 7, 18, 239, 10, 18, 12, 18, 14, 18, 242, 11, 18, 3, 19, 3, 19, 5,
+	19, 246, 10, 19, 3, 19, 5, 19, 249, 10, 19, 3, 19, 5, 19, 252, 10, 19,
+	3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 5, 22, 263,
+	10, 22, 3, 22, 3, 22, 3, 22, 5, 22, 268, 10, 22, 3, 22, 3, 22, 3, 22, 3,
+	22, 5, 22, 274, 10, 22, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 280, 10, 22,
+	3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 286, 10, 22, 3, 22, 3, 22, 3, 22, 5,
+	22, 291, 10, 22, 5, 22, 293, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24,
+	3, 24, 3, 24, 7, 24, 302, 10, 24, 12, 24, 14, 24, 305, 11, 24, 3, 25, 3,
+	25, 3, 25, 3, 25, 7, 25, 311, 10, 25, 12, 25, 14, 25, 314, 11, 25, 3, 26,
+	3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 5, 27, 326,
+	10, 27, 5, 27, 328, 10, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 29, 3,
+	29, 3, 29, 3, 30, 3, 30, 5, 30, 340, 10, 30, 3, 30, 5, 30, 343, 10, 30,
+	3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
+	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
+	3, 32, 5, 32, 367, 10, 32, 3, 33, 3, 33, 3, 33, 5, 33, 372, 10, 33, 3,
+	34, 3, 34, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 5, 36, 381, 10, 36, 3, 36,
+	3, 36, 3, 36, 3, 36, 5, 36, 387, 10, 36, 3, 37, 3, 37, 5, 37, 391, 10,
+	37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 7, 38, 399, 10, 38, 12, 38,
+	14, 38, 402, 11, 38, 5, 38, 404, 10, 38, 3, 38, 5, 38, 407, 10, 38, 3,
+	38, 3, 38, 3, 39, 3, 39, 3, 40, 3, 40, 3, 41, 3, 41, 3, 42, 3, 42, 3, 43,
+	3, 43, 3, 44, 3, 44, 6, 44, 423, 10, 44, 13, 44, 14, 44, 424, 3, 44, 7,
+	44, 428, 10, 44, 12, 44, 14, 44, 431, 11, 44, 3, 45, 5, 45, 434, 10, 45,
+	3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3,
+	46, 3, 46, 3, 46, 5, 46, 449, 10, 46, 3, 47, 3, 47, 3, 47, 3, 47, 7, 47,
+	455, 10, 47, 12, 47, 14, 47, 458, 11, 47, 6, 47, 460, 10, 47, 13, 47, 14,
+	47, 461, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 7, 47, 469, 10, 47, 12, 47,
+	14, 47, 472, 11, 47, 7, 47, 474, 10, 47, 12, 47, 14, 47, 477, 11, 47, 3,
+	47, 3, 47, 3, 47, 7, 47, 482, 10, 47, 12, 47, 14, 47, 485, 11, 47, 7, 47,
+	487, 10, 47, 12, 47, 14, 47, 490, 11, 47, 5, 47, 492, 10, 47, 3, 48, 3,
+	48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 5, 50, 503, 10, 50,
+	3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 7, 53, 512, 10, 53, 12,
+	53, 14, 53, 515, 11, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55,
+	3, 55, 7, 55, 525, 10, 55, 12, 55, 14, 55, 528, 11, 55, 5, 55, 530, 10,
+	55, 3, 55, 3, 55, 3, 56, 5, 56, 535, 10, 56, 3, 56, 3, 56, 3, 57, 3, 57,
+	3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3,
+	57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 5, 57, 558, 10, 57, 3, 57,
+	3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3,
+	57, 5, 57, 572, 10, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
+	3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3,
+	57, 3, 57, 3, 57, 3, 57, 5, 57, 595, 10, 57, 3, 57, 3, 57, 7, 57, 599,
+	10, 57, 12, 57, 14, 57, 602, 11, 57, 3, 58, 3, 58, 3, 58, 6, 58, 607, 10,
+	58, 13, 58, 14, 58, 608, 3, 58, 5, 58, 612, 10, 58, 3, 58, 3, 58, 3, 59,
+	3, 59, 6, 59, 618, 10, 59, 13, 59, 14, 59, 619, 3, 59, 5, 59, 623, 10,
+	59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 7, 60, 631, 10, 60, 12, 60,
+	14, 60, 634, 11, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3,
+	62, 3, 62, 3, 62, 5, 62, 646, 10, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
+	3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3,
+	62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 5, 62, 671, 10, 62,
+	3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 5, 64, 678, 10, 64, 3, 65, 3, 65, 3,
+	66, 3, 66, 3, 67, 3, 67, 3, 68, 3, 68, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70,
+	2, 3, 112, 71, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
 	32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
 	68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102,
-	104, 106, 108, 110, 112, 2, 9, 3, 2, 61, 62, 3, 2, 46, 47, 4, 2, 46, 46,
-	53, 54, 3, 2, 17, 22, 3, 2, 23, 25, 3, 2, 26, 27, 4, 2, 26, 27, 56, 57,
-	2, 592, 2, 114, 3, 2, 2, 2, 4, 119, 3, 2, 2, 2, 6, 126, 3, 2, 2, 2, 8,
-	130, 3, 2, 2, 2, 10, 147, 3, 2, 2, 2, 12, 149, 3, 2, 2, 2, 14, 165, 3,
-	2, 2, 2, 16, 167, 3, 2, 2, 2, 18, 176, 3, 2, 2, 2, 20, 182, 3, 2, 2, 2,
-	22, 186, 3, 2, 2, 2, 24, 190, 3, 2, 2, 2, 26, 194, 3, 2, 2, 2, 28, 196,
-	3, 2, 2, 2, 30, 199, 3, 2, 2, 2, 32, 207, 3, 2, 2, 2, 34, 209, 3, 2, 2,
-	2, 36, 218, 3, 2, 2, 2, 38, 240, 3, 2, 2, 2, 40, 242, 3, 2, 2, 2, 42, 246,
-	3, 2, 2, 2, 44, 254, 3, 2, 2, 2, 46, 263, 3, 2, 2, 2, 48, 275, 3, 2, 2,
-	2, 50, 277, 3, 2, 2, 2, 52, 297, 3, 2, 2, 2, 54, 299, 3, 2, 2, 2, 56, 302,
-	3, 2, 2, 2, 58, 307, 3, 2, 2, 2, 60, 315, 3, 2, 2, 2, 62, 321, 3, 2, 2,
-	2, 64, 337, 3, 2, 2, 2, 66, 339, 3, 2, 2, 2, 68, 341, 3, 2, 2, 2, 70, 343,
-	3, 2, 2, 2, 72, 345, 3, 2, 2, 2, 74, 347, 3, 2, 2, 2, 76, 368, 3, 2, 2,
-	2, 78, 411, 3, 2, 2, 2, 80, 413, 3, 2, 2, 2, 82, 415, 3, 2, 2, 2, 84, 421,
-	3, 2, 2, 2, 86, 423, 3, 2, 2, 2, 88, 430, 3, 2, 2, 2, 90, 433, 3, 2, 2,
-	2, 92, 437, 3, 2, 2, 2, 94, 467, 3, 2, 2, 2, 96, 541, 3, 2, 2, 2, 98, 543,
-	3, 2, 2, 2, 100, 548, 3, 2, 2, 2, 102, 550, 3, 2, 2, 2, 104, 552, 3, 2,
-	2, 2, 106, 554, 3, 2, 2, 2, 108, 556, 3, 2, 2, 2, 110, 558, 3, 2, 2, 2,
-	112, 560, 3, 2, 2, 2, 114, 115, 5, 4, 3, 2, 115, 3, 3, 2, 2, 2, 116, 118,
-	5, 6, 4, 2, 117, 116, 3, 2, 2, 2, 118, 121, 3, 2, 2, 2, 119, 117, 3, 2,
-	2, 2, 119, 120, 3, 2, 2, 2, 120, 122, 3, 2, 2, 2, 121, 119, 3, 2, 2, 2,
-	122, 123, 5, 8, 5, 2, 123, 5, 3, 2, 2, 2, 124, 127, 5, 90, 46, 2, 125,
-	127, 5, 52, 27, 2, 126, 124, 3, 2, 2, 2, 126, 125, 3, 2, 2, 2, 127, 7,
-	3, 2, 2, 2, 128, 131, 5, 10, 6, 2, 129, 131, 5, 12, 7, 2, 130, 128, 3,
-	2, 2, 2, 130, 129, 3, 2, 2, 2, 131, 9, 3, 2, 2, 2, 132, 134, 7, 38, 2,
-	2, 133, 135, 7, 39, 2, 2, 134, 133, 3, 2, 2, 2, 134, 135, 3, 2, 2, 2, 135,
-	136, 3, 2, 2, 2, 136, 148, 5, 94, 48, 2, 137, 139, 7, 38, 2, 2, 138, 140,
-	7, 39, 2, 2, 139, 138, 3, 2, 2, 2, 139, 140, 3, 2, 2, 2, 140, 141, 3, 2,
-	2, 2, 141, 142, 7, 13, 2, 2, 142, 143, 5, 12, 7, 2, 143, 144, 7, 14, 2,
-	2, 144, 148, 3, 2, 2, 2, 145, 146, 7, 38, 2, 2, 146, 148, 5, 96, 49, 2,
-	147, 132, 3, 2, 2, 2, 147, 137, 3, 2, 2, 2, 147, 145, 3, 2, 2, 2, 148,
-	11, 3, 2, 2, 2, 149, 150, 7, 37, 2, 2, 150, 153, 5, 14, 8, 2, 151, 152,
-	7, 10, 2, 2, 152, 154, 5, 16, 9, 2, 153, 151, 3, 2, 2, 2, 153, 154, 3,
-	2, 2, 2, 154, 155, 3, 2, 2, 2, 155, 156, 7, 58, 2, 2, 156, 160, 5, 18,
-	10, 2, 157, 159, 5, 24, 13, 2, 158, 157, 3, 2, 2, 2, 159, 162, 3, 2, 2,
-	2, 160, 158, 3, 2, 2, 2, 160, 161, 3, 2, 2, 2, 161, 163, 3, 2, 2, 2, 162,
-	160, 3, 2, 2, 2, 163, 164, 5, 26, 14, 2, 164, 13, 3, 2, 2, 2, 165, 166,
-	7, 60, 2, 2, 166, 15, 3, 2, 2, 2, 167, 168, 7, 60, 2, 2, 168, 17, 3, 2,
-	2, 2, 169, 177, 5, 90, 46, 2, 170, 177, 5, 60, 31, 2, 171, 177, 5, 62,
-	32, 2, 172, 177, 5, 56, 29, 2, 173, 177, 5, 78, 40, 2, 174, 177, 5, 58,
-	30, 2, 175, 177, 5, 54, 28, 2, 176, 169, 3, 2, 2, 2, 176, 170, 3, 2, 2,
-	2, 176, 171, 3, 2, 2, 2, 176, 172, 3, 2, 2, 2, 176, 173, 3, 2, 2, 2, 176,
-	174, 3, 2, 2, 2, 176, 175, 3, 2, 2, 2, 177, 19, 3, 2, 2, 2, 178, 183, 5,
-	30, 16, 2, 179, 183, 5, 34, 18, 2, 180, 183, 5, 28, 15, 2, 181, 183, 5,
-	38, 20, 2, 182, 178, 3, 2, 2, 2, 182, 179, 3, 2, 2, 2, 182, 180, 3, 2,
-	2, 2, 182, 181, 3, 2, 2, 2, 183, 21, 3, 2, 2, 2, 184, 187, 5, 52, 27, 2,
-	185, 187, 5, 90, 46, 2, 186, 184, 3, 2, 2, 2, 186, 185, 3, 2, 2, 2, 187,
-	23, 3, 2, 2, 2, 188, 191, 5, 22, 12, 2, 189, 191, 5, 20, 11, 2, 190, 188,
-	3, 2, 2, 2, 190, 189, 3, 2, 2, 2, 191, 25, 3, 2, 2, 2, 192, 195, 5, 10,
-	6, 2, 193, 195, 5, 12, 7, 2, 194, 192, 3, 2, 2, 2, 194, 193, 3, 2, 2, 2,
-	195, 27, 3, 2, 2, 2, 196, 197, 7, 40, 2, 2, 197, 198, 5, 94, 48, 2, 198,
-	29, 3, 2, 2, 2, 199, 200, 7, 42, 2, 2, 200, 203, 5, 32, 17, 2, 201, 202,
-	7, 10, 2, 2, 202, 204, 5, 32, 17, 2, 203, 201, 3, 2, 2, 2, 203, 204, 3,
-	2, 2, 2, 204, 31, 3, 2, 2, 2, 205, 208, 7, 63, 2, 2, 206, 208, 5, 54, 28,
-	2, 207, 205, 3, 2, 2, 2, 207, 206, 3, 2, 2, 2, 208, 33, 3, 2, 2, 2, 209,
-	210, 7, 41, 2, 2, 210, 215, 5, 36, 19, 2, 211, 212, 7, 10, 2, 2, 212, 214,
-	5, 36, 19, 2, 213, 211, 3, 2, 2, 2, 214, 217, 3, 2, 2, 2, 215, 213, 3,
-	2, 2, 2, 215, 216, 3, 2, 2, 2, 216, 35, 3, 2, 2, 2, 217, 215, 3, 2, 2,
-	2, 218, 220, 5, 94, 48, 2, 219, 221, 7, 45, 2, 2, 220, 219, 3, 2, 2, 2,
-	220, 221, 3, 2, 2, 2, 221, 37, 3, 2, 2, 2, 222, 223, 7, 44, 2, 2, 223,
-	241, 5, 50, 26, 2, 224, 225, 7, 44, 2, 2, 225, 241, 5, 44, 23, 2, 226,
-	227, 7, 44, 2, 2, 227, 228, 5, 42, 22, 2, 228, 229, 5, 44, 23, 2, 229,
-	241, 3, 2, 2, 2, 230, 231, 7, 44, 2, 2, 231, 232, 5, 42, 22, 2, 232, 233,
-	5, 48, 25, 2, 233, 241, 3, 2, 2, 2, 234, 235, 7, 44, 2, 2, 235, 236, 5,
-	42, 22, 2, 236, 237, 5, 50, 26, 2, 237, 241, 3, 2, 2, 2, 238, 239, 7, 44,
-	2, 2, 239, 241, 5, 42, 22, 2, 240, 222, 3, 2, 2, 2, 240, 224, 3, 2, 2,
-	2, 240, 226, 3, 2, 2, 2, 240, 230, 3, 2, 2, 2, 240, 234, 3, 2, 2, 2, 240,
-	238, 3, 2, 2, 2, 241, 39, 3, 2, 2, 2, 242, 243, 7, 60, 2, 2, 243, 244,
-	7, 33, 2, 2, 244, 245, 5, 94, 48, 2, 245, 41, 3, 2, 2, 2, 246, 251, 5,
-	40, 21, 2, 247, 248, 7, 10, 2, 2, 248, 250, 5, 40, 21, 2, 249, 247, 3,
-	2, 2, 2, 250, 253, 3, 2, 2, 2, 251, 249, 3, 2, 2, 2, 251, 252, 3, 2, 2,
-	2, 252, 43, 3, 2, 2, 2, 253, 251, 3, 2, 2, 2, 254, 255, 7, 55, 2, 2, 255,
-	260, 5, 46, 24, 2, 256, 257, 7, 10, 2, 2, 257, 259, 5, 46, 24, 2, 258,
-	256, 3, 2, 2, 2, 259, 262, 3, 2, 2, 2, 260, 258, 3, 2, 2, 2, 260, 261,
-	3, 2, 2, 2, 261, 45, 3, 2, 2, 2, 262, 260, 3, 2, 2, 2, 263, 264, 7, 60,
-	2, 2, 264, 265, 7, 33, 2, 2, 265, 266, 5, 90, 46, 2, 266, 47, 3, 2, 2,
-	2, 267, 268, 7, 49, 2, 2, 268, 276, 5, 40, 21, 2, 269, 270, 7, 49, 2, 2,
-	270, 273, 7, 60, 2, 2, 271, 272, 7, 50, 2, 2, 272, 274, 7, 60, 2, 2, 273,
-	271, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274, 276, 3, 2, 2, 2, 275, 267,
-	3, 2, 2, 2, 275, 269, 3, 2, 2, 2, 276, 49, 3, 2, 2, 2, 277, 278, 7, 51,
-	2, 2, 278, 279, 7, 52, 2, 2, 279, 280, 7, 49, 2, 2, 280, 281, 7, 60, 2,
-	2, 281, 51, 3, 2, 2, 2, 282, 283, 7, 43, 2, 2, 283, 284, 7, 60, 2, 2, 284,
-	285, 7, 33, 2, 2, 285, 298, 5, 94, 48, 2, 286, 287, 7, 43, 2, 2, 287, 288,
-	7, 60, 2, 2, 288, 289, 7, 33, 2, 2, 289, 290, 7, 13, 2, 2, 290, 291, 5,
-	12, 7, 2, 291, 292, 7, 14, 2, 2, 292, 298, 3, 2, 2, 2, 293, 294, 7, 43,
-	2, 2, 294, 295, 7, 60, 2, 2, 295, 296, 7, 33, 2, 2, 296, 298, 5, 96, 49,
-	2, 297, 282, 3, 2, 2, 2, 297, 286, 3, 2, 2, 2, 297, 293, 3, 2, 2, 2, 298,
-	53, 3, 2, 2, 2, 299, 300, 7, 59, 2, 2, 300, 301, 7, 60, 2, 2, 301, 55,
-	3, 2, 2, 2, 302, 303, 7, 60, 2, 2, 303, 57, 3, 2, 2, 2, 304, 308, 5, 68,
-	35, 2, 305, 308, 5, 56, 29, 2, 306, 308, 5, 54, 28, 2, 307, 304, 3, 2,
-	2, 2, 307, 305, 3, 2, 2, 2, 307, 306, 3, 2, 2, 2, 308, 309, 3, 2, 2, 2,
-	309, 313, 7, 32, 2, 2, 310, 314, 5, 68, 35, 2, 311, 314, 5, 56, 29, 2,
-	312, 314, 5, 54, 28, 2, 313, 310, 3, 2, 2, 2, 313, 311, 3, 2, 2, 2, 313,
-	312, 3, 2, 2, 2, 314, 59, 3, 2, 2, 2, 315, 317, 7, 11, 2, 2, 316, 318,
-	5, 74, 38, 2, 317, 316, 3, 2, 2, 2, 317, 318, 3, 2, 2, 2, 318, 319, 3,
-	2, 2, 2, 319, 320, 7, 12, 2, 2, 320, 61, 3, 2, 2, 2, 321, 330, 7, 15, 2,
-	2, 322, 327, 5, 76, 39, 2, 323, 324, 7, 10, 2, 2, 324, 326, 5, 76, 39,
-	2, 325, 323, 3, 2, 2, 2, 326, 329, 3, 2, 2, 2, 327, 325, 3, 2, 2, 2, 327,
-	328, 3, 2, 2, 2, 328, 331, 3, 2, 2, 2, 329, 327, 3, 2, 2, 2, 330, 322,
-	3, 2, 2, 2, 330, 331, 3, 2, 2, 2, 331, 333, 3, 2, 2, 2, 332, 334, 7, 10,
-	2, 2, 333, 332, 3, 2, 2, 2, 333, 334, 3, 2, 2, 2, 334, 335, 3, 2, 2, 2,
-	335, 336, 7, 16, 2, 2, 336, 63, 3, 2, 2, 2, 337, 338, 7, 48, 2, 2, 338,
-	65, 3, 2, 2, 2, 339, 340, 9, 2, 2, 2, 340, 67, 3, 2, 2, 2, 341, 342, 7,
-	63, 2, 2, 342, 69, 3, 2, 2, 2, 343, 344, 7, 64, 2, 2, 344, 71, 3, 2, 2,
-	2, 345, 346, 9, 3, 2, 2, 346, 73, 3, 2, 2, 2, 347, 356, 5, 94, 48, 2, 348,
-	350, 7, 10, 2, 2, 349, 348, 3, 2, 2, 2, 350, 351, 3, 2, 2, 2, 351, 349,
-	3, 2, 2, 2, 351, 352, 3, 2, 2, 2, 352, 353, 3, 2, 2, 2, 353, 355, 5, 94,
-	48, 2, 354, 349, 3, 2, 2, 2, 355, 358, 3, 2, 2, 2, 356, 354, 3, 2, 2, 2,
-	356, 357, 3, 2, 2, 2, 357, 75, 3, 2, 2, 2, 358, 356, 3, 2, 2, 2, 359, 360,
-	5, 84, 43, 2, 360, 361, 7, 7, 2, 2, 361, 362, 5, 94, 48, 2, 362, 369, 3,
-	2, 2, 2, 363, 364, 5, 82, 42, 2, 364, 365, 7, 7, 2, 2, 365, 366, 5, 94,
-	48, 2, 366, 369, 3, 2, 2, 2, 367, 369, 5, 80, 41, 2, 368, 359, 3, 2, 2,
-	2, 368, 363, 3, 2, 2, 2, 368, 367, 3, 2, 2, 2, 369, 77, 3, 2, 2, 2, 370,
-	379, 7, 60, 2, 2, 371, 372, 7, 9, 2, 2, 372, 376, 5, 84, 43, 2, 373, 375,
-	5, 82, 42, 2, 374, 373, 3, 2, 2, 2, 375, 378, 3, 2, 2, 2, 376, 374, 3,
-	2, 2, 2, 376, 377, 3, 2, 2, 2, 377, 380, 3, 2, 2, 2, 378, 376, 3, 2, 2,
-	2, 379, 371, 3, 2, 2, 2, 380, 381, 3, 2, 2, 2, 381, 379, 3, 2, 2, 2, 381,
-	382, 3, 2, 2, 2, 382, 412, 3, 2, 2, 2, 383, 384, 7, 60, 2, 2, 384, 395,
-	5, 82, 42, 2, 385, 386, 7, 9, 2, 2, 386, 390, 5, 84, 43, 2, 387, 389, 5,
-	82, 42, 2, 388, 387, 3, 2, 2, 2, 389, 392, 3, 2, 2, 2, 390, 388, 3, 2,
-	2, 2, 390, 391, 3, 2, 2, 2, 391, 394, 3, 2, 2, 2, 392, 390, 3, 2, 2, 2,
-	393, 385, 3, 2, 2, 2, 394, 397, 3, 2, 2, 2, 395, 393, 3, 2, 2, 2, 395,
-	396, 3, 2, 2, 2, 396, 408, 3, 2, 2, 2, 397, 395, 3, 2, 2, 2, 398, 403,
-	5, 82, 42, 2, 399, 400, 7, 9, 2, 2, 400, 402, 5, 84, 43, 2, 401, 399, 3,
-	2, 2, 2, 402, 405, 3, 2, 2, 2, 403, 401, 3, 2, 2, 2, 403, 404, 3, 2, 2,
-	2, 404, 407, 3, 2, 2, 2, 405, 403, 3, 2, 2, 2, 406, 398, 3, 2, 2, 2, 407,
-	410, 3, 2, 2, 2, 408, 406, 3, 2, 2, 2, 408, 409, 3, 2, 2, 2, 409, 412,
-	3, 2, 2, 2, 410, 408, 3, 2, 2, 2, 411, 370, 3, 2, 2, 2, 411, 383, 3, 2,
-	2, 2, 412, 79, 3, 2, 2, 2, 413, 414, 5, 56, 29, 2, 414, 81, 3, 2, 2, 2,
-	415, 416, 7, 11, 2, 2, 416, 417, 5, 94, 48, 2, 417, 418, 7, 12, 2, 2, 418,
-	83, 3, 2, 2, 2, 419, 422, 7, 60, 2, 2, 420, 422, 5, 66, 34, 2, 421, 419,
-	3, 2, 2, 2, 421, 420, 3, 2, 2, 2, 422, 85, 3, 2, 2, 2, 423, 424, 7, 13,
-	2, 2, 424, 425, 5, 94, 48, 2, 425, 426, 7, 14, 2, 2, 426, 87, 3, 2, 2,
-	2, 427, 429, 7, 65, 2, 2, 428, 427, 3, 2, 2, 2, 429, 432, 3, 2, 2, 2, 430,
-	428, 3, 2, 2, 2, 430, 431, 3, 2, 2, 2, 431, 89, 3, 2, 2, 2, 432, 430, 3,
-	2, 2, 2, 433, 434, 5, 88, 45, 2, 434, 435, 7, 60, 2, 2, 435, 436, 5, 92,
-	47, 2, 436, 91, 3, 2, 2, 2, 437, 446, 7, 13, 2, 2, 438, 443, 5, 94, 48,
-	2, 439, 440, 7, 10, 2, 2, 440, 442, 5, 94, 48, 2, 441, 439, 3, 2, 2, 2,
-	442, 445, 3, 2, 2, 2, 443, 441, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444,
-	447, 3, 2, 2, 2, 445, 443, 3, 2, 2, 2, 446, 438, 3, 2, 2, 2, 446, 447,
-	3, 2, 2, 2, 447, 448, 3, 2, 2, 2, 448, 449, 7, 14, 2, 2, 449, 93, 3, 2,
-	2, 2, 450, 451, 8, 48, 1, 2, 451, 452, 5, 112, 57, 2, 452, 453, 5, 94,
-	48, 24, 453, 468, 3, 2, 2, 2, 454, 468, 5, 90, 46, 2, 455, 468, 5, 86,
-	44, 2, 456, 468, 5, 58, 30, 2, 457, 468, 5, 66, 34, 2, 458, 468, 5, 68,
-	35, 2, 459, 468, 5, 70, 36, 2, 460, 468, 5, 64, 33, 2, 461, 468, 5, 60,
-	31, 2, 462, 468, 5, 62, 32, 2, 463, 468, 5, 56, 29, 2, 464, 468, 5, 78,
-	40, 2, 465, 468, 5, 72, 37, 2, 466, 468, 5, 54, 28, 2, 467, 450, 3, 2,
-	2, 2, 467, 454, 3, 2, 2, 2, 467, 455, 3, 2, 2, 2, 467, 456, 3, 2, 2, 2,
-	467, 457, 3, 2, 2, 2, 467, 458, 3, 2, 2, 2, 467, 459, 3, 2, 2, 2, 467,
-	460, 3, 2, 2, 2, 467, 461, 3, 2, 2, 2, 467, 462, 3, 2, 2, 2, 467, 463,
-	3, 2, 2, 2, 467, 464, 3, 2, 2, 2, 467, 465, 3, 2, 2, 2, 467, 466, 3, 2,
-	2, 2, 468, 510, 3, 2, 2, 2, 469, 470, 12, 23, 2, 2, 470, 471, 5, 108, 55,
-	2, 471, 472, 5, 94, 48, 24, 472, 509, 3, 2, 2, 2, 473, 474, 12, 22, 2,
-	2, 474, 475, 5, 110, 56, 2, 475, 476, 5, 94, 48, 23, 476, 509, 3, 2, 2,
-	2, 477, 478, 12, 19, 2, 2, 478, 481, 5, 98, 50, 2, 479, 482, 5, 100, 51,
-	2, 480, 482, 5, 102, 52, 2, 481, 479, 3, 2, 2, 2, 481, 480, 3, 2, 2, 2,
-	482, 483, 3, 2, 2, 2, 483, 484, 5, 94, 48, 20, 484, 509, 3, 2, 2, 2, 485,
-	486, 12, 18, 2, 2, 486, 487, 5, 100, 51, 2, 487, 488, 5, 94, 48, 19, 488,
-	509, 3, 2, 2, 2, 489, 490, 12, 17, 2, 2, 490, 491, 5, 102, 52, 2, 491,
-	492, 5, 94, 48, 18, 492, 509, 3, 2, 2, 2, 493, 494, 12, 16, 2, 2, 494,
-	495, 5, 104, 53, 2, 495, 496, 5, 94, 48, 17, 496, 509, 3, 2, 2, 2, 497,
-	498, 12, 15, 2, 2, 498, 499, 5, 106, 54, 2, 499, 500, 5, 94, 48, 16, 500,
-	509, 3, 2, 2, 2, 501, 502, 12, 14, 2, 2, 502, 504, 7, 34, 2, 2, 503, 505,
-	5, 94, 48, 2, 504, 503, 3, 2, 2, 2, 504, 505, 3, 2, 2, 2, 505, 506, 3,
-	2, 2, 2, 506, 507, 7, 7, 2, 2, 507, 509, 5, 94, 48, 15, 508, 469, 3, 2,
-	2, 2, 508, 473, 3, 2, 2, 2, 508, 477, 3, 2, 2, 2, 508, 485, 3, 2, 2, 2,
-	508, 489, 3, 2, 2, 2, 508, 493, 3, 2, 2, 2, 508, 497, 3, 2, 2, 2, 508,
-	501, 3, 2, 2, 2, 509, 512, 3, 2, 2, 2, 510, 508, 3, 2, 2, 2, 510, 511,
-	3, 2, 2, 2, 511, 95, 3, 2, 2, 2, 512, 510, 3, 2, 2, 2, 513, 514, 5, 94,
-	48, 2, 514, 516, 7, 34, 2, 2, 515, 517, 5, 94, 48, 2, 516, 515, 3, 2, 2,
-	2, 516, 517, 3, 2, 2, 2, 517, 518, 3, 2, 2, 2, 518, 519, 7, 7, 2, 2, 519,
-	520, 7, 13, 2, 2, 520, 521, 5, 12, 7, 2, 521, 522, 7, 14, 2, 2, 522, 542,
-	3, 2, 2, 2, 523, 524, 5, 94, 48, 2, 524, 525, 7, 34, 2, 2, 525, 526, 7,
-	13, 2, 2, 526, 527, 5, 12, 7, 2, 527, 528, 7, 14, 2, 2, 528, 529, 7, 7,
-	2, 2, 529, 530, 5, 94, 48, 2, 530, 542, 3, 2, 2, 2, 531, 532, 5, 94, 48,
-	2, 532, 533, 7, 34, 2, 2, 533, 534, 7, 13, 2, 2, 534, 535, 5, 12, 7, 2,
-	535, 536, 7, 14, 2, 2, 536, 537, 7, 7, 2, 2, 537, 538, 7, 13, 2, 2, 538,
-	539, 5, 12, 7, 2, 539, 540, 7, 14, 2, 2, 540, 542, 3, 2, 2, 2, 541, 513,
-	3, 2, 2, 2, 541, 523, 3, 2, 2, 2, 541, 531, 3, 2, 2, 2, 542, 97, 3, 2,
-	2, 2, 543, 544, 9, 4, 2, 2, 544, 99, 3, 2, 2, 2, 545, 549, 7, 58, 2, 2,
-	546, 547, 7, 57, 2, 2, 547, 549, 7, 58, 2, 2, 548, 545, 3, 2, 2, 2, 548,
-	546, 3, 2, 2, 2, 549, 101, 3, 2, 2, 2, 550, 551, 9, 5, 2, 2, 551, 103,
-	3, 2, 2, 2, 552, 553, 7, 30, 2, 2, 553, 105, 3, 2, 2, 2, 554, 555, 7, 31,
-	2, 2, 555, 107, 3, 2, 2, 2, 556, 557, 9, 6, 2, 2, 557, 109, 3, 2, 2, 2,
-	558, 559, 9, 7, 2, 2, 559, 111, 3, 2, 2, 2, 560, 561, 9, 8, 2, 2, 561,
-	113, 3, 2, 2, 2, 53, 119, 126, 130, 134, 139, 147, 153, 160, 176, 182,
-	186, 190, 194, 203, 207, 215, 220, 240, 251, 260, 273, 275, 297, 307, 313,
-	317, 327, 330, 333, 351, 356, 368, 376, 381, 390, 395, 403, 408, 411, 421,
-	430, 443, 446, 467, 481, 504, 508, 510, 516, 541, 548,
+	104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132,
+	134, 136, 138, 2, 11, 8, 2, 46, 46, 50, 51, 55, 57, 60, 60, 64, 65, 70,
+	70, 3, 2, 71, 73, 3, 2, 47, 48, 4, 2, 38, 66, 68, 68, 4, 2, 47, 47, 56,
+	57, 3, 2, 17, 22, 3, 2, 23, 25, 3, 2, 26, 27, 4, 2, 26, 27, 66, 67, 2,
+	730, 2, 140, 3, 2, 2, 2, 4, 145, 3, 2, 2, 2, 6, 152, 3, 2, 2, 2, 8, 156,
+	3, 2, 2, 2, 10, 173, 3, 2, 2, 2, 12, 175, 3, 2, 2, 2, 14, 191, 3, 2, 2,
+	2, 16, 193, 3, 2, 2, 2, 18, 202, 3, 2, 2, 2, 20, 209, 3, 2, 2, 2, 22, 213,
+	3, 2, 2, 2, 24, 217, 3, 2, 2, 2, 26, 221, 3, 2, 2, 2, 28, 223, 3, 2, 2,
+	2, 30, 226, 3, 2, 2, 2, 32, 232, 3, 2, 2, 2, 34, 234, 3, 2, 2, 2, 36, 243,
+	3, 2, 2, 2, 38, 253, 3, 2, 2, 2, 40, 256, 3, 2, 2, 2, 42, 292, 3, 2, 2,
+	2, 44, 294, 3, 2, 2, 2, 46, 298, 3, 2, 2, 2, 48, 306, 3, 2, 2, 2, 50, 315,
+	3, 2, 2, 2, 52, 327, 3, 2, 2, 2, 54, 329, 3, 2, 2, 2, 56, 334, 3, 2, 2,
+	2, 58, 337, 3, 2, 2, 2, 60, 346, 3, 2, 2, 2, 62, 366, 3, 2, 2, 2, 64, 368,
+	3, 2, 2, 2, 66, 373, 3, 2, 2, 2, 68, 375, 3, 2, 2, 2, 70, 380, 3, 2, 2,
+	2, 72, 388, 3, 2, 2, 2, 74, 394, 3, 2, 2, 2, 76, 410, 3, 2, 2, 2, 78, 412,
+	3, 2, 2, 2, 80, 414, 3, 2, 2, 2, 82, 416, 3, 2, 2, 2, 84, 418, 3, 2, 2,
+	2, 86, 420, 3, 2, 2, 2, 88, 433, 3, 2, 2, 2, 90, 448, 3, 2, 2, 2, 92, 491,
+	3, 2, 2, 2, 94, 493, 3, 2, 2, 2, 96, 495, 3, 2, 2, 2, 98, 502, 3, 2, 2,
+	2, 100, 504, 3, 2, 2, 2, 102, 506, 3, 2, 2, 2, 104, 513, 3, 2, 2, 2, 106,
+	516, 3, 2, 2, 2, 108, 520, 3, 2, 2, 2, 110, 534, 3, 2, 2, 2, 112, 557,
+	3, 2, 2, 2, 114, 603, 3, 2, 2, 2, 116, 615, 3, 2, 2, 2, 118, 626, 3, 2,
+	2, 2, 120, 638, 3, 2, 2, 2, 122, 670, 3, 2, 2, 2, 124, 672, 3, 2, 2, 2,
+	126, 677, 3, 2, 2, 2, 128, 679, 3, 2, 2, 2, 130, 681, 3, 2, 2, 2, 132,
+	683, 3, 2, 2, 2, 134, 685, 3, 2, 2, 2, 136, 687, 3, 2, 2, 2, 138, 689,
+	3, 2, 2, 2, 140, 141, 5, 4, 3, 2, 141, 3, 3, 2, 2, 2, 142, 144, 5, 6, 4,
+	2, 143, 142, 3, 2, 2, 2, 144, 147, 3, 2, 2, 2, 145, 143, 3, 2, 2, 2, 145,
+	146, 3, 2, 2, 2, 146, 148, 3, 2, 2, 2, 147, 145, 3, 2, 2, 2, 148, 149,
+	5, 8, 5, 2, 149, 5, 3, 2, 2, 2, 150, 153, 5, 106, 54, 2, 151, 153, 5, 62,
+	32, 2, 152, 150, 3, 2, 2, 2, 152, 151, 3, 2, 2, 2, 153, 7, 3, 2, 2, 2,
+	154, 157, 5, 10, 6, 2, 155, 157, 5, 12, 7, 2, 156, 154, 3, 2, 2, 2, 156,
+	155, 3, 2, 2, 2, 157, 9, 3, 2, 2, 2, 158, 160, 7, 39, 2, 2, 159, 161, 7,
+	40, 2, 2, 160, 159, 3, 2, 2, 2, 160, 161, 3, 2, 2, 2, 161, 162, 3, 2, 2,
+	2, 162, 174, 5, 112, 57, 2, 163, 165, 7, 39, 2, 2, 164, 166, 7, 40, 2,
+	2, 165, 164, 3, 2, 2, 2, 165, 166, 3, 2, 2, 2, 166, 167, 3, 2, 2, 2, 167,
+	168, 7, 13, 2, 2, 168, 169, 5, 12, 7, 2, 169, 170, 7, 14, 2, 2, 170, 174,
+	3, 2, 2, 2, 171, 172, 7, 39, 2, 2, 172, 174, 5, 122, 62, 2, 173, 158, 3,
+	2, 2, 2, 173, 163, 3, 2, 2, 2, 173, 171, 3, 2, 2, 2, 174, 11, 3, 2, 2,
+	2, 175, 176, 7, 38, 2, 2, 176, 179, 5, 14, 8, 2, 177, 178, 7, 10, 2, 2,
+	178, 180, 5, 16, 9, 2, 179, 177, 3, 2, 2, 2, 179, 180, 3, 2, 2, 2, 180,
+	181, 3, 2, 2, 2, 181, 182, 7, 68, 2, 2, 182, 186, 5, 18, 10, 2, 183, 185,
+	5, 24, 13, 2, 184, 183, 3, 2, 2, 2, 185, 188, 3, 2, 2, 2, 186, 184, 3,
+	2, 2, 2, 186, 187, 3, 2, 2, 2, 187, 189, 3, 2, 2, 2, 188, 186, 3, 2, 2,
+	2, 189, 190, 5, 26, 14, 2, 190, 13, 3, 2, 2, 2, 191, 192, 5, 68, 35, 2,
+	192, 15, 3, 2, 2, 2, 193, 194, 5, 68, 35, 2, 194, 17, 3, 2, 2, 2, 195,
+	203, 5, 106, 54, 2, 196, 203, 5, 72, 37, 2, 197, 203, 5, 74, 38, 2, 198,
+	203, 5, 66, 34, 2, 199, 203, 5, 92, 47, 2, 200, 203, 5, 70, 36, 2, 201,
+	203, 5, 64, 33, 2, 202, 195, 3, 2, 2, 2, 202, 196, 3, 2, 2, 2, 202, 197,
+	3, 2, 2, 2, 202, 198, 3, 2, 2, 2, 202, 199, 3, 2, 2, 2, 202, 200, 3, 2,
+	2, 2, 202, 201, 3, 2, 2, 2, 203, 19, 3, 2, 2, 2, 204, 210, 5, 30, 16, 2,
+	205, 210, 5, 34, 18, 2, 206, 210, 5, 28, 15, 2, 207, 210, 5, 42, 22, 2,
+	208, 210, 5, 58, 30, 2, 209, 204, 3, 2, 2, 2, 209, 205, 3, 2, 2, 2, 209,
+	206, 3, 2, 2, 2, 209, 207, 3, 2, 2, 2, 209, 208, 3, 2, 2, 2, 210, 21, 3,
+	2, 2, 2, 211, 214, 5, 62, 32, 2, 212, 214, 5, 106, 54, 2, 213, 211, 3,
+	2, 2, 2, 213, 212, 3, 2, 2, 2, 214, 23, 3, 2, 2, 2, 215, 218, 5, 22, 12,
+	2, 216, 218, 5, 20, 11, 2, 217, 215, 3, 2, 2, 2, 217, 216, 3, 2, 2, 2,
+	218, 25, 3, 2, 2, 2, 219, 222, 5, 10, 6, 2, 220, 222, 5, 12, 7, 2, 221,
+	219, 3, 2, 2, 2, 221, 220, 3, 2, 2, 2, 222, 27, 3, 2, 2, 2, 223, 224, 7,
+	41, 2, 2, 224, 225, 5, 112, 57, 2, 225, 29, 3, 2, 2, 2, 226, 227, 7, 43,
+	2, 2, 227, 230, 5, 32, 17, 2, 228, 229, 7, 10, 2, 2, 229, 231, 5, 32, 17,
+	2, 230, 228, 3, 2, 2, 2, 230, 231, 3, 2, 2, 2, 231, 31, 3, 2, 2, 2, 232,
+	233, 5, 112, 57, 2, 233, 33, 3, 2, 2, 2, 234, 235, 7, 42, 2, 2, 235, 240,
+	5, 36, 19, 2, 236, 237, 7, 10, 2, 2, 237, 239, 5, 36, 19, 2, 238, 236,
+	3, 2, 2, 2, 239, 242, 3, 2, 2, 2, 240, 238, 3, 2, 2, 2, 240, 241, 3, 2,
+	2, 2, 241, 35, 3, 2, 2, 2, 242, 240, 3, 2, 2, 2, 243, 245, 5, 112, 57,
+	2, 244, 246, 7, 46, 2, 2, 245, 244, 3, 2, 2, 2, 245, 246, 3, 2, 2, 2, 246,
+	248, 3, 2, 2, 2, 247, 249, 5, 38, 20, 2, 248, 247, 3, 2, 2, 2, 248, 249,
+	3, 2, 2, 2, 249, 251, 3, 2, 2, 2, 250, 252, 5, 40, 21, 2, 251, 250, 3,
+	2, 2, 2, 251, 252, 3, 2, 2, 2, 252, 37, 3, 2, 2, 2, 253, 254, 7, 50, 2,
+	2, 254, 255, 7, 70, 2, 2, 255, 39, 3, 2, 2, 2, 256, 257, 7, 51, 2, 2, 257,
+	258, 7, 70, 2, 2, 258, 41, 3, 2, 2, 2, 259, 260, 7, 45, 2, 2, 260, 262,
+	5, 54, 28, 2, 261, 263, 5, 56, 29, 2, 262, 261, 3, 2, 2, 2, 262, 263, 3,
+	2, 2, 2, 263, 293, 3, 2, 2, 2, 264, 265, 7, 45, 2, 2, 265, 267, 5, 48,
+	25, 2, 266, 268, 5, 56, 29, 2, 267, 266, 3, 2, 2, 2, 267, 268, 3, 2, 2,
+	2, 268, 293, 3, 2, 2, 2, 269, 270, 7, 45, 2, 2, 270, 271, 5, 46, 24, 2,
+	271, 273, 5, 48, 25, 2, 272, 274, 5, 56, 29, 2, 273, 272, 3, 2, 2, 2, 273,
+	274, 3, 2, 2, 2, 274, 293, 3, 2, 2, 2, 275, 276, 7, 45, 2, 2, 276, 277,
+	5, 46, 24, 2, 277, 279, 5, 52, 27, 2, 278, 280, 5, 56, 29, 2, 279, 278,
+	3, 2, 2, 2, 279, 280, 3, 2, 2, 2, 280, 293, 3, 2, 2, 2, 281, 282, 7, 45,
+	2, 2, 282, 283, 5, 46, 24, 2, 283, 285, 5, 54, 28, 2, 284, 286, 5, 56,
+	29, 2, 285, 284, 3, 2, 2, 2, 285, 286, 3, 2, 2, 2, 286, 293, 3, 2, 2, 2,
+	287, 288, 7, 45, 2, 2, 288, 290, 5, 46, 24, 2, 289, 291, 5, 56, 29, 2,
+	290, 289, 3, 2, 2, 2, 290, 291, 3, 2, 2, 2, 291, 293, 3, 2, 2, 2, 292,
+	259, 3, 2, 2, 2, 292, 264, 3, 2, 2, 2, 292, 269, 3, 2, 2, 2, 292, 275,
+	3, 2, 2, 2, 292, 281, 3, 2, 2, 2, 292, 287, 3, 2, 2, 2, 293, 43, 3, 2,
+	2, 2, 294, 295, 5, 68, 35, 2, 295, 296, 7, 34, 2, 2, 296, 297, 5, 112,
+	57, 2, 297, 45, 3, 2, 2, 2, 298, 303, 5, 44, 23, 2, 299, 300, 7, 10, 2,
+	2, 300, 302, 5, 44, 23, 2, 301, 299, 3, 2, 2, 2, 302, 305, 3, 2, 2, 2,
+	303, 301, 3, 2, 2, 2, 303, 304, 3, 2, 2, 2, 304, 47, 3, 2, 2, 2, 305, 303,
+	3, 2, 2, 2, 306, 307, 7, 58, 2, 2, 307, 312, 5, 50, 26, 2, 308, 309, 7,
+	10, 2, 2, 309, 311, 5, 50, 26, 2, 310, 308, 3, 2, 2, 2, 311, 314, 3, 2,
+	2, 2, 312, 310, 3, 2, 2, 2, 312, 313, 3, 2, 2, 2, 313, 49, 3, 2, 2, 2,
+	314, 312, 3, 2, 2, 2, 315, 316, 5, 68, 35, 2, 316, 317, 7, 34, 2, 2, 317,
+	318, 5, 106, 54, 2, 318, 51, 3, 2, 2, 2, 319, 320, 7, 52, 2, 2, 320, 328,
+	5, 44, 23, 2, 321, 322, 7, 52, 2, 2, 322, 325, 5, 68, 35, 2, 323, 324,
+	7, 53, 2, 2, 324, 326, 5, 68, 35, 2, 325, 323, 3, 2, 2, 2, 325, 326, 3,
+	2, 2, 2, 326, 328, 3, 2, 2, 2, 327, 319, 3, 2, 2, 2, 327, 321, 3, 2, 2,
+	2, 328, 53, 3, 2, 2, 2, 329, 330, 7, 54, 2, 2, 330, 331, 7, 55, 2, 2, 331,
+	332, 7, 52, 2, 2, 332, 333, 5, 68, 35, 2, 333, 55, 3, 2, 2, 2, 334, 335,
+	7, 70, 2, 2, 335, 336, 5, 74, 38, 2, 336, 57, 3, 2, 2, 2, 337, 339, 7,
+	59, 2, 2, 338, 340, 5, 60, 31, 2, 339, 338, 3, 2, 2, 2, 339, 340, 3, 2,
+	2, 2, 340, 342, 3, 2, 2, 2, 341, 343, 5, 74, 38, 2, 342, 341, 3, 2, 2,
+	2, 342, 343, 3, 2, 2, 2, 343, 344, 3, 2, 2, 2, 344, 345, 5, 48, 25, 2,
+	345, 59, 3, 2, 2, 2, 346, 347, 7, 60, 2, 2, 347, 348, 5, 112, 57, 2, 348,
+	61, 3, 2, 2, 2, 349, 350, 7, 44, 2, 2, 350, 351, 5, 68, 35, 2, 351, 352,
+	7, 34, 2, 2, 352, 353, 5, 112, 57, 2, 353, 367, 3, 2, 2, 2, 354, 355, 7,
+	44, 2, 2, 355, 356, 5, 68, 35, 2, 356, 357, 7, 34, 2, 2, 357, 358, 7, 13,
+	2, 2, 358, 359, 5, 12, 7, 2, 359, 360, 7, 14, 2, 2, 360, 367, 3, 2, 2,
+	2, 361, 362, 7, 44, 2, 2, 362, 363, 5, 68, 35, 2, 363, 364, 7, 34, 2, 2,
+	364, 365, 5, 122, 62, 2, 365, 367, 3, 2, 2, 2, 366, 349, 3, 2, 2, 2, 366,
+	354, 3, 2, 2, 2, 366, 361, 3, 2, 2, 2, 367, 63, 3, 2, 2, 2, 368, 371, 7,
+	69, 2, 2, 369, 372, 7, 70, 2, 2, 370, 372, 5, 100, 51, 2, 371, 369, 3,
+	2, 2, 2, 371, 370, 3, 2, 2, 2, 372, 65, 3, 2, 2, 2, 373, 374, 5, 68, 35,
+	2, 374, 67, 3, 2, 2, 2, 375, 376, 9, 2, 2, 2, 376, 69, 3, 2, 2, 2, 377,
+	381, 5, 80, 41, 2, 378, 381, 5, 66, 34, 2, 379, 381, 5, 64, 33, 2, 380,
+	377, 3, 2, 2, 2, 380, 378, 3, 2, 2, 2, 380, 379, 3, 2, 2, 2, 381, 382,
+	3, 2, 2, 2, 382, 386, 7, 32, 2, 2, 383, 387, 5, 80, 41, 2, 384, 387, 5,
+	66, 34, 2, 385, 387, 5, 64, 33, 2, 386, 383, 3, 2, 2, 2, 386, 384, 3, 2,
+	2, 2, 386, 385, 3, 2, 2, 2, 387, 71, 3, 2, 2, 2, 388, 390, 7, 11, 2, 2,
+	389, 391, 5, 86, 44, 2, 390, 389, 3, 2, 2, 2, 390, 391, 3, 2, 2, 2, 391,
+	392, 3, 2, 2, 2, 392, 393, 7, 12, 2, 2, 393, 73, 3, 2, 2, 2, 394, 403,
+	7, 15, 2, 2, 395, 400, 5, 90, 46, 2, 396, 397, 7, 10, 2, 2, 397, 399, 5,
+	90, 46, 2, 398, 396, 3, 2, 2, 2, 399, 402, 3, 2, 2, 2, 400, 398, 3, 2,
+	2, 2, 400, 401, 3, 2, 2, 2, 401, 404, 3, 2, 2, 2, 402, 400, 3, 2, 2, 2,
+	403, 395, 3, 2, 2, 2, 403, 404, 3, 2, 2, 2, 404, 406, 3, 2, 2, 2, 405,
+	407, 7, 10, 2, 2, 406, 405, 3, 2, 2, 2, 406, 407, 3, 2, 2, 2, 407, 408,
+	3, 2, 2, 2, 408, 409, 7, 16, 2, 2, 409, 75, 3, 2, 2, 2, 410, 411, 7, 49,
+	2, 2, 411, 77, 3, 2, 2, 2, 412, 413, 9, 3, 2, 2, 413, 79, 3, 2, 2, 2, 414,
+	415, 7, 74, 2, 2, 415, 81, 3, 2, 2, 2, 416, 417, 7, 75, 2, 2, 417, 83,
+	3, 2, 2, 2, 418, 419, 9, 4, 2, 2, 419, 85, 3, 2, 2, 2, 420, 429, 5, 88,
+	45, 2, 421, 423, 7, 10, 2, 2, 422, 421, 3, 2, 2, 2, 423, 424, 3, 2, 2,
+	2, 424, 422, 3, 2, 2, 2, 424, 425, 3, 2, 2, 2, 425, 426, 3, 2, 2, 2, 426,
+	428, 5, 88, 45, 2, 427, 422, 3, 2, 2, 2, 428, 431, 3, 2, 2, 2, 429, 427,
+	3, 2, 2, 2, 429, 430, 3, 2, 2, 2, 430, 87, 3, 2, 2, 2, 431, 429, 3, 2,
+	2, 2, 432, 434, 7, 33, 2, 2, 433, 432, 3, 2, 2, 2, 433, 434, 3, 2, 2, 2,
+	434, 435, 3, 2, 2, 2, 435, 436, 5, 112, 57, 2, 436, 89, 3, 2, 2, 2, 437,
+	438, 5, 98, 50, 2, 438, 439, 7, 7, 2, 2, 439, 440, 5, 112, 57, 2, 440,
+	449, 3, 2, 2, 2, 441, 442, 5, 96, 49, 2, 442, 443, 7, 7, 2, 2, 443, 444,
+	5, 112, 57, 2, 444, 449, 3, 2, 2, 2, 445, 449, 5, 94, 48, 2, 446, 447,
+	7, 33, 2, 2, 447, 449, 5, 112, 57, 2, 448, 437, 3, 2, 2, 2, 448, 441, 3,
+	2, 2, 2, 448, 445, 3, 2, 2, 2, 448, 446, 3, 2, 2, 2, 449, 91, 3, 2, 2,
+	2, 450, 459, 5, 68, 35, 2, 451, 452, 7, 9, 2, 2, 452, 456, 5, 98, 50, 2,
+	453, 455, 5, 96, 49, 2, 454, 453, 3, 2, 2, 2, 455, 458, 3, 2, 2, 2, 456,
+	454, 3, 2, 2, 2, 456, 457, 3, 2, 2, 2, 457, 460, 3, 2, 2, 2, 458, 456,
+	3, 2, 2, 2, 459, 451, 3, 2, 2, 2, 460, 461, 3, 2, 2, 2, 461, 459, 3, 2,
+	2, 2, 461, 462, 3, 2, 2, 2, 462, 492, 3, 2, 2, 2, 463, 464, 5, 68, 35,
+	2, 464, 475, 5, 96, 49, 2, 465, 466, 7, 9, 2, 2, 466, 470, 5, 98, 50, 2,
+	467, 469, 5, 96, 49, 2, 468, 467, 3, 2, 2, 2, 469, 472, 3, 2, 2, 2, 470,
+	468, 3, 2, 2, 2, 470, 471, 3, 2, 2, 2, 471, 474, 3, 2, 2, 2, 472, 470,
+	3, 2, 2, 2, 473, 465, 3, 2, 2, 2, 474, 477, 3, 2, 2, 2, 475, 473, 3, 2,
+	2, 2, 475, 476, 3, 2, 2, 2, 476, 488, 3, 2, 2, 2, 477, 475, 3, 2, 2, 2,
+	478, 483, 5, 96, 49, 2, 479, 480, 7, 9, 2, 2, 480, 482, 5, 98, 50, 2, 481,
+	479, 3, 2, 2, 2, 482, 485, 3, 2, 2, 2, 483, 481, 3, 2, 2, 2, 483, 484,
+	3, 2, 2, 2, 484, 487, 3, 2, 2, 2, 485, 483, 3, 2, 2, 2, 486, 478, 3, 2,
+	2, 2, 487, 490, 3, 2, 2, 2, 488, 486, 3, 2, 2, 2, 488, 489, 3, 2, 2, 2,
+	489, 492, 3, 2, 2, 2, 490, 488, 3, 2, 2, 2, 491, 450, 3, 2, 2, 2, 491,
+	463, 3, 2, 2, 2, 492, 93, 3, 2, 2, 2, 493, 494, 5, 66, 34, 2, 494, 95,
+	3, 2, 2, 2, 495, 496, 7, 11, 2, 2, 496, 497, 5, 112, 57, 2, 497, 498, 7,
+	12, 2, 2, 498, 97, 3, 2, 2, 2, 499, 503, 7, 70, 2, 2, 500, 503, 5, 78,
+	40, 2, 501, 503, 5, 100, 51, 2, 502, 499, 3, 2, 2, 2, 502, 500, 3, 2, 2,
+	2, 502, 501, 3, 2, 2, 2, 503, 99, 3, 2, 2, 2, 504, 505, 9, 5, 2, 2, 505,
+	101, 3, 2, 2, 2, 506, 507, 7, 13, 2, 2, 507, 508, 5, 112, 57, 2, 508, 509,
+	7, 14, 2, 2, 509, 103, 3, 2, 2, 2, 510, 512, 7, 76, 2, 2, 511, 510, 3,
+	2, 2, 2, 512, 515, 3, 2, 2, 2, 513, 511, 3, 2, 2, 2, 513, 514, 3, 2, 2,
+	2, 514, 105, 3, 2, 2, 2, 515, 513, 3, 2, 2, 2, 516, 517, 5, 104, 53, 2,
+	517, 518, 7, 70, 2, 2, 518, 519, 5, 108, 55, 2, 519, 107, 3, 2, 2, 2, 520,
+	529, 7, 13, 2, 2, 521, 526, 5, 110, 56, 2, 522, 523, 7, 10, 2, 2, 523,
+	525, 5, 110, 56, 2, 524, 522, 3, 2, 2, 2, 525, 528, 3, 2, 2, 2, 526, 524,
+	3, 2, 2, 2, 526, 527, 3, 2, 2, 2, 527, 530, 3, 2, 2, 2, 528, 526, 3, 2,
+	2, 2, 529, 521, 3, 2, 2, 2, 529, 530, 3, 2, 2, 2, 530, 531, 3, 2, 2, 2,
+	531, 532, 7, 14, 2, 2, 532, 109, 3, 2, 2, 2, 533, 535, 7, 33, 2, 2, 534,
+	533, 3, 2, 2, 2, 534, 535, 3, 2, 2, 2, 535, 536, 3, 2, 2, 2, 536, 537,
+	5, 112, 57, 2, 537, 111, 3, 2, 2, 2, 538, 539, 8, 57, 1, 2, 539, 540, 5,
+	138, 70, 2, 540, 541, 5, 112, 57, 26, 541, 558, 3, 2, 2, 2, 542, 558, 5,
+	106, 54, 2, 543, 558, 5, 102, 52, 2, 544, 558, 5, 114, 58, 2, 545, 558,
+	5, 116, 59, 2, 546, 558, 5, 70, 36, 2, 547, 558, 5, 78, 40, 2, 548, 558,
+	5, 80, 41, 2, 549, 558, 5, 82, 42, 2, 550, 558, 5, 76, 39, 2, 551, 558,
+	5, 72, 37, 2, 552, 558, 5, 74, 38, 2, 553, 558, 5, 66, 34, 2, 554, 558,
+	5, 92, 47, 2, 555, 558, 5, 84, 43, 2, 556, 558, 5, 64, 33, 2, 557, 538,
+	3, 2, 2, 2, 557, 542, 3, 2, 2, 2, 557, 543, 3, 2, 2, 2, 557, 544, 3, 2,
+	2, 2, 557, 545, 3, 2, 2, 2, 557, 546, 3, 2, 2, 2, 557, 547, 3, 2, 2, 2,
+	557, 548, 3, 2, 2, 2, 557, 549, 3, 2, 2, 2, 557, 550, 3, 2, 2, 2, 557,
+	551, 3, 2, 2, 2, 557, 552, 3, 2, 2, 2, 557, 553, 3, 2, 2, 2, 557, 554,
+	3, 2, 2, 2, 557, 555, 3, 2, 2, 2, 557, 556, 3, 2, 2, 2, 558, 600, 3, 2,
+	2, 2, 559, 560, 12, 25, 2, 2, 560, 561, 5, 134, 68, 2, 561, 562, 5, 112,
+	57, 26, 562, 599, 3, 2, 2, 2, 563, 564, 12, 24, 2, 2, 564, 565, 5, 136,
+	69, 2, 565, 566, 5, 112, 57, 25, 566, 599, 3, 2, 2, 2, 567, 568, 12, 19,
+	2, 2, 568, 571, 5, 124, 63, 2, 569, 572, 5, 126, 64, 2, 570, 572, 5, 128,
+	65, 2, 571, 569, 3, 2, 2, 2, 571, 570, 3, 2, 2, 2, 572, 573, 3, 2, 2, 2,
+	573, 574, 5, 112, 57, 20, 574, 599, 3, 2, 2, 2, 575, 576, 12, 18, 2, 2,
+	576, 577, 5, 126, 64, 2, 577, 578, 5, 112, 57, 19, 578, 599, 3, 2, 2, 2,
+	579, 580, 12, 17, 2, 2, 580, 581, 5, 128, 65, 2, 581, 582, 5, 112, 57,
+	18, 582, 599, 3, 2, 2, 2, 583, 584, 12, 16, 2, 2, 584, 585, 5, 130, 66,
+	2, 585, 586, 5, 112, 57, 17, 586, 599, 3, 2, 2, 2, 587, 588, 12, 15, 2,
+	2, 588, 589, 5, 132, 67, 2, 589, 590, 5, 112, 57, 16, 590, 599, 3, 2, 2,
+	2, 591, 592, 12, 14, 2, 2, 592, 594, 7, 35, 2, 2, 593, 595, 5, 112, 57,
+	2, 594, 593, 3, 2, 2, 2, 594, 595, 3, 2, 2, 2, 595, 596, 3, 2, 2, 2, 596,
+	597, 7, 7, 2, 2, 597, 599, 5, 112, 57, 15, 598, 559, 3, 2, 2, 2, 598, 563,
+	3, 2, 2, 2, 598, 567, 3, 2, 2, 2, 598, 575, 3, 2, 2, 2, 598, 579, 3, 2,
+	2, 2, 598, 583, 3, 2, 2, 2, 598, 587, 3, 2, 2, 2, 598, 591, 3, 2, 2, 2,
+	599, 602, 3, 2, 2, 2, 600, 598, 3, 2, 2, 2, 600, 601, 3, 2, 2, 2, 601,
+	113, 3, 2, 2, 2, 602, 600, 3, 2, 2, 2, 603, 604, 7, 61, 2, 2, 604, 606,
+	5, 112, 57, 2, 605, 607, 5, 118, 60, 2, 606, 605, 3, 2, 2, 2, 607, 608,
+	3, 2, 2, 2, 608, 606, 3, 2, 2, 2, 608, 609, 3, 2, 2, 2, 609, 611, 3, 2,
+	2, 2, 610, 612, 5, 120, 61, 2, 611, 610, 3, 2, 2, 2, 611, 612, 3, 2, 2,
+	2, 612, 613, 3, 2, 2, 2, 613, 614, 7, 65, 2, 2, 614, 115, 3, 2, 2, 2, 615,
+	617, 7, 62, 2, 2, 616, 618, 5, 118, 60, 2, 617, 616, 3, 2, 2, 2, 618, 619,
+	3, 2, 2, 2, 619, 617, 3, 2, 2, 2, 619, 620, 3, 2, 2, 2, 620, 622, 3, 2,
+	2, 2, 621, 623, 5, 120, 61, 2, 622, 621, 3, 2, 2, 2, 622, 623, 3, 2, 2,
+	2, 623, 624, 3, 2, 2, 2, 624, 625, 7, 65, 2, 2, 625, 117, 3, 2, 2, 2, 626,
+	627, 7, 63, 2, 2, 627, 632, 5, 112, 57, 2, 628, 629, 7, 10, 2, 2, 629,
+	631, 5, 112, 57, 2, 630, 628, 3, 2, 2, 2, 631, 634, 3, 2, 2, 2, 632, 630,
+	3, 2, 2, 2, 632, 633, 3, 2, 2, 2, 633, 635, 3, 2, 2, 2, 634, 632, 3, 2,
+	2, 2, 635, 636, 7, 7, 2, 2, 636, 637, 5, 112, 57, 2, 637, 119, 3, 2, 2,
+	2, 638, 639, 7, 64, 2, 2, 639, 640, 7, 7, 2, 2, 640, 641, 5, 112, 57, 2,
+	641, 121, 3, 2, 2, 2, 642, 643, 5, 112, 57, 2, 643, 645, 7, 35, 2, 2, 644,
+	646, 5, 112, 57, 2, 645, 644, 3, 2, 2, 2, 645, 646, 3, 2, 2, 2, 646, 647,
+	3, 2, 2, 2, 647, 648, 7, 7, 2, 2, 648, 649, 7, 13, 2, 2, 649, 650, 5, 12,
+	7, 2, 650, 651, 7, 14, 2, 2, 651, 671, 3, 2, 2, 2, 652, 653, 5, 112, 57,
+	2, 653, 654, 7, 35, 2, 2, 654, 655, 7, 13, 2, 2, 655, 656, 5, 12, 7, 2,
+	656, 657, 7, 14, 2, 2, 657, 658, 7, 7, 2, 2, 658, 659, 5, 112, 57, 2, 659,
+	671, 3, 2, 2, 2, 660, 661, 5, 112, 57, 2, 661, 662, 7, 35, 2, 2, 662, 663,
+	7, 13, 2, 2, 663, 664, 5, 12, 7, 2, 664, 665, 7, 14, 2, 2, 665, 666, 7,
+	7, 2, 2, 666, 667, 7, 13, 2, 2, 667, 668, 5, 12, 7, 2, 668, 669, 7, 14,
+	2, 2, 669, 671, 3, 2, 2, 2, 670, 642, 3, 2, 2, 2, 670, 652, 3, 2, 2, 2,
+	670, 660, 3, 2, 2, 2, 671, 123, 3, 2, 2, 2, 672, 673, 9, 6, 2, 2, 673,
+	125, 3, 2, 2, 2, 674, 678, 7, 68, 2, 2, 675, 676, 7, 67, 2, 2, 676, 678,
+	7, 68, 2, 2, 677, 674, 3, 2, 2, 2, 677, 675, 3, 2, 2, 2, 678, 127, 3, 2,
+	2, 2, 679, 680, 9, 7, 2, 2, 680, 129, 3, 2, 2, 2, 681, 682, 7, 30, 2, 2,
+	682, 131, 3, 2, 2, 2, 683, 684, 7, 31, 2, 2, 684, 133, 3, 2, 2, 2, 685,
+	686, 9, 8, 2, 2, 686, 135, 3, 2, 2, 2, 687, 688, 9, 9, 2, 2, 688, 137,
+	3, 2, 2, 2, 689, 690, 9, 10, 2, 2, 690, 139, 3, 2, 2, 2, 70, 145, 152,
+	156, 160, 165, 173, 179, 186, 202, 209, 213, 217, 221, 230, 240, 245, 248,
+	251, 262, 267, 273, 279, 285, 290, 292, 303, 312, 325, 327, 339, 342, 366,
+	371, 380, 386, 390, 400, 403, 406, 424, 429, 433, 448, 456, 461, 470, 475,
+	483, 488, 491, 502, 513, 526, 529, 534, 557, 571, 594, 598, 600, 608, 611,
+	619, 622, 632, 645, 670, 677,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -274,22 +335,22 @@ var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 var literalNames = []string{
 	"", "", "", "", "", "':'", "';'", "'.'", "','", "'['", "']'", "'('", "')'",
 	"'{'", "'}'", "'>'", "'<'", "'=='", "'>='", "'<='", "'!='", "'*'", "'/'",
-	"'%'", "'+'", "'-'", "'--'", "'++'", "", "", "", "'='", "'?'", "'!~'",
-	"'=~'", "'FOR'", "'RETURN'", "'DISTINCT'", "'FILTER'", "'SORT'", "'LIMIT'",
-	"'LET'", "'COLLECT'", "", "'NONE'", "'NULL'", "", "'INTO'", "'KEEP'", "'WITH'",
-	"'COUNT'", "'ALL'", "'ANY'", "'AGGREGATE'", "'LIKE'", "", "'IN'", "'@'",
+	"'%'", "'+'", "'-'", "'--'", "'++'", "", "", "", "'...'", "'='", "'?'",
+	"'!~'", "'=~'", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "'@'",
 }
 var symbolicNames = []string{
 	"", "MultiLineComment", "SingleLineComment", "WhiteSpaces", "LineTerminator",
 	"Colon", "SemiColon", "Dot", "Comma", "OpenBracket", "CloseBracket", "OpenParen",
 	"CloseParen", "OpenBrace", "CloseBrace", "Gt", "Lt", "Eq", "Gte", "Lte",
 	"Neq", "Multi", "Div", "Mod", "Plus", "Minus", "MinusMinus", "PlusPlus",
-	"And", "Or", "Range", "Assign", "QuestionMark", "RegexNotMatch", "RegexMatch",
-	"For", "Return", "Distinct", "Filter", "Sort", "Limit", "Let", "Collect",
-	"SortDirection", "None", "Null", "BooleanLiteral", "Into", "Keep", "With",
-	"Count", "All", "Any", "Aggregate", "Like", "Not", "In", "Param", "Identifier",
-	"StringLiteral", "TemplateStringLiteral", "IntegerLiteral", "FloatLiteral",
-	"NamespaceSegment",
+	"And", "Or", "Range", "Ellipsis", "Assign", "QuestionMark", "RegexNotMatch",
+	"RegexMatch", "For", "Return", "Distinct", "Filter", "Sort", "Limit", "Let",
+	"Collect", "SortDirection", "None", "Null", "BooleanLiteral", "Nulls",
+	"Collate", "Into", "Keep", "With", "Count", "All", "Any", "Aggregate",
+	"Window", "Partition", "Switch", "When", "Case", "Default", "End", "Like",
+	"Not", "In", "Param", "Identifier", "StringLiteral", "TemplateStringLiteral",
+	"RawStringLiteral", "IntegerLiteral", "FloatLiteral", "NamespaceSegment",
 }
 
 var ruleNames = []string{
@@ -297,16 +358,19 @@ var ruleNames = []string{
 	"forExpression", "forExpressionValueVariable", "forExpressionKeyVariable",
 	"forExpressionSource", "forExpressionClause", "forExpressionStatement",
 	"forExpressionBody", "forExpressionReturn", "filterClause", "limitClause",
-	"limitClauseValue", "sortClause", "sortClauseExpression", "collectClause",
-	"collectSelector", "collectGrouping", "collectAggregator", "collectAggregateSelector",
-	"collectGroupVariable", "collectCounter", "variableDeclaration", "param",
-	"variable", "rangeOperator", "arrayLiteral", "objectLiteral", "booleanLiteral",
-	"stringLiteral", "integerLiteral", "floatLiteral", "noneLiteral", "arrayElementList",
-	"propertyAssignment", "memberExpression", "shorthandPropertyName", "computedPropertyName",
-	"propertyName", "expressionGroup", "namespace", "functionCallExpression",
-	"arguments", "expression", "forTernaryExpression", "arrayOperator", "inOperator",
-	"equalityOperator", "logicalAndOperator", "logicalOrOperator", "multiplicativeOperator",
-	"additiveOperator", "unaryOperator",
+	"limitClauseValue", "sortClause", "sortClauseExpression", "sortClauseNulls",
+	"sortClauseCollation", "collectClause", "collectSelector", "collectGrouping",
+	"collectAggregator", "collectAggregateSelector", "collectGroupVariable",
+	"collectCounter", "collectOptions", "windowClause", "windowPartition",
+	"variableDeclaration", "param", "variable", "identifier", "rangeOperator",
+	"arrayLiteral", "objectLiteral", "booleanLiteral", "stringLiteral", "integerLiteral",
+	"floatLiteral", "noneLiteral", "arrayElementList", "arrayElement", "propertyAssignment",
+	"memberExpression", "shorthandPropertyName", "computedPropertyName", "propertyName",
+	"reservedWord", "expressionGroup", "namespace", "functionCallExpression",
+	"arguments", "argument", "expression", "switchExpression", "whenExpression",
+	"switchCase", "switchDefault", "forTernaryExpression", "arrayOperator",
+	"inOperator", "equalityOperator", "logicalAndOperator", "logicalOrOperator",
+	"multiplicativeOperator", "additiveOperator", "unaryOperator",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -367,39 +431,50 @@ const (
 	FqlParserAnd                   = 28
 	FqlParserOr                    = 29
 	FqlParserRange                 = 30
-	FqlParserAssign                = 31
-	FqlParserQuestionMark          = 32
-	FqlParserRegexNotMatch         = 33
-	FqlParserRegexMatch            = 34
-	FqlParserFor                   = 35
-	FqlParserReturn                = 36
-	FqlParserDistinct              = 37
-	FqlParserFilter                = 38
-	FqlParserSort                  = 39
-	FqlParserLimit                 = 40
-	FqlParserLet                   = 41
-	FqlParserCollect               = 42
-	FqlParserSortDirection         = 43
-	FqlParserNone                  = 44
-	FqlParserNull                  = 45
-	FqlParserBooleanLiteral        = 46
-	FqlParserInto                  = 47
-	FqlParserKeep                  = 48
-	FqlParserWith                  = 49
-	FqlParserCount                 = 50
-	FqlParserAll                   = 51
-	FqlParserAny                   = 52
-	FqlParserAggregate             = 53
-	FqlParserLike                  = 54
-	FqlParserNot                   = 55
-	FqlParserIn                    = 56
-	FqlParserParam                 = 57
-	FqlParserIdentifier            = 58
-	FqlParserStringLiteral         = 59
-	FqlParserTemplateStringLiteral = 60
-	FqlParserIntegerLiteral        = 61
-	FqlParserFloatLiteral          = 62
-	FqlParserNamespaceSegment      = 63
+	FqlParserEllipsis              = 31
+	FqlParserAssign                = 32
+	FqlParserQuestionMark          = 33
+	FqlParserRegexNotMatch         = 34
+	FqlParserRegexMatch            = 35
+	FqlParserFor                   = 36
+	FqlParserReturn                = 37
+	FqlParserDistinct              = 38
+	FqlParserFilter                = 39
+	FqlParserSort                  = 40
+	FqlParserLimit                 = 41
+	FqlParserLet                   = 42
+	FqlParserCollect               = 43
+	FqlParserSortDirection         = 44
+	FqlParserNone                  = 45
+	FqlParserNull                  = 46
+	FqlParserBooleanLiteral        = 47
+	FqlParserNulls                 = 48
+	FqlParserCollate               = 49
+	FqlParserInto                  = 50
+	FqlParserKeep                  = 51
+	FqlParserWith                  = 52
+	FqlParserCount                 = 53
+	FqlParserAll                   = 54
+	FqlParserAny                   = 55
+	FqlParserAggregate             = 56
+	FqlParserWindow                = 57
+	FqlParserPartition             = 58
+	FqlParserSwitch                = 59
+	FqlParserWhen                  = 60
+	FqlParserCase                  = 61
+	FqlParserDefault               = 62
+	FqlParserEnd                   = 63
+	FqlParserLike                  = 64
+	FqlParserNot                   = 65
+	FqlParserIn                    = 66
+	FqlParserParam                 = 67
+	FqlParserIdentifier            = 68
+	FqlParserStringLiteral         = 69
+	FqlParserTemplateStringLiteral = 70
+	FqlParserRawStringLiteral      = 71
+	FqlParserIntegerLiteral        = 72
+	FqlParserFloatLiteral          = 73
+	FqlParserNamespaceSegment      = 74
 )
 
 // FqlParser rules.
@@ -422,44 +497,57 @@ const (
 	FqlParserRULE_limitClauseValue           = 15
 	FqlParserRULE_sortClause                 = 16
 	FqlParserRULE_sortClauseExpression       = 17
-	FqlParserRULE_collectClause              = 18
-	FqlParserRULE_collectSelector            = 19
-	FqlParserRULE_collectGrouping            = 20
-	FqlParserRULE_collectAggregator          = 21
-	FqlParserRULE_collectAggregateSelector   = 22
-	FqlParserRULE_collectGroupVariable       = 23
-	FqlParserRULE_collectCounter             = 24
-	FqlParserRULE_variableDeclaration        = 25
-	FqlParserRULE_param                      = 26
-	FqlParserRULE_variable                   = 27
-	FqlParserRULE_rangeOperator              = 28
-	FqlParserRULE_arrayLiteral               = 29
-	FqlParserRULE_objectLiteral              = 30
-	FqlParserRULE_booleanLiteral             = 31
-	FqlParserRULE_stringLiteral              = 32
-	FqlParserRULE_integerLiteral             = 33
-	FqlParserRULE_floatLiteral               = 34
-	FqlParserRULE_noneLiteral                = 35
-	FqlParserRULE_arrayElementList           = 36
-	FqlParserRULE_propertyAssignment         = 37
-	FqlParserRULE_memberExpression           = 38
-	FqlParserRULE_shorthandPropertyName      = 39
-	FqlParserRULE_computedPropertyName       = 40
-	FqlParserRULE_propertyName               = 41
-	FqlParserRULE_expressionGroup            = 42
-	FqlParserRULE_namespace                  = 43
-	FqlParserRULE_functionCallExpression     = 44
-	FqlParserRULE_arguments                  = 45
-	FqlParserRULE_expression                 = 46
-	FqlParserRULE_forTernaryExpression       = 47
-	FqlParserRULE_arrayOperator              = 48
-	FqlParserRULE_inOperator                 = 49
-	FqlParserRULE_equalityOperator           = 50
-	FqlParserRULE_logicalAndOperator         = 51
-	FqlParserRULE_logicalOrOperator          = 52
-	FqlParserRULE_multiplicativeOperator     = 53
-	FqlParserRULE_additiveOperator           = 54
-	FqlParserRULE_unaryOperator              = 55
+	FqlParserRULE_sortClauseNulls            = 18
+	FqlParserRULE_sortClauseCollation        = 19
+	FqlParserRULE_collectClause              = 20
+	FqlParserRULE_collectSelector            = 21
+	FqlParserRULE_collectGrouping            = 22
+	FqlParserRULE_collectAggregator          = 23
+	FqlParserRULE_collectAggregateSelector   = 24
+	FqlParserRULE_collectGroupVariable       = 25
+	FqlParserRULE_collectCounter             = 26
+	FqlParserRULE_collectOptions             = 27
+	FqlParserRULE_windowClause               = 28
+	FqlParserRULE_windowPartition            = 29
+	FqlParserRULE_variableDeclaration        = 30
+	FqlParserRULE_param                      = 31
+	FqlParserRULE_variable                   = 32
+	FqlParserRULE_identifier                 = 33
+	FqlParserRULE_rangeOperator              = 34
+	FqlParserRULE_arrayLiteral               = 35
+	FqlParserRULE_objectLiteral              = 36
+	FqlParserRULE_booleanLiteral             = 37
+	FqlParserRULE_stringLiteral              = 38
+	FqlParserRULE_integerLiteral             = 39
+	FqlParserRULE_floatLiteral               = 40
+	FqlParserRULE_noneLiteral                = 41
+	FqlParserRULE_arrayElementList           = 42
+	FqlParserRULE_arrayElement               = 43
+	FqlParserRULE_propertyAssignment         = 44
+	FqlParserRULE_memberExpression           = 45
+	FqlParserRULE_shorthandPropertyName      = 46
+	FqlParserRULE_computedPropertyName       = 47
+	FqlParserRULE_propertyName               = 48
+	FqlParserRULE_reservedWord               = 49
+	FqlParserRULE_expressionGroup            = 50
+	FqlParserRULE_namespace                  = 51
+	FqlParserRULE_functionCallExpression     = 52
+	FqlParserRULE_arguments                  = 53
+	FqlParserRULE_argument                   = 54
+	FqlParserRULE_expression                 = 55
+	FqlParserRULE_switchExpression           = 56
+	FqlParserRULE_whenExpression             = 57
+	FqlParserRULE_switchCase                 = 58
+	FqlParserRULE_switchDefault              = 59
+	FqlParserRULE_forTernaryExpression       = 60
+	FqlParserRULE_arrayOperator              = 61
+	FqlParserRULE_inOperator                 = 62
+	FqlParserRULE_equalityOperator           = 63
+	FqlParserRULE_logicalAndOperator         = 64
+	FqlParserRULE_logicalOrOperator          = 65
+	FqlParserRULE_multiplicativeOperator     = 66
+	FqlParserRULE_additiveOperator           = 67
+	FqlParserRULE_unaryOperator              = 68
 )
 
 // IProgramContext is an interface to support dynamic dispatch.
@@ -562,7 +650,7 @@ func (p *FqlParser) Program() (localctx IProgramContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(112)
+		p.SetState(138)
 		p.Body()
 	}
 
@@ -692,22 +780,22 @@ func (p *FqlParser) Body() (localctx IBodyContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(117)
+	p.SetState(143)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ((_la-41)&-(0x1f+1)) == 0 && ((1<<uint((_la-41)))&((1<<(FqlParserLet-41))|(1<<(FqlParserIdentifier-41))|(1<<(FqlParserNamespaceSegment-41)))) != 0 {
+	for _la == FqlParserLet || _la == FqlParserIdentifier || _la == FqlParserNamespaceSegment {
 		{
-			p.SetState(114)
+			p.SetState(140)
 			p.BodyStatement()
 		}
 
-		p.SetState(119)
+		p.SetState(145)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(120)
+		p.SetState(146)
 		p.BodyExpression()
 	}
 
@@ -822,21 +910,21 @@ func (p *FqlParser) BodyStatement() (localctx IBodyStatementContext) {
 		}
 	}()
 
-	p.SetState(124)
+	p.SetState(150)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FqlParserIdentifier, FqlParserNamespaceSegment:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(122)
+			p.SetState(148)
 			p.FunctionCallExpression()
 		}
 
 	case FqlParserLet:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(123)
+			p.SetState(149)
 			p.VariableDeclaration()
 		}
 
@@ -955,21 +1043,21 @@ func (p *FqlParser) BodyExpression() (localctx IBodyExpressionContext) {
 		}
 	}()
 
-	p.SetState(128)
+	p.SetState(154)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FqlParserReturn:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(126)
+			p.SetState(152)
 			p.ReturnExpression()
 		}
 
 	case FqlParserFor:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(127)
+			p.SetState(153)
 			p.ForExpression()
 		}
 
@@ -1115,69 +1203,69 @@ func (p *FqlParser) ReturnExpression() (localctx IReturnExpressionContext) {
 		}
 	}()
 
-	p.SetState(145)
+	p.SetState(171)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(130)
+			p.SetState(156)
 			p.Match(FqlParserReturn)
 		}
-		p.SetState(132)
+		p.SetState(158)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == FqlParserDistinct {
 			{
-				p.SetState(131)
+				p.SetState(157)
 				p.Match(FqlParserDistinct)
 			}
 
 		}
 		{
-			p.SetState(134)
+			p.SetState(160)
 			p.expression(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(135)
+			p.SetState(161)
 			p.Match(FqlParserReturn)
 		}
-		p.SetState(137)
+		p.SetState(163)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == FqlParserDistinct {
 			{
-				p.SetState(136)
+				p.SetState(162)
 				p.Match(FqlParserDistinct)
 			}
 
 		}
 		{
-			p.SetState(139)
+			p.SetState(165)
 			p.Match(FqlParserOpenParen)
 		}
 		{
-			p.SetState(140)
+			p.SetState(166)
 			p.ForExpression()
 		}
 		{
-			p.SetState(141)
+			p.SetState(167)
 			p.Match(FqlParserCloseParen)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(143)
+			p.SetState(169)
 			p.Match(FqlParserReturn)
 		}
 		{
-			p.SetState(144)
+			p.SetState(170)
 			p.ForTernaryExpression()
 		}
 
@@ -1352,52 +1440,52 @@ func (p *FqlParser) ForExpression() (localctx IForExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(147)
+		p.SetState(173)
 		p.Match(FqlParserFor)
 	}
 	{
-		p.SetState(148)
+		p.SetState(174)
 		p.ForExpressionValueVariable()
 	}
-	p.SetState(151)
+	p.SetState(177)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FqlParserComma {
 		{
-			p.SetState(149)
+			p.SetState(175)
 			p.Match(FqlParserComma)
 		}
 		{
-			p.SetState(150)
+			p.SetState(176)
 			p.ForExpressionKeyVariable()
 		}
 
 	}
 	{
-		p.SetState(153)
+		p.SetState(179)
 		p.Match(FqlParserIn)
 	}
 	{
-		p.SetState(154)
+		p.SetState(180)
 		p.ForExpressionSource()
 	}
-	p.SetState(158)
+	p.SetState(184)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(FqlParserFilter-38))|(1<<(FqlParserSort-38))|(1<<(FqlParserLimit-38))|(1<<(FqlParserLet-38))|(1<<(FqlParserCollect-38))|(1<<(FqlParserIdentifier-38))|(1<<(FqlParserNamespaceSegment-38)))) != 0 {
+	for (((_la-39)&-(0x1f+1)) == 0 && ((1<<uint((_la-39)))&((1<<(FqlParserFilter-39))|(1<<(FqlParserSort-39))|(1<<(FqlParserLimit-39))|(1<<(FqlParserLet-39))|(1<<(FqlParserCollect-39))|(1<<(FqlParserWindow-39))|(1<<(FqlParserIdentifier-39)))) != 0) || _la == FqlParserNamespaceSegment {
 		{
-			p.SetState(155)
+			p.SetState(181)
 			p.ForExpressionBody()
 		}
 
-		p.SetState(160)
+		p.SetState(186)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(161)
+		p.SetState(187)
 		p.ForExpressionReturn()
 	}
 
@@ -1442,8 +1530,14 @@ func NewForExpressionValueVariableContext(parser antlr.Parser, parent antlr.Pars
 
 func (s *ForExpressionValueVariableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ForExpressionValueVariableContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *ForExpressionValueVariableContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
 func (s *ForExpressionValueVariableContext) GetRuleContext() antlr.RuleContext {
@@ -1498,8 +1592,8 @@ func (p *FqlParser) ForExpressionValueVariable() (localctx IForExpressionValueVa
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(163)
-		p.Match(FqlParserIdentifier)
+		p.SetState(189)
+		p.Identifier()
 	}
 
 	return localctx
@@ -1543,8 +1637,14 @@ func NewForExpressionKeyVariableContext(parser antlr.Parser, parent antlr.Parser
 
 func (s *ForExpressionKeyVariableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ForExpressionKeyVariableContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *ForExpressionKeyVariableContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
 func (s *ForExpressionKeyVariableContext) GetRuleContext() antlr.RuleContext {
@@ -1599,8 +1699,8 @@ func (p *FqlParser) ForExpressionKeyVariable() (localctx IForExpressionKeyVariab
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(165)
-		p.Match(FqlParserIdentifier)
+		p.SetState(191)
+		p.Identifier()
 	}
 
 	return localctx
@@ -1764,55 +1864,55 @@ func (p *FqlParser) ForExpressionSource() (localctx IForExpressionSourceContext)
 		}
 	}()
 
-	p.SetState(174)
+	p.SetState(200)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(167)
+			p.SetState(193)
 			p.FunctionCallExpression()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(168)
+			p.SetState(194)
 			p.ArrayLiteral()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(169)
+			p.SetState(195)
 			p.ObjectLiteral()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(170)
+			p.SetState(196)
 			p.Variable()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(171)
+			p.SetState(197)
 			p.MemberExpression()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(172)
+			p.SetState(198)
 			p.RangeOperator()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(173)
+			p.SetState(199)
 			p.Param()
 		}
 
@@ -1899,6 +1999,16 @@ func (s *ForExpressionClauseContext) CollectClause() ICollectClauseContext {
 	return t.(ICollectClauseContext)
 }
 
+func (s *ForExpressionClauseContext) WindowClause() IWindowClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWindowClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWindowClauseContext)
+}
+
 func (s *ForExpressionClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1949,38 +2059,45 @@ func (p *FqlParser) ForExpressionClause() (localctx IForExpressionClauseContext)
 		}
 	}()
 
-	p.SetState(180)
+	p.SetState(207)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FqlParserLimit:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(176)
+			p.SetState(202)
 			p.LimitClause()
 		}
 
 	case FqlParserSort:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(177)
+			p.SetState(203)
 			p.SortClause()
 		}
 
 	case FqlParserFilter:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(178)
+			p.SetState(204)
 			p.FilterClause()
 		}
 
 	case FqlParserCollect:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(179)
+			p.SetState(205)
 			p.CollectClause()
 		}
 
+	case FqlParserWindow:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(206)
+			p.WindowClause()
+		}
+
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
@@ -2096,21 +2213,21 @@ func (p *FqlParser) ForExpressionStatement() (localctx IForExpressionStatementCo
 		}
 	}()
 
-	p.SetState(184)
+	p.SetState(211)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FqlParserLet:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(182)
+			p.SetState(209)
 			p.VariableDeclaration()
 		}
 
 	case FqlParserIdentifier, FqlParserNamespaceSegment:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(183)
+			p.SetState(210)
 			p.FunctionCallExpression()
 		}
 
@@ -2229,21 +2346,21 @@ func (p *FqlParser) ForExpressionBody() (localctx IForExpressionBodyContext) {
 		}
 	}()
 
-	p.SetState(188)
+	p.SetState(215)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FqlParserLet, FqlParserIdentifier, FqlParserNamespaceSegment:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(186)
+			p.SetState(213)
 			p.ForExpressionStatement()
 		}
 
-	case FqlParserFilter, FqlParserSort, FqlParserLimit, FqlParserCollect:
+	case FqlParserFilter, FqlParserSort, FqlParserLimit, FqlParserCollect, FqlParserWindow:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(187)
+			p.SetState(214)
 			p.ForExpressionClause()
 		}
 
@@ -2362,21 +2479,21 @@ func (p *FqlParser) ForExpressionReturn() (localctx IForExpressionReturnContext)
 		}
 	}()
 
-	p.SetState(192)
+	p.SetState(219)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FqlParserReturn:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(190)
+			p.SetState(217)
 			p.ReturnExpression()
 		}
 
 	case FqlParserFor:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(191)
+			p.SetState(218)
 			p.ForExpression()
 		}
 
@@ -2491,11 +2608,11 @@ func (p *FqlParser) FilterClause() (localctx IFilterClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(194)
+		p.SetState(221)
 		p.Match(FqlParserFilter)
 	}
 	{
-		p.SetState(195)
+		p.SetState(222)
 		p.expression(0)
 	}
 
@@ -2624,24 +2741,24 @@ func (p *FqlParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(197)
+		p.SetState(224)
 		p.Match(FqlParserLimit)
 	}
 	{
-		p.SetState(198)
+		p.SetState(225)
 		p.LimitClauseValue()
 	}
-	p.SetState(201)
+	p.SetState(228)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FqlParserComma {
 		{
-			p.SetState(199)
+			p.SetState(226)
 			p.Match(FqlParserComma)
 		}
 		{
-			p.SetState(200)
+			p.SetState(227)
 			p.LimitClauseValue()
 		}
 
@@ -2688,18 +2805,14 @@ func NewLimitClauseValueContext(parser antlr.Parser, parent antlr.ParserRuleCont
 
 func (s *LimitClauseValueContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LimitClauseValueContext) IntegerLiteral() antlr.TerminalNode {
-	return s.GetToken(FqlParserIntegerLiteral, 0)
-}
-
-func (s *LimitClauseValueContext) Param() IParamContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamContext)(nil)).Elem(), 0)
+func (s *LimitClauseValueContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IParamContext)
+	return t.(IExpressionContext)
 }
 
 func (s *LimitClauseValueContext) GetRuleContext() antlr.RuleContext {
@@ -2752,26 +2865,10 @@ func (p *FqlParser) LimitClauseValue() (localctx ILimitClauseValueContext) {
 		}
 	}()
 
-	p.SetState(205)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case FqlParserIntegerLiteral:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(203)
-			p.Match(FqlParserIntegerLiteral)
-		}
-
-	case FqlParserParam:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(204)
-			p.Param()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(230)
+		p.expression(0)
 	}
 
 	return localctx
@@ -2903,28 +3000,28 @@ func (p *FqlParser) SortClause() (localctx ISortClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(207)
+		p.SetState(232)
 		p.Match(FqlParserSort)
 	}
 	{
-		p.SetState(208)
+		p.SetState(233)
 		p.SortClauseExpression()
 	}
-	p.SetState(213)
+	p.SetState(238)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FqlParserComma {
 		{
-			p.SetState(209)
+			p.SetState(234)
 			p.Match(FqlParserComma)
 		}
 		{
-			p.SetState(210)
+			p.SetState(235)
 			p.SortClauseExpression()
 		}
 
-		p.SetState(215)
+		p.SetState(240)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -2984,6 +3081,26 @@ func (s *SortClauseExpressionContext) SortDirection() antlr.TerminalNode {
 	return s.GetToken(FqlParserSortDirection, 0)
 }
 
+func (s *SortClauseExpressionContext) SortClauseNulls() ISortClauseNullsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISortClauseNullsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISortClauseNullsContext)
+}
+
+func (s *SortClauseExpressionContext) SortClauseCollation() ISortClauseCollationContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISortClauseCollationContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISortClauseCollationContext)
+}
+
 func (s *SortClauseExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3037,139 +3154,234 @@ func (p *FqlParser) SortClauseExpression() (localctx ISortClauseExpressionContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(216)
+		p.SetState(241)
 		p.expression(0)
 	}
-	p.SetState(218)
+	p.SetState(243)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == FqlParserSortDirection {
 		{
-			p.SetState(217)
+			p.SetState(242)
 			p.Match(FqlParserSortDirection)
 		}
 
+	}
+	p.SetState(246)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FqlParserNulls {
+		{
+			p.SetState(245)
+			p.SortClauseNulls()
+		}
+
+	}
+	p.SetState(249)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FqlParserCollate {
+		{
+			p.SetState(248)
+			p.SortClauseCollation()
+		}
+
 	}
 
 	return localctx
 }
 
-// ICollectClauseContext is an interface to support dynamic dispatch.
-type ICollectClauseContext interface {
+// ISortClauseNullsContext is an interface to support dynamic dispatch.
+type ISortClauseNullsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCollectClauseContext differentiates from other interfaces.
-	IsCollectClauseContext()
+	// IsSortClauseNullsContext differentiates from other interfaces.
+	IsSortClauseNullsContext()
 }
 
-type CollectClauseContext struct {
+type SortClauseNullsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCollectClauseContext() *CollectClauseContext {
-	var p = new(CollectClauseContext)
+func NewEmptySortClauseNullsContext() *SortClauseNullsContext {
+	var p = new(SortClauseNullsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_collectClause
+	p.RuleIndex = FqlParserRULE_sortClauseNulls
 	return p
 }
 
-func (*CollectClauseContext) IsCollectClauseContext() {}
+func (*SortClauseNullsContext) IsSortClauseNullsContext() {}
 
-func NewCollectClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CollectClauseContext {
-	var p = new(CollectClauseContext)
+func NewSortClauseNullsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SortClauseNullsContext {
+	var p = new(SortClauseNullsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_collectClause
+	p.RuleIndex = FqlParserRULE_sortClauseNulls
 
 	return p
 }
 
-func (s *CollectClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *SortClauseNullsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CollectClauseContext) Collect() antlr.TerminalNode {
-	return s.GetToken(FqlParserCollect, 0)
+func (s *SortClauseNullsContext) Nulls() antlr.TerminalNode {
+	return s.GetToken(FqlParserNulls, 0)
 }
 
-func (s *CollectClauseContext) CollectCounter() ICollectCounterContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectCounterContext)(nil)).Elem(), 0)
+func (s *SortClauseNullsContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(FqlParserIdentifier, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *SortClauseNullsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	return t.(ICollectCounterContext)
+func (s *SortClauseNullsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CollectClauseContext) CollectAggregator() ICollectAggregatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectAggregatorContext)(nil)).Elem(), 0)
+func (s *SortClauseNullsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterSortClauseNulls(s)
+	}
+}
 
-	if t == nil {
-		return nil
+func (s *SortClauseNullsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitSortClauseNulls(s)
 	}
+}
 
-	return t.(ICollectAggregatorContext)
+func (s *SortClauseNullsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitSortClauseNulls(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *CollectClauseContext) CollectGrouping() ICollectGroupingContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectGroupingContext)(nil)).Elem(), 0)
+func (p *FqlParser) SortClauseNulls() (localctx ISortClauseNullsContext) {
+	localctx = NewSortClauseNullsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, FqlParserRULE_sortClauseNulls)
 
-	if t == nil {
-		return nil
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(251)
+		p.Match(FqlParserNulls)
+	}
+	{
+		p.SetState(252)
+		p.Match(FqlParserIdentifier)
 	}
 
-	return t.(ICollectGroupingContext)
+	return localctx
 }
 
-func (s *CollectClauseContext) CollectGroupVariable() ICollectGroupVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectGroupVariableContext)(nil)).Elem(), 0)
+// ISortClauseCollationContext is an interface to support dynamic dispatch.
+type ISortClauseCollationContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(ICollectGroupVariableContext)
+	// IsSortClauseCollationContext differentiates from other interfaces.
+	IsSortClauseCollationContext()
 }
 
-func (s *CollectClauseContext) GetRuleContext() antlr.RuleContext {
+type SortClauseCollationContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySortClauseCollationContext() *SortClauseCollationContext {
+	var p = new(SortClauseCollationContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_sortClauseCollation
+	return p
+}
+
+func (*SortClauseCollationContext) IsSortClauseCollationContext() {}
+
+func NewSortClauseCollationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SortClauseCollationContext {
+	var p = new(SortClauseCollationContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_sortClauseCollation
+
+	return p
+}
+
+func (s *SortClauseCollationContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SortClauseCollationContext) Collate() antlr.TerminalNode {
+	return s.GetToken(FqlParserCollate, 0)
+}
+
+func (s *SortClauseCollationContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(FqlParserIdentifier, 0)
+}
+
+func (s *SortClauseCollationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CollectClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SortClauseCollationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CollectClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SortClauseCollationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterCollectClause(s)
+		listenerT.EnterSortClauseCollation(s)
 	}
 }
 
-func (s *CollectClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SortClauseCollationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitCollectClause(s)
+		listenerT.ExitSortClauseCollation(s)
 	}
 }
 
-func (s *CollectClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SortClauseCollationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitCollectClause(s)
+		return t.VisitSortClauseCollation(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) CollectClause() (localctx ICollectClauseContext) {
-	localctx = NewCollectClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, FqlParserRULE_collectClause)
+func (p *FqlParser) SortClauseCollation() (localctx ISortClauseCollationContext) {
+	localctx = NewSortClauseCollationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, FqlParserRULE_sortClauseCollation)
 
 	defer func() {
 		p.ExitRule()
@@ -3187,86 +3399,301 @@ func (p *FqlParser) CollectClause() (localctx ICollectClauseContext) {
 		}
 	}()
 
-	p.SetState(238)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(220)
-			p.Match(FqlParserCollect)
-		}
-		{
-			p.SetState(221)
-			p.CollectCounter()
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(254)
+		p.Match(FqlParserCollate)
+	}
+	{
+		p.SetState(255)
+		p.Match(FqlParserIdentifier)
+	}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(222)
-			p.Match(FqlParserCollect)
-		}
-		{
-			p.SetState(223)
-			p.CollectAggregator()
-		}
+	return localctx
+}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(224)
+// ICollectClauseContext is an interface to support dynamic dispatch.
+type ICollectClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCollectClauseContext differentiates from other interfaces.
+	IsCollectClauseContext()
+}
+
+type CollectClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCollectClauseContext() *CollectClauseContext {
+	var p = new(CollectClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_collectClause
+	return p
+}
+
+func (*CollectClauseContext) IsCollectClauseContext() {}
+
+func NewCollectClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CollectClauseContext {
+	var p = new(CollectClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_collectClause
+
+	return p
+}
+
+func (s *CollectClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CollectClauseContext) Collect() antlr.TerminalNode {
+	return s.GetToken(FqlParserCollect, 0)
+}
+
+func (s *CollectClauseContext) CollectCounter() ICollectCounterContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectCounterContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICollectCounterContext)
+}
+
+func (s *CollectClauseContext) CollectOptions() ICollectOptionsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectOptionsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICollectOptionsContext)
+}
+
+func (s *CollectClauseContext) CollectAggregator() ICollectAggregatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectAggregatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICollectAggregatorContext)
+}
+
+func (s *CollectClauseContext) CollectGrouping() ICollectGroupingContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectGroupingContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICollectGroupingContext)
+}
+
+func (s *CollectClauseContext) CollectGroupVariable() ICollectGroupVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectGroupVariableContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICollectGroupVariableContext)
+}
+
+func (s *CollectClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CollectClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CollectClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterCollectClause(s)
+	}
+}
+
+func (s *CollectClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitCollectClause(s)
+	}
+}
+
+func (s *CollectClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitCollectClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) CollectClause() (localctx ICollectClauseContext) {
+	localctx = NewCollectClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, FqlParserRULE_collectClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(290)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(257)
+			p.Match(FqlParserCollect)
+		}
+		{
+			p.SetState(258)
+			p.CollectCounter()
+		}
+		p.SetState(260)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(259)
+				p.CollectOptions()
+			}
+
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(262)
+			p.Match(FqlParserCollect)
+		}
+		{
+			p.SetState(263)
+			p.CollectAggregator()
+		}
+		p.SetState(265)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(264)
+				p.CollectOptions()
+			}
+
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(267)
 			p.Match(FqlParserCollect)
 		}
 		{
-			p.SetState(225)
+			p.SetState(268)
 			p.CollectGrouping()
 		}
 		{
-			p.SetState(226)
+			p.SetState(269)
 			p.CollectAggregator()
 		}
+		p.SetState(271)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(270)
+				p.CollectOptions()
+			}
+
+		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(228)
+			p.SetState(273)
 			p.Match(FqlParserCollect)
 		}
 		{
-			p.SetState(229)
+			p.SetState(274)
 			p.CollectGrouping()
 		}
 		{
-			p.SetState(230)
+			p.SetState(275)
 			p.CollectGroupVariable()
 		}
+		p.SetState(277)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(276)
+				p.CollectOptions()
+			}
+
+		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(232)
+			p.SetState(279)
 			p.Match(FqlParserCollect)
 		}
 		{
-			p.SetState(233)
+			p.SetState(280)
 			p.CollectGrouping()
 		}
 		{
-			p.SetState(234)
+			p.SetState(281)
 			p.CollectCounter()
 		}
+		p.SetState(283)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(282)
+				p.CollectOptions()
+			}
+
+		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(236)
+			p.SetState(285)
 			p.Match(FqlParserCollect)
 		}
 		{
-			p.SetState(237)
+			p.SetState(286)
 			p.CollectGrouping()
 		}
+		p.SetState(288)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(287)
+				p.CollectOptions()
+			}
+
+		}
 
 	}
 
@@ -3311,8 +3738,14 @@ func NewCollectSelectorContext(parser antlr.Parser, parent antlr.ParserRuleConte
 
 func (s *CollectSelectorContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CollectSelectorContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *CollectSelectorContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
 func (s *CollectSelectorContext) Assign() antlr.TerminalNode {
@@ -3361,7 +3794,7 @@ func (s *CollectSelectorContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *FqlParser) CollectSelector() (localctx ICollectSelectorContext) {
 	localctx = NewCollectSelectorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, FqlParserRULE_collectSelector)
+	p.EnterRule(localctx, 42, FqlParserRULE_collectSelector)
 
 	defer func() {
 		p.ExitRule()
@@ -3381,15 +3814,15 @@ func (p *FqlParser) CollectSelector() (localctx ICollectSelectorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(240)
-		p.Match(FqlParserIdentifier)
+		p.SetState(292)
+		p.Identifier()
 	}
 	{
-		p.SetState(241)
+		p.SetState(293)
 		p.Match(FqlParserAssign)
 	}
 	{
-		p.SetState(242)
+		p.SetState(294)
 		p.expression(0)
 	}
 
@@ -3497,7 +3930,7 @@ func (s *CollectGroupingContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *FqlParser) CollectGrouping() (localctx ICollectGroupingContext) {
 	localctx = NewCollectGroupingContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, FqlParserRULE_collectGrouping)
+	p.EnterRule(localctx, 44, FqlParserRULE_collectGrouping)
 	var _la int
 
 	defer func() {
@@ -3518,24 +3951,24 @@ func (p *FqlParser) CollectGrouping() (localctx ICollectGroupingContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(244)
+		p.SetState(296)
 		p.CollectSelector()
 	}
-	p.SetState(249)
+	p.SetState(301)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FqlParserComma {
 		{
-			p.SetState(245)
+			p.SetState(297)
 			p.Match(FqlParserComma)
 		}
 		{
-			p.SetState(246)
+			p.SetState(298)
 			p.CollectSelector()
 		}
 
-		p.SetState(251)
+		p.SetState(303)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3648,7 +4081,7 @@ func (s *CollectAggregatorContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *FqlParser) CollectAggregator() (localctx ICollectAggregatorContext) {
 	localctx = NewCollectAggregatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, FqlParserRULE_collectAggregator)
+	p.EnterRule(localctx, 46, FqlParserRULE_collectAggregator)
 	var _la int
 
 	defer func() {
@@ -3669,28 +4102,28 @@ func (p *FqlParser) CollectAggregator() (localctx ICollectAggregatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(252)
+		p.SetState(304)
 		p.Match(FqlParserAggregate)
 	}
 	{
-		p.SetState(253)
+		p.SetState(305)
 		p.CollectAggregateSelector()
 	}
-	p.SetState(258)
+	p.SetState(310)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == FqlParserComma {
 		{
-			p.SetState(254)
+			p.SetState(306)
 			p.Match(FqlParserComma)
 		}
 		{
-			p.SetState(255)
+			p.SetState(307)
 			p.CollectAggregateSelector()
 		}
 
-		p.SetState(260)
+		p.SetState(312)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3736,8 +4169,14 @@ func NewCollectAggregateSelectorContext(parser antlr.Parser, parent antlr.Parser
 
 func (s *CollectAggregateSelectorContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CollectAggregateSelectorContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *CollectAggregateSelectorContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
 func (s *CollectAggregateSelectorContext) Assign() antlr.TerminalNode {
@@ -3786,7 +4225,7 @@ func (s *CollectAggregateSelectorContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *FqlParser) CollectAggregateSelector() (localctx ICollectAggregateSelectorContext) {
 	localctx = NewCollectAggregateSelectorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, FqlParserRULE_collectAggregateSelector)
+	p.EnterRule(localctx, 48, FqlParserRULE_collectAggregateSelector)
 
 	defer func() {
 		p.ExitRule()
@@ -3806,15 +4245,15 @@ func (p *FqlParser) CollectAggregateSelector() (localctx ICollectAggregateSelect
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(261)
-		p.Match(FqlParserIdentifier)
+		p.SetState(313)
+		p.Identifier()
 	}
 	{
-		p.SetState(262)
+		p.SetState(314)
 		p.Match(FqlParserAssign)
 	}
 	{
-		p.SetState(263)
+		p.SetState(315)
 		p.FunctionCallExpression()
 	}
 
@@ -3873,12 +4312,27 @@ func (s *CollectGroupVariableContext) CollectSelector() ICollectSelectorContext
 	return t.(ICollectSelectorContext)
 }
 
-func (s *CollectGroupVariableContext) AllIdentifier() []antlr.TerminalNode {
-	return s.GetTokens(FqlParserIdentifier)
+func (s *CollectGroupVariableContext) AllIdentifier() []IIdentifierContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IIdentifierContext)(nil)).Elem())
+	var tst = make([]IIdentifierContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IIdentifierContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *CollectGroupVariableContext) Identifier(i int) antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, i)
+func (s *CollectGroupVariableContext) Identifier(i int) IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
 func (s *CollectGroupVariableContext) Keep() antlr.TerminalNode {
@@ -3917,7 +4371,7 @@ func (s *CollectGroupVariableContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *FqlParser) CollectGroupVariable() (localctx ICollectGroupVariableContext) {
 	localctx = NewCollectGroupVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, FqlParserRULE_collectGroupVariable)
+	p.EnterRule(localctx, 50, FqlParserRULE_collectGroupVariable)
 	var _la int
 
 	defer func() {
@@ -3936,42 +4390,42 @@ func (p *FqlParser) CollectGroupVariable() (localctx ICollectGroupVariableContex
 		}
 	}()
 
-	p.SetState(273)
+	p.SetState(325)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(265)
+			p.SetState(317)
 			p.Match(FqlParserInto)
 		}
 		{
-			p.SetState(266)
+			p.SetState(318)
 			p.CollectSelector()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(267)
+			p.SetState(319)
 			p.Match(FqlParserInto)
 		}
 		{
-			p.SetState(268)
-			p.Match(FqlParserIdentifier)
+			p.SetState(320)
+			p.Identifier()
 		}
-		p.SetState(271)
+		p.SetState(323)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == FqlParserKeep {
 			{
-				p.SetState(269)
+				p.SetState(321)
 				p.Match(FqlParserKeep)
 			}
 			{
-				p.SetState(270)
-				p.Match(FqlParserIdentifier)
+				p.SetState(322)
+				p.Identifier()
 			}
 
 		}
@@ -4031,8 +4485,14 @@ func (s *CollectCounterContext) Into() antlr.TerminalNode {
 	return s.GetToken(FqlParserInto, 0)
 }
 
-func (s *CollectCounterContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *CollectCounterContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
 func (s *CollectCounterContext) GetRuleContext() antlr.RuleContext {
@@ -4067,7 +4527,7 @@ func (s *CollectCounterContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *FqlParser) CollectCounter() (localctx ICollectCounterContext) {
 	localctx = NewCollectCounterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, FqlParserRULE_collectCounter)
+	p.EnterRule(localctx, 52, FqlParserRULE_collectCounter)
 
 	defer func() {
 		p.ExitRule()
@@ -4087,146 +4547,110 @@ func (p *FqlParser) CollectCounter() (localctx ICollectCounterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(275)
+		p.SetState(327)
 		p.Match(FqlParserWith)
 	}
 	{
-		p.SetState(276)
+		p.SetState(328)
 		p.Match(FqlParserCount)
 	}
 	{
-		p.SetState(277)
+		p.SetState(329)
 		p.Match(FqlParserInto)
 	}
 	{
-		p.SetState(278)
-		p.Match(FqlParserIdentifier)
+		p.SetState(330)
+		p.Identifier()
 	}
 
 	return localctx
 }
 
-// IVariableDeclarationContext is an interface to support dynamic dispatch.
-type IVariableDeclarationContext interface {
+// ICollectOptionsContext is an interface to support dynamic dispatch.
+type ICollectOptionsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsVariableDeclarationContext differentiates from other interfaces.
-	IsVariableDeclarationContext()
+	// IsCollectOptionsContext differentiates from other interfaces.
+	IsCollectOptionsContext()
 }
 
-type VariableDeclarationContext struct {
+type CollectOptionsContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVariableDeclarationContext() *VariableDeclarationContext {
-	var p = new(VariableDeclarationContext)
+func NewEmptyCollectOptionsContext() *CollectOptionsContext {
+	var p = new(CollectOptionsContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_variableDeclaration
+	p.RuleIndex = FqlParserRULE_collectOptions
 	return p
 }
 
-func (*VariableDeclarationContext) IsVariableDeclarationContext() {}
+func (*CollectOptionsContext) IsCollectOptionsContext() {}
 
-func NewVariableDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationContext {
-	var p = new(VariableDeclarationContext)
+func NewCollectOptionsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CollectOptionsContext {
+	var p = new(CollectOptionsContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_variableDeclaration
+	p.RuleIndex = FqlParserRULE_collectOptions
 
 	return p
 }
 
-func (s *VariableDeclarationContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *VariableDeclarationContext) Let() antlr.TerminalNode {
-	return s.GetToken(FqlParserLet, 0)
-}
+func (s *CollectOptionsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *VariableDeclarationContext) Identifier() antlr.TerminalNode {
+func (s *CollectOptionsContext) Identifier() antlr.TerminalNode {
 	return s.GetToken(FqlParserIdentifier, 0)
 }
 
-func (s *VariableDeclarationContext) Assign() antlr.TerminalNode {
-	return s.GetToken(FqlParserAssign, 0)
-}
-
-func (s *VariableDeclarationContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
-}
-
-func (s *VariableDeclarationContext) OpenParen() antlr.TerminalNode {
-	return s.GetToken(FqlParserOpenParen, 0)
-}
-
-func (s *VariableDeclarationContext) ForExpression() IForExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IForExpressionContext)
-}
-
-func (s *VariableDeclarationContext) CloseParen() antlr.TerminalNode {
-	return s.GetToken(FqlParserCloseParen, 0)
-}
-
-func (s *VariableDeclarationContext) ForTernaryExpression() IForTernaryExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForTernaryExpressionContext)(nil)).Elem(), 0)
+func (s *CollectOptionsContext) ObjectLiteral() IObjectLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IObjectLiteralContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IForTernaryExpressionContext)
+	return t.(IObjectLiteralContext)
 }
 
-func (s *VariableDeclarationContext) GetRuleContext() antlr.RuleContext {
+func (s *CollectOptionsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VariableDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CollectOptionsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VariableDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CollectOptionsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterVariableDeclaration(s)
+		listenerT.EnterCollectOptions(s)
 	}
 }
 
-func (s *VariableDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CollectOptionsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitVariableDeclaration(s)
+		listenerT.ExitCollectOptions(s)
 	}
 }
 
-func (s *VariableDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CollectOptionsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitVariableDeclaration(s)
+		return t.VisitCollectOptions(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) VariableDeclaration() (localctx IVariableDeclarationContext) {
-	localctx = NewVariableDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, FqlParserRULE_variableDeclaration)
+func (p *FqlParser) CollectOptions() (localctx ICollectOptionsContext) {
+	localctx = NewCollectOptionsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, FqlParserRULE_collectOptions)
 
 	defer func() {
 		p.ExitRule()
@@ -4244,158 +4668,125 @@ func (p *FqlParser) VariableDeclaration() (localctx IVariableDeclarationContext)
 		}
 	}()
 
-	p.SetState(295)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(280)
-			p.Match(FqlParserLet)
-		}
-		{
-			p.SetState(281)
-			p.Match(FqlParserIdentifier)
-		}
-		{
-			p.SetState(282)
-			p.Match(FqlParserAssign)
-		}
-		{
-			p.SetState(283)
-			p.expression(0)
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(284)
-			p.Match(FqlParserLet)
-		}
-		{
-			p.SetState(285)
-			p.Match(FqlParserIdentifier)
-		}
-		{
-			p.SetState(286)
-			p.Match(FqlParserAssign)
-		}
-		{
-			p.SetState(287)
-			p.Match(FqlParserOpenParen)
-		}
-		{
-			p.SetState(288)
-			p.ForExpression()
-		}
-		{
-			p.SetState(289)
-			p.Match(FqlParserCloseParen)
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(291)
-			p.Match(FqlParserLet)
-		}
-		{
-			p.SetState(292)
-			p.Match(FqlParserIdentifier)
-		}
-		{
-			p.SetState(293)
-			p.Match(FqlParserAssign)
-		}
-		{
-			p.SetState(294)
-			p.ForTernaryExpression()
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(332)
+		p.Match(FqlParserIdentifier)
+	}
+	{
+		p.SetState(333)
+		p.ObjectLiteral()
 	}
 
 	return localctx
 }
 
-// IParamContext is an interface to support dynamic dispatch.
-type IParamContext interface {
+// IWindowClauseContext is an interface to support dynamic dispatch.
+type IWindowClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsParamContext differentiates from other interfaces.
-	IsParamContext()
+	// IsWindowClauseContext differentiates from other interfaces.
+	IsWindowClauseContext()
 }
 
-type ParamContext struct {
+type WindowClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyParamContext() *ParamContext {
-	var p = new(ParamContext)
+func NewEmptyWindowClauseContext() *WindowClauseContext {
+	var p = new(WindowClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_param
+	p.RuleIndex = FqlParserRULE_windowClause
 	return p
 }
 
-func (*ParamContext) IsParamContext() {}
+func (*WindowClauseContext) IsWindowClauseContext() {}
 
-func NewParamContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamContext {
-	var p = new(ParamContext)
+func NewWindowClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WindowClauseContext {
+	var p = new(WindowClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_param
+	p.RuleIndex = FqlParserRULE_windowClause
 
 	return p
 }
 
-func (s *ParamContext) GetParser() antlr.Parser { return s.parser }
+func (s *WindowClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ParamContext) Param() antlr.TerminalNode {
-	return s.GetToken(FqlParserParam, 0)
+func (s *WindowClauseContext) Window() antlr.TerminalNode {
+	return s.GetToken(FqlParserWindow, 0)
 }
 
-func (s *ParamContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *WindowClauseContext) CollectAggregator() ICollectAggregatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICollectAggregatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICollectAggregatorContext)
 }
 
-func (s *ParamContext) GetRuleContext() antlr.RuleContext {
+func (s *WindowClauseContext) WindowPartition() IWindowPartitionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWindowPartitionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWindowPartitionContext)
+}
+
+func (s *WindowClauseContext) ObjectLiteral() IObjectLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IObjectLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IObjectLiteralContext)
+}
+
+func (s *WindowClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ParamContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WindowClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ParamContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WindowClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterParam(s)
+		listenerT.EnterWindowClause(s)
 	}
 }
 
-func (s *ParamContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WindowClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitParam(s)
+		listenerT.ExitWindowClause(s)
 	}
 }
 
-func (s *ParamContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WindowClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitParam(s)
+		return t.VisitWindowClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) Param() (localctx IParamContext) {
-	localctx = NewParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, FqlParserRULE_param)
+func (p *FqlParser) WindowClause() (localctx IWindowClauseContext) {
+	localctx = NewWindowClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, FqlParserRULE_windowClause)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4415,92 +4806,124 @@ func (p *FqlParser) Param() (localctx IParamContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(297)
-		p.Match(FqlParserParam)
+		p.SetState(335)
+		p.Match(FqlParserWindow)
+	}
+	p.SetState(337)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FqlParserPartition {
+		{
+			p.SetState(336)
+			p.WindowPartition()
+		}
+
+	}
+	p.SetState(340)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FqlParserOpenBrace {
+		{
+			p.SetState(339)
+			p.ObjectLiteral()
+		}
+
 	}
 	{
-		p.SetState(298)
-		p.Match(FqlParserIdentifier)
+		p.SetState(342)
+		p.CollectAggregator()
 	}
 
 	return localctx
 }
 
-// IVariableContext is an interface to support dynamic dispatch.
-type IVariableContext interface {
+// IWindowPartitionContext is an interface to support dynamic dispatch.
+type IWindowPartitionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsVariableContext differentiates from other interfaces.
-	IsVariableContext()
+	// IsWindowPartitionContext differentiates from other interfaces.
+	IsWindowPartitionContext()
 }
 
-type VariableContext struct {
+type WindowPartitionContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVariableContext() *VariableContext {
-	var p = new(VariableContext)
+func NewEmptyWindowPartitionContext() *WindowPartitionContext {
+	var p = new(WindowPartitionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_variable
+	p.RuleIndex = FqlParserRULE_windowPartition
 	return p
 }
 
-func (*VariableContext) IsVariableContext() {}
+func (*WindowPartitionContext) IsWindowPartitionContext() {}
 
-func NewVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableContext {
-	var p = new(VariableContext)
+func NewWindowPartitionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WindowPartitionContext {
+	var p = new(WindowPartitionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_variable
+	p.RuleIndex = FqlParserRULE_windowPartition
 
 	return p
 }
 
-func (s *VariableContext) GetParser() antlr.Parser { return s.parser }
+func (s *WindowPartitionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *VariableContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *WindowPartitionContext) Partition() antlr.TerminalNode {
+	return s.GetToken(FqlParserPartition, 0)
 }
 
-func (s *VariableContext) GetRuleContext() antlr.RuleContext {
+func (s *WindowPartitionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *WindowPartitionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WindowPartitionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WindowPartitionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterVariable(s)
+		listenerT.EnterWindowPartition(s)
 	}
 }
 
-func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WindowPartitionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitVariable(s)
+		listenerT.ExitWindowPartition(s)
 	}
 }
 
-func (s *VariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WindowPartitionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitVariable(s)
+		return t.VisitWindowPartition(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) Variable() (localctx IVariableContext) {
-	localctx = NewVariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, FqlParserRULE_variable)
+func (p *FqlParser) WindowPartition() (localctx IWindowPartitionContext) {
+	localctx = NewWindowPartitionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, FqlParserRULE_windowPartition)
 
 	defer func() {
 		p.ExitRule()
@@ -4520,157 +4943,144 @@ func (p *FqlParser) Variable() (localctx IVariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(300)
-		p.Match(FqlParserIdentifier)
+		p.SetState(344)
+		p.Match(FqlParserPartition)
+	}
+	{
+		p.SetState(345)
+		p.expression(0)
 	}
 
 	return localctx
 }
 
-// IRangeOperatorContext is an interface to support dynamic dispatch.
-type IRangeOperatorContext interface {
+// IVariableDeclarationContext is an interface to support dynamic dispatch.
+type IVariableDeclarationContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRangeOperatorContext differentiates from other interfaces.
-	IsRangeOperatorContext()
+	// IsVariableDeclarationContext differentiates from other interfaces.
+	IsVariableDeclarationContext()
 }
 
-type RangeOperatorContext struct {
+type VariableDeclarationContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRangeOperatorContext() *RangeOperatorContext {
-	var p = new(RangeOperatorContext)
+func NewEmptyVariableDeclarationContext() *VariableDeclarationContext {
+	var p = new(VariableDeclarationContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_rangeOperator
+	p.RuleIndex = FqlParserRULE_variableDeclaration
 	return p
 }
 
-func (*RangeOperatorContext) IsRangeOperatorContext() {}
+func (*VariableDeclarationContext) IsVariableDeclarationContext() {}
 
-func NewRangeOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RangeOperatorContext {
-	var p = new(RangeOperatorContext)
+func NewVariableDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationContext {
+	var p = new(VariableDeclarationContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_rangeOperator
+	p.RuleIndex = FqlParserRULE_variableDeclaration
 
 	return p
 }
 
-func (s *RangeOperatorContext) GetParser() antlr.Parser { return s.parser }
+func (s *VariableDeclarationContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RangeOperatorContext) Range() antlr.TerminalNode {
-	return s.GetToken(FqlParserRange, 0)
+func (s *VariableDeclarationContext) Let() antlr.TerminalNode {
+	return s.GetToken(FqlParserLet, 0)
 }
 
-func (s *RangeOperatorContext) AllIntegerLiteral() []IIntegerLiteralContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IIntegerLiteralContext)(nil)).Elem())
-	var tst = make([]IIntegerLiteralContext, len(ts))
+func (s *VariableDeclarationContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IIntegerLiteralContext)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IIdentifierContext)
 }
 
-func (s *RangeOperatorContext) IntegerLiteral(i int) IIntegerLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIntegerLiteralContext)(nil)).Elem(), i)
+func (s *VariableDeclarationContext) Assign() antlr.TerminalNode {
+	return s.GetToken(FqlParserAssign, 0)
+}
+
+func (s *VariableDeclarationContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIntegerLiteralContext)
+	return t.(IExpressionContext)
 }
 
-func (s *RangeOperatorContext) AllVariable() []IVariableContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVariableContext)(nil)).Elem())
-	var tst = make([]IVariableContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IVariableContext)
-		}
-	}
-
-	return tst
+func (s *VariableDeclarationContext) OpenParen() antlr.TerminalNode {
+	return s.GetToken(FqlParserOpenParen, 0)
 }
 
-func (s *RangeOperatorContext) Variable(i int) IVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), i)
+func (s *VariableDeclarationContext) ForExpression() IForExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IVariableContext)
+	return t.(IForExpressionContext)
 }
 
-func (s *RangeOperatorContext) AllParam() []IParamContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParamContext)(nil)).Elem())
-	var tst = make([]IParamContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IParamContext)
-		}
-	}
-
-	return tst
+func (s *VariableDeclarationContext) CloseParen() antlr.TerminalNode {
+	return s.GetToken(FqlParserCloseParen, 0)
 }
 
-func (s *RangeOperatorContext) Param(i int) IParamContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamContext)(nil)).Elem(), i)
+func (s *VariableDeclarationContext) ForTernaryExpression() IForTernaryExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForTernaryExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IParamContext)
+	return t.(IForTernaryExpressionContext)
 }
 
-func (s *RangeOperatorContext) GetRuleContext() antlr.RuleContext {
+func (s *VariableDeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RangeOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *VariableDeclarationContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RangeOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *VariableDeclarationContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterRangeOperator(s)
+		listenerT.EnterVariableDeclaration(s)
 	}
 }
 
-func (s *RangeOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *VariableDeclarationContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitRangeOperator(s)
+		listenerT.ExitVariableDeclaration(s)
 	}
 }
 
-func (s *RangeOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *VariableDeclarationContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitRangeOperator(s)
+		return t.VisitVariableDeclaration(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) RangeOperator() (localctx IRangeOperatorContext) {
-	localctx = NewRangeOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, FqlParserRULE_rangeOperator)
+func (p *FqlParser) VariableDeclaration() (localctx IVariableDeclarationContext) {
+	localctx = NewVariableDeclarationContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, FqlParserRULE_variableDeclaration)
 
 	defer func() {
 		p.ExitRule()
@@ -4688,155 +5098,168 @@ func (p *FqlParser) RangeOperator() (localctx IRangeOperatorContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(305)
+	p.SetState(364)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case FqlParserIntegerLiteral:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(302)
-			p.IntegerLiteral()
+			p.SetState(347)
+			p.Match(FqlParserLet)
 		}
-
-	case FqlParserIdentifier:
 		{
-			p.SetState(303)
-			p.Variable()
+			p.SetState(348)
+			p.Identifier()
 		}
-
-	case FqlParserParam:
 		{
-			p.SetState(304)
-			p.Param()
+			p.SetState(349)
+			p.Match(FqlParserAssign)
 		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-	{
-		p.SetState(307)
-		p.Match(FqlParserRange)
-	}
-	p.SetState(311)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case FqlParserIntegerLiteral:
 		{
-			p.SetState(308)
-			p.IntegerLiteral()
+			p.SetState(350)
+			p.expression(0)
 		}
 
-	case FqlParserIdentifier:
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(309)
-			p.Variable()
+			p.SetState(352)
+			p.Match(FqlParserLet)
+		}
+		{
+			p.SetState(353)
+			p.Identifier()
+		}
+		{
+			p.SetState(354)
+			p.Match(FqlParserAssign)
+		}
+		{
+			p.SetState(355)
+			p.Match(FqlParserOpenParen)
+		}
+		{
+			p.SetState(356)
+			p.ForExpression()
+		}
+		{
+			p.SetState(357)
+			p.Match(FqlParserCloseParen)
 		}
 
-	case FqlParserParam:
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(310)
-			p.Param()
+			p.SetState(359)
+			p.Match(FqlParserLet)
+		}
+		{
+			p.SetState(360)
+			p.Identifier()
+		}
+		{
+			p.SetState(361)
+			p.Match(FqlParserAssign)
+		}
+		{
+			p.SetState(362)
+			p.ForTernaryExpression()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IArrayLiteralContext is an interface to support dynamic dispatch.
-type IArrayLiteralContext interface {
+// IParamContext is an interface to support dynamic dispatch.
+type IParamContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsArrayLiteralContext differentiates from other interfaces.
-	IsArrayLiteralContext()
+	// IsParamContext differentiates from other interfaces.
+	IsParamContext()
 }
 
-type ArrayLiteralContext struct {
+type ParamContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArrayLiteralContext() *ArrayLiteralContext {
-	var p = new(ArrayLiteralContext)
+func NewEmptyParamContext() *ParamContext {
+	var p = new(ParamContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_arrayLiteral
+	p.RuleIndex = FqlParserRULE_param
 	return p
 }
 
-func (*ArrayLiteralContext) IsArrayLiteralContext() {}
+func (*ParamContext) IsParamContext() {}
 
-func NewArrayLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayLiteralContext {
-	var p = new(ArrayLiteralContext)
+func NewParamContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParamContext {
+	var p = new(ParamContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_arrayLiteral
+	p.RuleIndex = FqlParserRULE_param
 
 	return p
 }
 
-func (s *ArrayLiteralContext) GetParser() antlr.Parser { return s.parser }
+func (s *ParamContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArrayLiteralContext) OpenBracket() antlr.TerminalNode {
-	return s.GetToken(FqlParserOpenBracket, 0)
+func (s *ParamContext) Param() antlr.TerminalNode {
+	return s.GetToken(FqlParserParam, 0)
 }
 
-func (s *ArrayLiteralContext) CloseBracket() antlr.TerminalNode {
-	return s.GetToken(FqlParserCloseBracket, 0)
+func (s *ParamContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(FqlParserIdentifier, 0)
 }
 
-func (s *ArrayLiteralContext) ArrayElementList() IArrayElementListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayElementListContext)(nil)).Elem(), 0)
+func (s *ParamContext) ReservedWord() IReservedWordContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReservedWordContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IArrayElementListContext)
+	return t.(IReservedWordContext)
 }
 
-func (s *ArrayLiteralContext) GetRuleContext() antlr.RuleContext {
+func (s *ParamContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrayLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ParamContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArrayLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ParamContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterArrayLiteral(s)
+		listenerT.EnterParam(s)
 	}
 }
 
-func (s *ArrayLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ParamContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitArrayLiteral(s)
+		listenerT.ExitParam(s)
 	}
 }
 
-func (s *ArrayLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ParamContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitArrayLiteral(s)
+		return t.VisitParam(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) ArrayLiteral() (localctx IArrayLiteralContext) {
-	localctx = NewArrayLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, FqlParserRULE_arrayLiteral)
-	var _la int
+func (p *FqlParser) Param() (localctx IParamContext) {
+	localctx = NewParamContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, FqlParserRULE_param)
 
 	defer func() {
 		p.ExitRule()
@@ -4856,139 +5279,113 @@ func (p *FqlParser) ArrayLiteral() (localctx IArrayLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(313)
-		p.Match(FqlParserOpenBracket)
+		p.SetState(366)
+		p.Match(FqlParserParam)
 	}
-	p.SetState(315)
+	p.SetState(369)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
+	switch p.GetTokenStream().LA(1) {
+	case FqlParserIdentifier:
 		{
-			p.SetState(314)
-			p.ArrayElementList()
+			p.SetState(367)
+			p.Match(FqlParserIdentifier)
 		}
 
-	}
-	{
-		p.SetState(317)
-		p.Match(FqlParserCloseBracket)
+	case FqlParserFor, FqlParserReturn, FqlParserDistinct, FqlParserFilter, FqlParserSort, FqlParserLimit, FqlParserLet, FqlParserCollect, FqlParserSortDirection, FqlParserNone, FqlParserNull, FqlParserBooleanLiteral, FqlParserNulls, FqlParserCollate, FqlParserInto, FqlParserKeep, FqlParserWith, FqlParserCount, FqlParserAll, FqlParserAny, FqlParserAggregate, FqlParserWindow, FqlParserPartition, FqlParserSwitch, FqlParserWhen, FqlParserCase, FqlParserDefault, FqlParserEnd, FqlParserLike, FqlParserIn:
+		{
+			p.SetState(368)
+			p.ReservedWord()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IObjectLiteralContext is an interface to support dynamic dispatch.
-type IObjectLiteralContext interface {
+// IVariableContext is an interface to support dynamic dispatch.
+type IVariableContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsObjectLiteralContext differentiates from other interfaces.
-	IsObjectLiteralContext()
+	// IsVariableContext differentiates from other interfaces.
+	IsVariableContext()
 }
 
-type ObjectLiteralContext struct {
+type VariableContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyObjectLiteralContext() *ObjectLiteralContext {
-	var p = new(ObjectLiteralContext)
+func NewEmptyVariableContext() *VariableContext {
+	var p = new(VariableContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_objectLiteral
+	p.RuleIndex = FqlParserRULE_variable
 	return p
 }
 
-func (*ObjectLiteralContext) IsObjectLiteralContext() {}
+func (*VariableContext) IsVariableContext() {}
 
-func NewObjectLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ObjectLiteralContext {
-	var p = new(ObjectLiteralContext)
+func NewVariableContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableContext {
+	var p = new(VariableContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_objectLiteral
+	p.RuleIndex = FqlParserRULE_variable
 
 	return p
 }
 
-func (s *ObjectLiteralContext) GetParser() antlr.Parser { return s.parser }
+func (s *VariableContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ObjectLiteralContext) OpenBrace() antlr.TerminalNode {
-	return s.GetToken(FqlParserOpenBrace, 0)
-}
-
-func (s *ObjectLiteralContext) CloseBrace() antlr.TerminalNode {
-	return s.GetToken(FqlParserCloseBrace, 0)
-}
-
-func (s *ObjectLiteralContext) AllPropertyAssignment() []IPropertyAssignmentContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPropertyAssignmentContext)(nil)).Elem())
-	var tst = make([]IPropertyAssignmentContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IPropertyAssignmentContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *ObjectLiteralContext) PropertyAssignment(i int) IPropertyAssignmentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyAssignmentContext)(nil)).Elem(), i)
+func (s *VariableContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IPropertyAssignmentContext)
-}
-
-func (s *ObjectLiteralContext) AllComma() []antlr.TerminalNode {
-	return s.GetTokens(FqlParserComma)
-}
-
-func (s *ObjectLiteralContext) Comma(i int) antlr.TerminalNode {
-	return s.GetToken(FqlParserComma, i)
+	return t.(IIdentifierContext)
 }
 
-func (s *ObjectLiteralContext) GetRuleContext() antlr.RuleContext {
+func (s *VariableContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ObjectLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *VariableContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ObjectLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *VariableContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterObjectLiteral(s)
+		listenerT.EnterVariable(s)
 	}
 }
 
-func (s *ObjectLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *VariableContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitObjectLiteral(s)
+		listenerT.ExitVariable(s)
 	}
 }
 
-func (s *ObjectLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *VariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitObjectLiteral(s)
+		return t.VisitVariable(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) ObjectLiteral() (localctx IObjectLiteralContext) {
-	localctx = NewObjectLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, FqlParserRULE_objectLiteral)
-	var _la int
+func (p *FqlParser) Variable() (localctx IVariableContext) {
+	localctx = NewVariableContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, FqlParserRULE_variable)
 
 	defer func() {
 		p.ExitRule()
@@ -5006,243 +5403,126 @@ func (p *FqlParser) ObjectLiteral() (localctx IObjectLiteralContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(319)
-		p.Match(FqlParserOpenBrace)
-	}
-	p.SetState(328)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == FqlParserOpenBracket || (((_la-58)&-(0x1f+1)) == 0 && ((1<<uint((_la-58)))&((1<<(FqlParserIdentifier-58))|(1<<(FqlParserStringLiteral-58))|(1<<(FqlParserTemplateStringLiteral-58)))) != 0) {
-		{
-			p.SetState(320)
-			p.PropertyAssignment()
-		}
-		p.SetState(325)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext())
-
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				{
-					p.SetState(321)
-					p.Match(FqlParserComma)
-				}
-				{
-					p.SetState(322)
-					p.PropertyAssignment()
-				}
-
-			}
-			p.SetState(327)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext())
-		}
-
-	}
-	p.SetState(331)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == FqlParserComma {
-		{
-			p.SetState(330)
-			p.Match(FqlParserComma)
-		}
-
-	}
-	{
-		p.SetState(333)
-		p.Match(FqlParserCloseBrace)
+		p.SetState(371)
+		p.Identifier()
 	}
 
 	return localctx
 }
 
-// IBooleanLiteralContext is an interface to support dynamic dispatch.
-type IBooleanLiteralContext interface {
+// IIdentifierContext is an interface to support dynamic dispatch.
+type IIdentifierContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsBooleanLiteralContext differentiates from other interfaces.
-	IsBooleanLiteralContext()
+	// IsIdentifierContext differentiates from other interfaces.
+	IsIdentifierContext()
 }
 
-type BooleanLiteralContext struct {
+type IdentifierContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBooleanLiteralContext() *BooleanLiteralContext {
-	var p = new(BooleanLiteralContext)
+func NewEmptyIdentifierContext() *IdentifierContext {
+	var p = new(IdentifierContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_booleanLiteral
+	p.RuleIndex = FqlParserRULE_identifier
 	return p
 }
 
-func (*BooleanLiteralContext) IsBooleanLiteralContext() {}
+func (*IdentifierContext) IsIdentifierContext() {}
 
-func NewBooleanLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BooleanLiteralContext {
-	var p = new(BooleanLiteralContext)
+func NewIdentifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierContext {
+	var p = new(IdentifierContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_booleanLiteral
+	p.RuleIndex = FqlParserRULE_identifier
 
 	return p
 }
 
-func (s *BooleanLiteralContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *BooleanLiteralContext) BooleanLiteral() antlr.TerminalNode {
-	return s.GetToken(FqlParserBooleanLiteral, 0)
-}
-
-func (s *BooleanLiteralContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *BooleanLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *IdentifierContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BooleanLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterBooleanLiteral(s)
-	}
+func (s *IdentifierContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(FqlParserIdentifier, 0)
 }
 
-func (s *BooleanLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitBooleanLiteral(s)
-	}
+func (s *IdentifierContext) SortDirection() antlr.TerminalNode {
+	return s.GetToken(FqlParserSortDirection, 0)
 }
 
-func (s *BooleanLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case FqlParserVisitor:
-		return t.VisitBooleanLiteral(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *IdentifierContext) Nulls() antlr.TerminalNode {
+	return s.GetToken(FqlParserNulls, 0)
 }
 
-func (p *FqlParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
-	localctx = NewBooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, FqlParserRULE_booleanLiteral)
-
-	defer func() {
-		p.ExitRule()
-	}()
-
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(335)
-		p.Match(FqlParserBooleanLiteral)
-	}
-
-	return localctx
+func (s *IdentifierContext) Collate() antlr.TerminalNode {
+	return s.GetToken(FqlParserCollate, 0)
 }
 
-// IStringLiteralContext is an interface to support dynamic dispatch.
-type IStringLiteralContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsStringLiteralContext differentiates from other interfaces.
-	IsStringLiteralContext()
+func (s *IdentifierContext) Count() antlr.TerminalNode {
+	return s.GetToken(FqlParserCount, 0)
 }
 
-type StringLiteralContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *IdentifierContext) All() antlr.TerminalNode {
+	return s.GetToken(FqlParserAll, 0)
 }
 
-func NewEmptyStringLiteralContext() *StringLiteralContext {
-	var p = new(StringLiteralContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_stringLiteral
-	return p
+func (s *IdentifierContext) Any() antlr.TerminalNode {
+	return s.GetToken(FqlParserAny, 0)
 }
 
-func (*StringLiteralContext) IsStringLiteralContext() {}
-
-func NewStringLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StringLiteralContext {
-	var p = new(StringLiteralContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = FqlParserRULE_stringLiteral
-
-	return p
+func (s *IdentifierContext) Partition() antlr.TerminalNode {
+	return s.GetToken(FqlParserPartition, 0)
 }
 
-func (s *StringLiteralContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *StringLiteralContext) StringLiteral() antlr.TerminalNode {
-	return s.GetToken(FqlParserStringLiteral, 0)
+func (s *IdentifierContext) Default() antlr.TerminalNode {
+	return s.GetToken(FqlParserDefault, 0)
 }
 
-func (s *StringLiteralContext) TemplateStringLiteral() antlr.TerminalNode {
-	return s.GetToken(FqlParserTemplateStringLiteral, 0)
+func (s *IdentifierContext) End() antlr.TerminalNode {
+	return s.GetToken(FqlParserEnd, 0)
 }
 
-func (s *StringLiteralContext) GetRuleContext() antlr.RuleContext {
+func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StringLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IdentifierContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StringLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterStringLiteral(s)
+		listenerT.EnterIdentifier(s)
 	}
 }
 
-func (s *StringLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitStringLiteral(s)
+		listenerT.ExitIdentifier(s)
 	}
 }
 
-func (s *StringLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitStringLiteral(s)
+		return t.VisitIdentifier(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) StringLiteral() (localctx IStringLiteralContext) {
-	localctx = NewStringLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, FqlParserRULE_stringLiteral)
+func (p *FqlParser) Identifier() (localctx IIdentifierContext) {
+	localctx = NewIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, FqlParserRULE_identifier)
 	var _la int
 
 	defer func() {
@@ -5263,10 +5543,10 @@ func (p *FqlParser) StringLiteral() (localctx IStringLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(337)
+		p.SetState(373)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == FqlParserStringLiteral || _la == FqlParserTemplateStringLiteral) {
+		if !(((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserSortDirection-44))|(1<<(FqlParserNulls-44))|(1<<(FqlParserCollate-44))|(1<<(FqlParserCount-44))|(1<<(FqlParserAll-44))|(1<<(FqlParserAny-44))|(1<<(FqlParserPartition-44))|(1<<(FqlParserDefault-44))|(1<<(FqlParserEnd-44))|(1<<(FqlParserIdentifier-44)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -5277,182 +5557,150 @@ func (p *FqlParser) StringLiteral() (localctx IStringLiteralContext) {
 	return localctx
 }
 
-// IIntegerLiteralContext is an interface to support dynamic dispatch.
-type IIntegerLiteralContext interface {
+// IRangeOperatorContext is an interface to support dynamic dispatch.
+type IRangeOperatorContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsIntegerLiteralContext differentiates from other interfaces.
-	IsIntegerLiteralContext()
+	// IsRangeOperatorContext differentiates from other interfaces.
+	IsRangeOperatorContext()
 }
 
-type IntegerLiteralContext struct {
+type RangeOperatorContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyIntegerLiteralContext() *IntegerLiteralContext {
-	var p = new(IntegerLiteralContext)
+func NewEmptyRangeOperatorContext() *RangeOperatorContext {
+	var p = new(RangeOperatorContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_integerLiteral
+	p.RuleIndex = FqlParserRULE_rangeOperator
 	return p
 }
 
-func (*IntegerLiteralContext) IsIntegerLiteralContext() {}
+func (*RangeOperatorContext) IsRangeOperatorContext() {}
 
-func NewIntegerLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IntegerLiteralContext {
-	var p = new(IntegerLiteralContext)
+func NewRangeOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RangeOperatorContext {
+	var p = new(RangeOperatorContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_integerLiteral
+	p.RuleIndex = FqlParserRULE_rangeOperator
 
 	return p
 }
 
-func (s *IntegerLiteralContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *IntegerLiteralContext) IntegerLiteral() antlr.TerminalNode {
-	return s.GetToken(FqlParserIntegerLiteral, 0)
-}
+func (s *RangeOperatorContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IntegerLiteralContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *RangeOperatorContext) Range() antlr.TerminalNode {
+	return s.GetToken(FqlParserRange, 0)
 }
 
-func (s *IntegerLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *RangeOperatorContext) AllIntegerLiteral() []IIntegerLiteralContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IIntegerLiteralContext)(nil)).Elem())
+	var tst = make([]IIntegerLiteralContext, len(ts))
 
-func (s *IntegerLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterIntegerLiteral(s)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IIntegerLiteralContext)
+		}
 	}
+
+	return tst
 }
 
-func (s *IntegerLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitIntegerLiteral(s)
-	}
-}
-
-func (s *IntegerLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case FqlParserVisitor:
-		return t.VisitIntegerLiteral(s)
+func (s *RangeOperatorContext) IntegerLiteral(i int) IIntegerLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIntegerLiteralContext)(nil)).Elem(), i)
 
-	default:
-		return t.VisitChildren(s)
+	if t == nil {
+		return nil
 	}
-}
 
-func (p *FqlParser) IntegerLiteral() (localctx IIntegerLiteralContext) {
-	localctx = NewIntegerLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, FqlParserRULE_integerLiteral)
+	return t.(IIntegerLiteralContext)
+}
 
-	defer func() {
-		p.ExitRule()
-	}()
+func (s *RangeOperatorContext) AllVariable() []IVariableContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVariableContext)(nil)).Elem())
+	var tst = make([]IVariableContext, len(ts))
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IVariableContext)
 		}
-	}()
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(339)
-		p.Match(FqlParserIntegerLiteral)
 	}
 
-	return localctx
+	return tst
 }
 
-// IFloatLiteralContext is an interface to support dynamic dispatch.
-type IFloatLiteralContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsFloatLiteralContext differentiates from other interfaces.
-	IsFloatLiteralContext()
-}
+func (s *RangeOperatorContext) Variable(i int) IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), i)
 
-type FloatLiteralContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
+	if t == nil {
+		return nil
+	}
 
-func NewEmptyFloatLiteralContext() *FloatLiteralContext {
-	var p = new(FloatLiteralContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_floatLiteral
-	return p
+	return t.(IVariableContext)
 }
 
-func (*FloatLiteralContext) IsFloatLiteralContext() {}
-
-func NewFloatLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FloatLiteralContext {
-	var p = new(FloatLiteralContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *RangeOperatorContext) AllParam() []IParamContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IParamContext)(nil)).Elem())
+	var tst = make([]IParamContext, len(ts))
 
-	p.parser = parser
-	p.RuleIndex = FqlParserRULE_floatLiteral
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IParamContext)
+		}
+	}
 
-	return p
+	return tst
 }
 
-func (s *FloatLiteralContext) GetParser() antlr.Parser { return s.parser }
+func (s *RangeOperatorContext) Param(i int) IParamContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamContext)(nil)).Elem(), i)
 
-func (s *FloatLiteralContext) FloatLiteral() antlr.TerminalNode {
-	return s.GetToken(FqlParserFloatLiteral, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamContext)
 }
 
-func (s *FloatLiteralContext) GetRuleContext() antlr.RuleContext {
+func (s *RangeOperatorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FloatLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RangeOperatorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FloatLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RangeOperatorContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterFloatLiteral(s)
+		listenerT.EnterRangeOperator(s)
 	}
 }
 
-func (s *FloatLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RangeOperatorContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitFloatLiteral(s)
+		listenerT.ExitRangeOperator(s)
 	}
 }
 
-func (s *FloatLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RangeOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitFloatLiteral(s)
+		return t.VisitRangeOperator(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) FloatLiteral() (localctx IFloatLiteralContext) {
-	localctx = NewFloatLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, FqlParserRULE_floatLiteral)
+func (p *FqlParser) RangeOperator() (localctx IRangeOperatorContext) {
+	localctx = NewRangeOperatorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, FqlParserRULE_rangeOperator)
 
 	defer func() {
 		p.ExitRule()
@@ -5471,93 +5719,153 @@ func (p *FqlParser) FloatLiteral() (localctx IFloatLiteralContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
+	p.SetState(378)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case FqlParserIntegerLiteral:
+		{
+			p.SetState(375)
+			p.IntegerLiteral()
+		}
+
+	case FqlParserSortDirection, FqlParserNulls, FqlParserCollate, FqlParserCount, FqlParserAll, FqlParserAny, FqlParserPartition, FqlParserDefault, FqlParserEnd, FqlParserIdentifier:
+		{
+			p.SetState(376)
+			p.Variable()
+		}
+
+	case FqlParserParam:
+		{
+			p.SetState(377)
+			p.Param()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
 	{
-		p.SetState(341)
-		p.Match(FqlParserFloatLiteral)
+		p.SetState(380)
+		p.Match(FqlParserRange)
+	}
+	p.SetState(384)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case FqlParserIntegerLiteral:
+		{
+			p.SetState(381)
+			p.IntegerLiteral()
+		}
+
+	case FqlParserSortDirection, FqlParserNulls, FqlParserCollate, FqlParserCount, FqlParserAll, FqlParserAny, FqlParserPartition, FqlParserDefault, FqlParserEnd, FqlParserIdentifier:
+		{
+			p.SetState(382)
+			p.Variable()
+		}
+
+	case FqlParserParam:
+		{
+			p.SetState(383)
+			p.Param()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// INoneLiteralContext is an interface to support dynamic dispatch.
-type INoneLiteralContext interface {
+// IArrayLiteralContext is an interface to support dynamic dispatch.
+type IArrayLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsNoneLiteralContext differentiates from other interfaces.
-	IsNoneLiteralContext()
+	// IsArrayLiteralContext differentiates from other interfaces.
+	IsArrayLiteralContext()
 }
 
-type NoneLiteralContext struct {
+type ArrayLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNoneLiteralContext() *NoneLiteralContext {
-	var p = new(NoneLiteralContext)
+func NewEmptyArrayLiteralContext() *ArrayLiteralContext {
+	var p = new(ArrayLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_noneLiteral
+	p.RuleIndex = FqlParserRULE_arrayLiteral
 	return p
 }
 
-func (*NoneLiteralContext) IsNoneLiteralContext() {}
+func (*ArrayLiteralContext) IsArrayLiteralContext() {}
 
-func NewNoneLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NoneLiteralContext {
-	var p = new(NoneLiteralContext)
+func NewArrayLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayLiteralContext {
+	var p = new(ArrayLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_noneLiteral
+	p.RuleIndex = FqlParserRULE_arrayLiteral
 
 	return p
 }
 
-func (s *NoneLiteralContext) GetParser() antlr.Parser { return s.parser }
+func (s *ArrayLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NoneLiteralContext) Null() antlr.TerminalNode {
-	return s.GetToken(FqlParserNull, 0)
+func (s *ArrayLiteralContext) OpenBracket() antlr.TerminalNode {
+	return s.GetToken(FqlParserOpenBracket, 0)
 }
 
-func (s *NoneLiteralContext) None() antlr.TerminalNode {
-	return s.GetToken(FqlParserNone, 0)
+func (s *ArrayLiteralContext) CloseBracket() antlr.TerminalNode {
+	return s.GetToken(FqlParserCloseBracket, 0)
 }
 
-func (s *NoneLiteralContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayLiteralContext) ArrayElementList() IArrayElementListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayElementListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArrayElementListContext)
+}
+
+func (s *ArrayLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NoneLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrayLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NoneLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterNoneLiteral(s)
+		listenerT.EnterArrayLiteral(s)
 	}
 }
 
-func (s *NoneLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitNoneLiteral(s)
+		listenerT.ExitArrayLiteral(s)
 	}
 }
 
-func (s *NoneLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitNoneLiteral(s)
+		return t.VisitArrayLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) NoneLiteral() (localctx INoneLiteralContext) {
-	localctx = NewNoneLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, FqlParserRULE_noneLiteral)
+func (p *FqlParser) ArrayLiteral() (localctx IArrayLiteralContext) {
+	localctx = NewArrayLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, FqlParserRULE_arrayLiteral)
 	var _la int
 
 	defer func() {
@@ -5578,122 +5886,138 @@ func (p *FqlParser) NoneLiteral() (localctx INoneLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(343)
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(386)
+		p.Match(FqlParserOpenBracket)
+	}
+	p.SetState(388)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-		if !(_la == FqlParserNone || _la == FqlParserNull) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus)|(1<<FqlParserEllipsis))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserSortDirection-44))|(1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserNulls-44))|(1<<(FqlParserCollate-44))|(1<<(FqlParserCount-44))|(1<<(FqlParserAll-44))|(1<<(FqlParserAny-44))|(1<<(FqlParserPartition-44))|(1<<(FqlParserSwitch-44))|(1<<(FqlParserWhen-44))|(1<<(FqlParserDefault-44))|(1<<(FqlParserEnd-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserRawStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
+		{
+			p.SetState(387)
+			p.ArrayElementList()
 		}
+
+	}
+	{
+		p.SetState(390)
+		p.Match(FqlParserCloseBracket)
 	}
 
 	return localctx
 }
 
-// IArrayElementListContext is an interface to support dynamic dispatch.
-type IArrayElementListContext interface {
+// IObjectLiteralContext is an interface to support dynamic dispatch.
+type IObjectLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsArrayElementListContext differentiates from other interfaces.
-	IsArrayElementListContext()
+	// IsObjectLiteralContext differentiates from other interfaces.
+	IsObjectLiteralContext()
 }
 
-type ArrayElementListContext struct {
+type ObjectLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArrayElementListContext() *ArrayElementListContext {
-	var p = new(ArrayElementListContext)
+func NewEmptyObjectLiteralContext() *ObjectLiteralContext {
+	var p = new(ObjectLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_arrayElementList
+	p.RuleIndex = FqlParserRULE_objectLiteral
 	return p
 }
 
-func (*ArrayElementListContext) IsArrayElementListContext() {}
+func (*ObjectLiteralContext) IsObjectLiteralContext() {}
 
-func NewArrayElementListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayElementListContext {
-	var p = new(ArrayElementListContext)
+func NewObjectLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ObjectLiteralContext {
+	var p = new(ObjectLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_arrayElementList
+	p.RuleIndex = FqlParserRULE_objectLiteral
 
 	return p
 }
 
-func (s *ArrayElementListContext) GetParser() antlr.Parser { return s.parser }
+func (s *ObjectLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArrayElementListContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+func (s *ObjectLiteralContext) OpenBrace() antlr.TerminalNode {
+	return s.GetToken(FqlParserOpenBrace, 0)
+}
+
+func (s *ObjectLiteralContext) CloseBrace() antlr.TerminalNode {
+	return s.GetToken(FqlParserCloseBrace, 0)
+}
+
+func (s *ObjectLiteralContext) AllPropertyAssignment() []IPropertyAssignmentContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPropertyAssignmentContext)(nil)).Elem())
+	var tst = make([]IPropertyAssignmentContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExpressionContext)
+			tst[i] = t.(IPropertyAssignmentContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *ArrayElementListContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *ObjectLiteralContext) PropertyAssignment(i int) IPropertyAssignmentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyAssignmentContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IPropertyAssignmentContext)
 }
 
-func (s *ArrayElementListContext) AllComma() []antlr.TerminalNode {
+func (s *ObjectLiteralContext) AllComma() []antlr.TerminalNode {
 	return s.GetTokens(FqlParserComma)
 }
 
-func (s *ArrayElementListContext) Comma(i int) antlr.TerminalNode {
+func (s *ObjectLiteralContext) Comma(i int) antlr.TerminalNode {
 	return s.GetToken(FqlParserComma, i)
 }
 
-func (s *ArrayElementListContext) GetRuleContext() antlr.RuleContext {
+func (s *ObjectLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrayElementListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ObjectLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArrayElementListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ObjectLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterArrayElementList(s)
+		listenerT.EnterObjectLiteral(s)
 	}
 }
 
-func (s *ArrayElementListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ObjectLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitArrayElementList(s)
+		listenerT.ExitObjectLiteral(s)
 	}
 }
 
-func (s *ArrayElementListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ObjectLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitArrayElementList(s)
+		return t.VisitObjectLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) ArrayElementList() (localctx IArrayElementListContext) {
-	localctx = NewArrayElementListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, FqlParserRULE_arrayElementList)
+func (p *FqlParser) ObjectLiteral() (localctx IObjectLiteralContext) {
+	localctx = NewObjectLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, FqlParserRULE_objectLiteral)
 	var _la int
 
 	defer func() {
@@ -5712,158 +6036,138 @@ func (p *FqlParser) ArrayElementList() (localctx IArrayElementListContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(345)
-		p.expression(0)
+		p.SetState(392)
+		p.Match(FqlParserOpenBrace)
 	}
-	p.SetState(354)
+	p.SetState(401)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == FqlParserComma {
-		p.SetState(347)
+	if (((_la-9)&-(0x1f+1)) == 0 && ((1<<uint((_la-9)))&((1<<(FqlParserOpenBracket-9))|(1<<(FqlParserEllipsis-9))|(1<<(FqlParserFor-9))|(1<<(FqlParserReturn-9))|(1<<(FqlParserDistinct-9))|(1<<(FqlParserFilter-9))|(1<<(FqlParserSort-9)))) != 0) || (((_la-41)&-(0x1f+1)) == 0 && ((1<<uint((_la-41)))&((1<<(FqlParserLimit-41))|(1<<(FqlParserLet-41))|(1<<(FqlParserCollect-41))|(1<<(FqlParserSortDirection-41))|(1<<(FqlParserNone-41))|(1<<(FqlParserNull-41))|(1<<(FqlParserBooleanLiteral-41))|(1<<(FqlParserNulls-41))|(1<<(FqlParserCollate-41))|(1<<(FqlParserInto-41))|(1<<(FqlParserKeep-41))|(1<<(FqlParserWith-41))|(1<<(FqlParserCount-41))|(1<<(FqlParserAll-41))|(1<<(FqlParserAny-41))|(1<<(FqlParserAggregate-41))|(1<<(FqlParserWindow-41))|(1<<(FqlParserPartition-41))|(1<<(FqlParserSwitch-41))|(1<<(FqlParserWhen-41))|(1<<(FqlParserCase-41))|(1<<(FqlParserDefault-41))|(1<<(FqlParserEnd-41))|(1<<(FqlParserLike-41))|(1<<(FqlParserIn-41))|(1<<(FqlParserIdentifier-41))|(1<<(FqlParserStringLiteral-41))|(1<<(FqlParserTemplateStringLiteral-41))|(1<<(FqlParserRawStringLiteral-41)))) != 0) {
+		{
+			p.SetState(393)
+			p.PropertyAssignment()
+		}
+		p.SetState(398)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
 
-		for ok := true; ok; ok = _la == FqlParserComma {
-			{
-				p.SetState(346)
-				p.Match(FqlParserComma)
-			}
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(394)
+					p.Match(FqlParserComma)
+				}
+				{
+					p.SetState(395)
+					p.PropertyAssignment()
+				}
 
-			p.SetState(349)
+			}
+			p.SetState(400)
 			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
 		}
+
+	}
+	p.SetState(404)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FqlParserComma {
 		{
-			p.SetState(351)
-			p.expression(0)
+			p.SetState(403)
+			p.Match(FqlParserComma)
 		}
 
-		p.SetState(356)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(406)
+		p.Match(FqlParserCloseBrace)
 	}
 
 	return localctx
 }
 
-// IPropertyAssignmentContext is an interface to support dynamic dispatch.
-type IPropertyAssignmentContext interface {
+// IBooleanLiteralContext is an interface to support dynamic dispatch.
+type IBooleanLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPropertyAssignmentContext differentiates from other interfaces.
-	IsPropertyAssignmentContext()
+	// IsBooleanLiteralContext differentiates from other interfaces.
+	IsBooleanLiteralContext()
 }
 
-type PropertyAssignmentContext struct {
+type BooleanLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPropertyAssignmentContext() *PropertyAssignmentContext {
-	var p = new(PropertyAssignmentContext)
+func NewEmptyBooleanLiteralContext() *BooleanLiteralContext {
+	var p = new(BooleanLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_propertyAssignment
+	p.RuleIndex = FqlParserRULE_booleanLiteral
 	return p
 }
 
-func (*PropertyAssignmentContext) IsPropertyAssignmentContext() {}
+func (*BooleanLiteralContext) IsBooleanLiteralContext() {}
 
-func NewPropertyAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyAssignmentContext {
-	var p = new(PropertyAssignmentContext)
+func NewBooleanLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BooleanLiteralContext {
+	var p = new(BooleanLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_propertyAssignment
+	p.RuleIndex = FqlParserRULE_booleanLiteral
 
 	return p
 }
 
-func (s *PropertyAssignmentContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PropertyAssignmentContext) PropertyName() IPropertyNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyNameContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPropertyNameContext)
-}
-
-func (s *PropertyAssignmentContext) Colon() antlr.TerminalNode {
-	return s.GetToken(FqlParserColon, 0)
-}
-
-func (s *PropertyAssignmentContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
-}
-
-func (s *PropertyAssignmentContext) ComputedPropertyName() IComputedPropertyNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IComputedPropertyNameContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IComputedPropertyNameContext)
-}
-
-func (s *PropertyAssignmentContext) ShorthandPropertyName() IShorthandPropertyNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShorthandPropertyNameContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *BooleanLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IShorthandPropertyNameContext)
+func (s *BooleanLiteralContext) BooleanLiteral() antlr.TerminalNode {
+	return s.GetToken(FqlParserBooleanLiteral, 0)
 }
 
-func (s *PropertyAssignmentContext) GetRuleContext() antlr.RuleContext {
+func (s *BooleanLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PropertyAssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BooleanLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PropertyAssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BooleanLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterPropertyAssignment(s)
+		listenerT.EnterBooleanLiteral(s)
 	}
 }
 
-func (s *PropertyAssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BooleanLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitPropertyAssignment(s)
+		listenerT.ExitBooleanLiteral(s)
 	}
 }
 
-func (s *PropertyAssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BooleanLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitPropertyAssignment(s)
+		return t.VisitBooleanLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) PropertyAssignment() (localctx IPropertyAssignmentContext) {
-	localctx = NewPropertyAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, FqlParserRULE_propertyAssignment)
+func (p *FqlParser) BooleanLiteral() (localctx IBooleanLiteralContext) {
+	localctx = NewBooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, FqlParserRULE_booleanLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -5881,180 +6185,99 @@ func (p *FqlParser) PropertyAssignment() (localctx IPropertyAssignmentContext) {
 		}
 	}()
 
-	p.SetState(366)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(357)
-			p.PropertyName()
-		}
-		{
-			p.SetState(358)
-			p.Match(FqlParserColon)
-		}
-		{
-			p.SetState(359)
-			p.expression(0)
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(361)
-			p.ComputedPropertyName()
-		}
-		{
-			p.SetState(362)
-			p.Match(FqlParserColon)
-		}
-		{
-			p.SetState(363)
-			p.expression(0)
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(365)
-			p.ShorthandPropertyName()
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(408)
+		p.Match(FqlParserBooleanLiteral)
 	}
 
 	return localctx
 }
 
-// IMemberExpressionContext is an interface to support dynamic dispatch.
-type IMemberExpressionContext interface {
+// IStringLiteralContext is an interface to support dynamic dispatch.
+type IStringLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMemberExpressionContext differentiates from other interfaces.
-	IsMemberExpressionContext()
+	// IsStringLiteralContext differentiates from other interfaces.
+	IsStringLiteralContext()
 }
 
-type MemberExpressionContext struct {
+type StringLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMemberExpressionContext() *MemberExpressionContext {
-	var p = new(MemberExpressionContext)
+func NewEmptyStringLiteralContext() *StringLiteralContext {
+	var p = new(StringLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_memberExpression
+	p.RuleIndex = FqlParserRULE_stringLiteral
 	return p
 }
 
-func (*MemberExpressionContext) IsMemberExpressionContext() {}
+func (*StringLiteralContext) IsStringLiteralContext() {}
 
-func NewMemberExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MemberExpressionContext {
-	var p = new(MemberExpressionContext)
+func NewStringLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StringLiteralContext {
+	var p = new(StringLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_memberExpression
+	p.RuleIndex = FqlParserRULE_stringLiteral
 
 	return p
 }
 
-func (s *MemberExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *StringLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MemberExpressionContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *StringLiteralContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(FqlParserStringLiteral, 0)
 }
 
-func (s *MemberExpressionContext) AllDot() []antlr.TerminalNode {
-	return s.GetTokens(FqlParserDot)
-}
-
-func (s *MemberExpressionContext) Dot(i int) antlr.TerminalNode {
-	return s.GetToken(FqlParserDot, i)
-}
-
-func (s *MemberExpressionContext) AllPropertyName() []IPropertyNameContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPropertyNameContext)(nil)).Elem())
-	var tst = make([]IPropertyNameContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IPropertyNameContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *MemberExpressionContext) PropertyName(i int) IPropertyNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyNameContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPropertyNameContext)
-}
-
-func (s *MemberExpressionContext) AllComputedPropertyName() []IComputedPropertyNameContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IComputedPropertyNameContext)(nil)).Elem())
-	var tst = make([]IComputedPropertyNameContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IComputedPropertyNameContext)
-		}
-	}
-
-	return tst
+func (s *StringLiteralContext) TemplateStringLiteral() antlr.TerminalNode {
+	return s.GetToken(FqlParserTemplateStringLiteral, 0)
 }
 
-func (s *MemberExpressionContext) ComputedPropertyName(i int) IComputedPropertyNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IComputedPropertyNameContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IComputedPropertyNameContext)
+func (s *StringLiteralContext) RawStringLiteral() antlr.TerminalNode {
+	return s.GetToken(FqlParserRawStringLiteral, 0)
 }
 
-func (s *MemberExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *StringLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MemberExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StringLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MemberExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StringLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterMemberExpression(s)
+		listenerT.EnterStringLiteral(s)
 	}
 }
 
-func (s *MemberExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StringLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitMemberExpression(s)
+		listenerT.ExitStringLiteral(s)
 	}
 }
 
-func (s *MemberExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StringLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitMemberExpression(s)
+		return t.VisitStringLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) MemberExpression() (localctx IMemberExpressionContext) {
-	localctx = NewMemberExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, FqlParserRULE_memberExpression)
+func (p *FqlParser) StringLiteral() (localctx IStringLiteralContext) {
+	localctx = NewStringLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, FqlParserRULE_stringLiteral)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6072,226 +6295,97 @@ func (p *FqlParser) MemberExpression() (localctx IMemberExpressionContext) {
 		}
 	}()
 
-	var _alt int
-
-	p.SetState(409)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(368)
-			p.Match(FqlParserIdentifier)
-		}
-		p.SetState(377)
-		p.GetErrorHandler().Sync(p)
-		_alt = 1
-		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			switch _alt {
-			case 1:
-				{
-					p.SetState(369)
-					p.Match(FqlParserDot)
-				}
-				{
-					p.SetState(370)
-					p.PropertyName()
-				}
-				p.SetState(374)
-				p.GetErrorHandler().Sync(p)
-				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
-
-				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-					if _alt == 1 {
-						{
-							p.SetState(371)
-							p.ComputedPropertyName()
-						}
-
-					}
-					p.SetState(376)
-					p.GetErrorHandler().Sync(p)
-					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
-				}
-
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
-
-			p.SetState(379)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext())
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(381)
-			p.Match(FqlParserIdentifier)
-		}
-		{
-			p.SetState(382)
-			p.ComputedPropertyName()
-		}
-		p.SetState(393)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext())
-
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				{
-					p.SetState(383)
-					p.Match(FqlParserDot)
-				}
-				{
-					p.SetState(384)
-					p.PropertyName()
-				}
-				p.SetState(388)
-				p.GetErrorHandler().Sync(p)
-				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
-
-				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-					if _alt == 1 {
-						{
-							p.SetState(385)
-							p.ComputedPropertyName()
-						}
-
-					}
-					p.SetState(390)
-					p.GetErrorHandler().Sync(p)
-					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
-				}
-
-			}
-			p.SetState(395)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext())
-		}
-		p.SetState(406)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext())
-
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				{
-					p.SetState(396)
-					p.ComputedPropertyName()
-				}
-				p.SetState(401)
-				p.GetErrorHandler().Sync(p)
-				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
-
-				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-					if _alt == 1 {
-						{
-							p.SetState(397)
-							p.Match(FqlParserDot)
-						}
-						{
-							p.SetState(398)
-							p.PropertyName()
-						}
-
-					}
-					p.SetState(403)
-					p.GetErrorHandler().Sync(p)
-					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext())
-				}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(410)
+		_la = p.GetTokenStream().LA(1)
 
-			}
-			p.SetState(408)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext())
+		if !(((_la-69)&-(0x1f+1)) == 0 && ((1<<uint((_la-69)))&((1<<(FqlParserStringLiteral-69))|(1<<(FqlParserTemplateStringLiteral-69))|(1<<(FqlParserRawStringLiteral-69)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
 	}
 
 	return localctx
 }
 
-// IShorthandPropertyNameContext is an interface to support dynamic dispatch.
-type IShorthandPropertyNameContext interface {
+// IIntegerLiteralContext is an interface to support dynamic dispatch.
+type IIntegerLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShorthandPropertyNameContext differentiates from other interfaces.
-	IsShorthandPropertyNameContext()
+	// IsIntegerLiteralContext differentiates from other interfaces.
+	IsIntegerLiteralContext()
 }
 
-type ShorthandPropertyNameContext struct {
+type IntegerLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShorthandPropertyNameContext() *ShorthandPropertyNameContext {
-	var p = new(ShorthandPropertyNameContext)
+func NewEmptyIntegerLiteralContext() *IntegerLiteralContext {
+	var p = new(IntegerLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_shorthandPropertyName
+	p.RuleIndex = FqlParserRULE_integerLiteral
 	return p
 }
 
-func (*ShorthandPropertyNameContext) IsShorthandPropertyNameContext() {}
+func (*IntegerLiteralContext) IsIntegerLiteralContext() {}
 
-func NewShorthandPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShorthandPropertyNameContext {
-	var p = new(ShorthandPropertyNameContext)
+func NewIntegerLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IntegerLiteralContext {
+	var p = new(IntegerLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_shorthandPropertyName
+	p.RuleIndex = FqlParserRULE_integerLiteral
 
 	return p
 }
 
-func (s *ShorthandPropertyNameContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShorthandPropertyNameContext) Variable() IVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *IntegerLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IVariableContext)
+func (s *IntegerLiteralContext) IntegerLiteral() antlr.TerminalNode {
+	return s.GetToken(FqlParserIntegerLiteral, 0)
 }
 
-func (s *ShorthandPropertyNameContext) GetRuleContext() antlr.RuleContext {
+func (s *IntegerLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShorthandPropertyNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IntegerLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShorthandPropertyNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IntegerLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterShorthandPropertyName(s)
+		listenerT.EnterIntegerLiteral(s)
 	}
 }
 
-func (s *ShorthandPropertyNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IntegerLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitShorthandPropertyName(s)
+		listenerT.ExitIntegerLiteral(s)
 	}
 }
 
-func (s *ShorthandPropertyNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IntegerLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitShorthandPropertyName(s)
+		return t.VisitIntegerLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) ShorthandPropertyName() (localctx IShorthandPropertyNameContext) {
-	localctx = NewShorthandPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, FqlParserRULE_shorthandPropertyName)
+func (p *FqlParser) IntegerLiteral() (localctx IIntegerLiteralContext) {
+	localctx = NewIntegerLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, FqlParserRULE_integerLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -6311,102 +6405,88 @@ func (p *FqlParser) ShorthandPropertyName() (localctx IShorthandPropertyNameCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(411)
-		p.Variable()
+		p.SetState(412)
+		p.Match(FqlParserIntegerLiteral)
 	}
 
 	return localctx
 }
 
-// IComputedPropertyNameContext is an interface to support dynamic dispatch.
-type IComputedPropertyNameContext interface {
+// IFloatLiteralContext is an interface to support dynamic dispatch.
+type IFloatLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsComputedPropertyNameContext differentiates from other interfaces.
-	IsComputedPropertyNameContext()
+	// IsFloatLiteralContext differentiates from other interfaces.
+	IsFloatLiteralContext()
 }
 
-type ComputedPropertyNameContext struct {
+type FloatLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyComputedPropertyNameContext() *ComputedPropertyNameContext {
-	var p = new(ComputedPropertyNameContext)
+func NewEmptyFloatLiteralContext() *FloatLiteralContext {
+	var p = new(FloatLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_computedPropertyName
+	p.RuleIndex = FqlParserRULE_floatLiteral
 	return p
 }
 
-func (*ComputedPropertyNameContext) IsComputedPropertyNameContext() {}
+func (*FloatLiteralContext) IsFloatLiteralContext() {}
 
-func NewComputedPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComputedPropertyNameContext {
-	var p = new(ComputedPropertyNameContext)
+func NewFloatLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FloatLiteralContext {
+	var p = new(FloatLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_computedPropertyName
+	p.RuleIndex = FqlParserRULE_floatLiteral
 
 	return p
 }
 
-func (s *ComputedPropertyNameContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ComputedPropertyNameContext) OpenBracket() antlr.TerminalNode {
-	return s.GetToken(FqlParserOpenBracket, 0)
-}
-
-func (s *ComputedPropertyNameContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
-}
+func (s *FloatLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ComputedPropertyNameContext) CloseBracket() antlr.TerminalNode {
-	return s.GetToken(FqlParserCloseBracket, 0)
+func (s *FloatLiteralContext) FloatLiteral() antlr.TerminalNode {
+	return s.GetToken(FqlParserFloatLiteral, 0)
 }
 
-func (s *ComputedPropertyNameContext) GetRuleContext() antlr.RuleContext {
+func (s *FloatLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ComputedPropertyNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FloatLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ComputedPropertyNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FloatLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterComputedPropertyName(s)
+		listenerT.EnterFloatLiteral(s)
 	}
 }
 
-func (s *ComputedPropertyNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FloatLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitComputedPropertyName(s)
+		listenerT.ExitFloatLiteral(s)
 	}
 }
 
-func (s *ComputedPropertyNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FloatLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitComputedPropertyName(s)
+		return t.VisitFloatLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) ComputedPropertyName() (localctx IComputedPropertyNameContext) {
-	localctx = NewComputedPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, FqlParserRULE_computedPropertyName)
+func (p *FqlParser) FloatLiteral() (localctx IFloatLiteralContext) {
+	localctx = NewFloatLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, FqlParserRULE_floatLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -6425,107 +6505,94 @@ func (p *FqlParser) ComputedPropertyName() (localctx IComputedPropertyNameContex
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(413)
-		p.Match(FqlParserOpenBracket)
-	}
 	{
 		p.SetState(414)
-		p.expression(0)
-	}
-	{
-		p.SetState(415)
-		p.Match(FqlParserCloseBracket)
+		p.Match(FqlParserFloatLiteral)
 	}
 
 	return localctx
 }
 
-// IPropertyNameContext is an interface to support dynamic dispatch.
-type IPropertyNameContext interface {
+// INoneLiteralContext is an interface to support dynamic dispatch.
+type INoneLiteralContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPropertyNameContext differentiates from other interfaces.
-	IsPropertyNameContext()
+	// IsNoneLiteralContext differentiates from other interfaces.
+	IsNoneLiteralContext()
 }
 
-type PropertyNameContext struct {
+type NoneLiteralContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPropertyNameContext() *PropertyNameContext {
-	var p = new(PropertyNameContext)
+func NewEmptyNoneLiteralContext() *NoneLiteralContext {
+	var p = new(NoneLiteralContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_propertyName
+	p.RuleIndex = FqlParserRULE_noneLiteral
 	return p
 }
 
-func (*PropertyNameContext) IsPropertyNameContext() {}
+func (*NoneLiteralContext) IsNoneLiteralContext() {}
 
-func NewPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyNameContext {
-	var p = new(PropertyNameContext)
+func NewNoneLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NoneLiteralContext {
+	var p = new(NoneLiteralContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_propertyName
+	p.RuleIndex = FqlParserRULE_noneLiteral
 
 	return p
 }
 
-func (s *PropertyNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *NoneLiteralContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PropertyNameContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *NoneLiteralContext) Null() antlr.TerminalNode {
+	return s.GetToken(FqlParserNull, 0)
 }
 
-func (s *PropertyNameContext) StringLiteral() IStringLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStringLiteralContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStringLiteralContext)
+func (s *NoneLiteralContext) None() antlr.TerminalNode {
+	return s.GetToken(FqlParserNone, 0)
 }
 
-func (s *PropertyNameContext) GetRuleContext() antlr.RuleContext {
+func (s *NoneLiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PropertyNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NoneLiteralContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PropertyNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NoneLiteralContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterPropertyName(s)
+		listenerT.EnterNoneLiteral(s)
 	}
 }
 
-func (s *PropertyNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NoneLiteralContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitPropertyName(s)
+		listenerT.ExitNoneLiteral(s)
 	}
 }
 
-func (s *PropertyNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NoneLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitPropertyName(s)
+		return t.VisitNoneLiteral(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) PropertyName() (localctx IPropertyNameContext) {
-	localctx = NewPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, FqlParserRULE_propertyName)
+func (p *FqlParser) NoneLiteral() (localctx INoneLiteralContext) {
+	localctx = NewNoneLiteralContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, FqlParserRULE_noneLiteral)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6543,120 +6610,125 @@ func (p *FqlParser) PropertyName() (localctx IPropertyNameContext) {
 		}
 	}()
 
-	p.SetState(419)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case FqlParserIdentifier:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(417)
-			p.Match(FqlParserIdentifier)
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(416)
+		_la = p.GetTokenStream().LA(1)
 
-	case FqlParserStringLiteral, FqlParserTemplateStringLiteral:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(418)
-			p.StringLiteral()
+		if !(_la == FqlParserNone || _la == FqlParserNull) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IExpressionGroupContext is an interface to support dynamic dispatch.
-type IExpressionGroupContext interface {
+// IArrayElementListContext is an interface to support dynamic dispatch.
+type IArrayElementListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExpressionGroupContext differentiates from other interfaces.
-	IsExpressionGroupContext()
+	// IsArrayElementListContext differentiates from other interfaces.
+	IsArrayElementListContext()
 }
 
-type ExpressionGroupContext struct {
+type ArrayElementListContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionGroupContext() *ExpressionGroupContext {
-	var p = new(ExpressionGroupContext)
+func NewEmptyArrayElementListContext() *ArrayElementListContext {
+	var p = new(ArrayElementListContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_expressionGroup
+	p.RuleIndex = FqlParserRULE_arrayElementList
 	return p
 }
 
-func (*ExpressionGroupContext) IsExpressionGroupContext() {}
+func (*ArrayElementListContext) IsArrayElementListContext() {}
 
-func NewExpressionGroupContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionGroupContext {
-	var p = new(ExpressionGroupContext)
+func NewArrayElementListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayElementListContext {
+	var p = new(ArrayElementListContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_expressionGroup
+	p.RuleIndex = FqlParserRULE_arrayElementList
 
 	return p
 }
 
-func (s *ExpressionGroupContext) GetParser() antlr.Parser { return s.parser }
+func (s *ArrayElementListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionGroupContext) OpenParen() antlr.TerminalNode {
-	return s.GetToken(FqlParserOpenParen, 0)
+func (s *ArrayElementListContext) AllArrayElement() []IArrayElementContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IArrayElementContext)(nil)).Elem())
+	var tst = make([]IArrayElementContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IArrayElementContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *ExpressionGroupContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *ArrayElementListContext) ArrayElement(i int) IArrayElementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayElementContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IArrayElementContext)
 }
 
-func (s *ExpressionGroupContext) CloseParen() antlr.TerminalNode {
-	return s.GetToken(FqlParserCloseParen, 0)
+func (s *ArrayElementListContext) AllComma() []antlr.TerminalNode {
+	return s.GetTokens(FqlParserComma)
 }
 
-func (s *ExpressionGroupContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayElementListContext) Comma(i int) antlr.TerminalNode {
+	return s.GetToken(FqlParserComma, i)
+}
+
+func (s *ArrayElementListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionGroupContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrayElementListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionGroupContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayElementListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterExpressionGroup(s)
+		listenerT.EnterArrayElementList(s)
 	}
 }
 
-func (s *ExpressionGroupContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayElementListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitExpressionGroup(s)
+		listenerT.ExitArrayElementList(s)
 	}
 }
 
-func (s *ExpressionGroupContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayElementListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitExpressionGroup(s)
+		return t.VisitArrayElementList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) ExpressionGroup() (localctx IExpressionGroupContext) {
-	localctx = NewExpressionGroupContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, FqlParserRULE_expressionGroup)
+func (p *FqlParser) ArrayElementList() (localctx IArrayElementListContext) {
+	localctx = NewArrayElementListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, FqlParserRULE_arrayElementList)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6676,100 +6748,126 @@ func (p *FqlParser) ExpressionGroup() (localctx IExpressionGroupContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(421)
-		p.Match(FqlParserOpenParen)
-	}
-	{
-		p.SetState(422)
-		p.expression(0)
+		p.SetState(418)
+		p.ArrayElement()
 	}
-	{
-		p.SetState(423)
-		p.Match(FqlParserCloseParen)
+	p.SetState(427)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FqlParserComma {
+		p.SetState(420)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == FqlParserComma {
+			{
+				p.SetState(419)
+				p.Match(FqlParserComma)
+			}
+
+			p.SetState(422)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(424)
+			p.ArrayElement()
+		}
+
+		p.SetState(429)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// INamespaceContext is an interface to support dynamic dispatch.
-type INamespaceContext interface {
+// IArrayElementContext is an interface to support dynamic dispatch.
+type IArrayElementContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsNamespaceContext differentiates from other interfaces.
-	IsNamespaceContext()
+	// IsArrayElementContext differentiates from other interfaces.
+	IsArrayElementContext()
 }
 
-type NamespaceContext struct {
+type ArrayElementContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamespaceContext() *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewEmptyArrayElementContext() *ArrayElementContext {
+	var p = new(ArrayElementContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_namespace
+	p.RuleIndex = FqlParserRULE_arrayElement
 	return p
 }
 
-func (*NamespaceContext) IsNamespaceContext() {}
+func (*ArrayElementContext) IsArrayElementContext() {}
 
-func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewArrayElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayElementContext {
+	var p = new(ArrayElementContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_namespace
+	p.RuleIndex = FqlParserRULE_arrayElement
 
 	return p
 }
 
-func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+func (s *ArrayElementContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NamespaceContext) AllNamespaceSegment() []antlr.TerminalNode {
-	return s.GetTokens(FqlParserNamespaceSegment)
+func (s *ArrayElementContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
 }
 
-func (s *NamespaceContext) NamespaceSegment(i int) antlr.TerminalNode {
-	return s.GetToken(FqlParserNamespaceSegment, i)
+func (s *ArrayElementContext) Ellipsis() antlr.TerminalNode {
+	return s.GetToken(FqlParserEllipsis, 0)
 }
 
-func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayElementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrayElementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayElementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterNamespace(s)
+		listenerT.EnterArrayElement(s)
 	}
 }
 
-func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayElementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitNamespace(s)
+		listenerT.ExitArrayElement(s)
 	}
 }
 
-func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayElementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitNamespace(s)
+		return t.VisitArrayElement(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) Namespace() (localctx INamespaceContext) {
-	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, FqlParserRULE_namespace)
+func (p *FqlParser) ArrayElement() (localctx IArrayElementContext) {
+	localctx = NewArrayElementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, FqlParserRULE_arrayElement)
 	var _la int
 
 	defer func() {
@@ -6789,119 +6887,144 @@ func (p *FqlParser) Namespace() (localctx INamespaceContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(428)
+	p.SetState(431)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == FqlParserNamespaceSegment {
+	if _la == FqlParserEllipsis {
 		{
-			p.SetState(425)
-			p.Match(FqlParserNamespaceSegment)
+			p.SetState(430)
+			p.Match(FqlParserEllipsis)
 		}
 
-		p.SetState(430)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(433)
+		p.expression(0)
 	}
 
 	return localctx
 }
 
-// IFunctionCallExpressionContext is an interface to support dynamic dispatch.
-type IFunctionCallExpressionContext interface {
+// IPropertyAssignmentContext is an interface to support dynamic dispatch.
+type IPropertyAssignmentContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFunctionCallExpressionContext differentiates from other interfaces.
-	IsFunctionCallExpressionContext()
+	// IsPropertyAssignmentContext differentiates from other interfaces.
+	IsPropertyAssignmentContext()
 }
 
-type FunctionCallExpressionContext struct {
+type PropertyAssignmentContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionCallExpressionContext() *FunctionCallExpressionContext {
-	var p = new(FunctionCallExpressionContext)
+func NewEmptyPropertyAssignmentContext() *PropertyAssignmentContext {
+	var p = new(PropertyAssignmentContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_functionCallExpression
+	p.RuleIndex = FqlParserRULE_propertyAssignment
 	return p
 }
 
-func (*FunctionCallExpressionContext) IsFunctionCallExpressionContext() {}
+func (*PropertyAssignmentContext) IsPropertyAssignmentContext() {}
 
-func NewFunctionCallExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallExpressionContext {
-	var p = new(FunctionCallExpressionContext)
+func NewPropertyAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyAssignmentContext {
+	var p = new(PropertyAssignmentContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_functionCallExpression
+	p.RuleIndex = FqlParserRULE_propertyAssignment
 
 	return p
 }
 
-func (s *FunctionCallExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *PropertyAssignmentContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionCallExpressionContext) Namespace() INamespaceContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamespaceContext)(nil)).Elem(), 0)
+func (s *PropertyAssignmentContext) PropertyName() IPropertyNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyNameContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(INamespaceContext)
+	return t.(IPropertyNameContext)
 }
 
-func (s *FunctionCallExpressionContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(FqlParserIdentifier, 0)
+func (s *PropertyAssignmentContext) Colon() antlr.TerminalNode {
+	return s.GetToken(FqlParserColon, 0)
 }
 
-func (s *FunctionCallExpressionContext) Arguments() IArgumentsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArgumentsContext)(nil)).Elem(), 0)
+func (s *PropertyAssignmentContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IArgumentsContext)
+	return t.(IExpressionContext)
 }
 
-func (s *FunctionCallExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *PropertyAssignmentContext) ComputedPropertyName() IComputedPropertyNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IComputedPropertyNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IComputedPropertyNameContext)
+}
+
+func (s *PropertyAssignmentContext) ShorthandPropertyName() IShorthandPropertyNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShorthandPropertyNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShorthandPropertyNameContext)
+}
+
+func (s *PropertyAssignmentContext) Ellipsis() antlr.TerminalNode {
+	return s.GetToken(FqlParserEllipsis, 0)
+}
+
+func (s *PropertyAssignmentContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionCallExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PropertyAssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionCallExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PropertyAssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterFunctionCallExpression(s)
+		listenerT.EnterPropertyAssignment(s)
 	}
 }
 
-func (s *FunctionCallExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PropertyAssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitFunctionCallExpression(s)
+		listenerT.ExitPropertyAssignment(s)
 	}
 }
 
-func (s *FunctionCallExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PropertyAssignmentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitFunctionCallExpression(s)
+		return t.VisitPropertyAssignment(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) FunctionCallExpression() (localctx IFunctionCallExpressionContext) {
-	localctx = NewFunctionCallExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, FqlParserRULE_functionCallExpression)
+func (p *FqlParser) PropertyAssignment() (localctx IPropertyAssignmentContext) {
+	localctx = NewPropertyAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, FqlParserRULE_propertyAssignment)
 
 	defer func() {
 		p.ExitRule()
@@ -6919,134 +7042,197 @@ func (p *FqlParser) FunctionCallExpression() (localctx IFunctionCallExpressionCo
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(431)
-		p.Namespace()
-	}
-	{
-		p.SetState(432)
-		p.Match(FqlParserIdentifier)
-	}
-	{
-		p.SetState(433)
-		p.Arguments()
+	p.SetState(446)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(435)
+			p.PropertyName()
+		}
+		{
+			p.SetState(436)
+			p.Match(FqlParserColon)
+		}
+		{
+			p.SetState(437)
+			p.expression(0)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(439)
+			p.ComputedPropertyName()
+		}
+		{
+			p.SetState(440)
+			p.Match(FqlParserColon)
+		}
+		{
+			p.SetState(441)
+			p.expression(0)
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(443)
+			p.ShorthandPropertyName()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(444)
+			p.Match(FqlParserEllipsis)
+		}
+		{
+			p.SetState(445)
+			p.expression(0)
+		}
+
 	}
 
 	return localctx
 }
 
-// IArgumentsContext is an interface to support dynamic dispatch.
-type IArgumentsContext interface {
+// IMemberExpressionContext is an interface to support dynamic dispatch.
+type IMemberExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsArgumentsContext differentiates from other interfaces.
-	IsArgumentsContext()
+	// IsMemberExpressionContext differentiates from other interfaces.
+	IsMemberExpressionContext()
 }
 
-type ArgumentsContext struct {
+type MemberExpressionContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArgumentsContext() *ArgumentsContext {
-	var p = new(ArgumentsContext)
+func NewEmptyMemberExpressionContext() *MemberExpressionContext {
+	var p = new(MemberExpressionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_arguments
+	p.RuleIndex = FqlParserRULE_memberExpression
 	return p
 }
 
-func (*ArgumentsContext) IsArgumentsContext() {}
+func (*MemberExpressionContext) IsMemberExpressionContext() {}
 
-func NewArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentsContext {
-	var p = new(ArgumentsContext)
+func NewMemberExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MemberExpressionContext {
+	var p = new(MemberExpressionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_arguments
+	p.RuleIndex = FqlParserRULE_memberExpression
 
 	return p
 }
 
-func (s *ArgumentsContext) GetParser() antlr.Parser { return s.parser }
+func (s *MemberExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArgumentsContext) OpenParen() antlr.TerminalNode {
-	return s.GetToken(FqlParserOpenParen, 0)
+func (s *MemberExpressionContext) Identifier() IIdentifierContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
 }
 
-func (s *ArgumentsContext) CloseParen() antlr.TerminalNode {
-	return s.GetToken(FqlParserCloseParen, 0)
+func (s *MemberExpressionContext) AllDot() []antlr.TerminalNode {
+	return s.GetTokens(FqlParserDot)
 }
 
-func (s *ArgumentsContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+func (s *MemberExpressionContext) Dot(i int) antlr.TerminalNode {
+	return s.GetToken(FqlParserDot, i)
+}
+
+func (s *MemberExpressionContext) AllPropertyName() []IPropertyNameContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPropertyNameContext)(nil)).Elem())
+	var tst = make([]IPropertyNameContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExpressionContext)
+			tst[i] = t.(IPropertyNameContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *ArgumentsContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *MemberExpressionContext) PropertyName(i int) IPropertyNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyNameContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IPropertyNameContext)
 }
 
-func (s *ArgumentsContext) AllComma() []antlr.TerminalNode {
-	return s.GetTokens(FqlParserComma)
+func (s *MemberExpressionContext) AllComputedPropertyName() []IComputedPropertyNameContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IComputedPropertyNameContext)(nil)).Elem())
+	var tst = make([]IComputedPropertyNameContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IComputedPropertyNameContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *ArgumentsContext) Comma(i int) antlr.TerminalNode {
-	return s.GetToken(FqlParserComma, i)
+func (s *MemberExpressionContext) ComputedPropertyName(i int) IComputedPropertyNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IComputedPropertyNameContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IComputedPropertyNameContext)
 }
 
-func (s *ArgumentsContext) GetRuleContext() antlr.RuleContext {
+func (s *MemberExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArgumentsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MemberExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArgumentsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MemberExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterArguments(s)
+		listenerT.EnterMemberExpression(s)
 	}
 }
 
-func (s *ArgumentsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MemberExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitArguments(s)
+		listenerT.ExitMemberExpression(s)
 	}
 }
 
-func (s *ArgumentsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MemberExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitArguments(s)
+		return t.VisitMemberExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) Arguments() (localctx IArgumentsContext) {
-	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, FqlParserRULE_arguments)
-	var _la int
+func (p *FqlParser) MemberExpression() (localctx IMemberExpressionContext) {
+	localctx = NewMemberExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, FqlParserRULE_memberExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -7064,672 +7250,2701 @@ func (p *FqlParser) Arguments() (localctx IArgumentsContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(435)
-		p.Match(FqlParserOpenParen)
-	}
-	p.SetState(444)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	var _alt int
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
+	p.SetState(489)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(436)
-			p.expression(0)
+			p.SetState(448)
+			p.Identifier()
 		}
-		p.SetState(441)
+		p.SetState(457)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				{
+					p.SetState(449)
+					p.Match(FqlParserDot)
+				}
+				{
+					p.SetState(450)
+					p.PropertyName()
+				}
+				p.SetState(454)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext())
 
-		for _la == FqlParserComma {
-			{
-				p.SetState(437)
-				p.Match(FqlParserComma)
+				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+					if _alt == 1 {
+						{
+							p.SetState(451)
+							p.ComputedPropertyName()
+						}
+
+					}
+					p.SetState(456)
+					p.GetErrorHandler().Sync(p)
+					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext())
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(459)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext())
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(461)
+			p.Identifier()
+		}
+		{
+			p.SetState(462)
+			p.ComputedPropertyName()
+		}
+		p.SetState(473)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(463)
+					p.Match(FqlParserDot)
+				}
+				{
+					p.SetState(464)
+					p.PropertyName()
+				}
+				p.SetState(468)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext())
+
+				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+					if _alt == 1 {
+						{
+							p.SetState(465)
+							p.ComputedPropertyName()
+						}
+
+					}
+					p.SetState(470)
+					p.GetErrorHandler().Sync(p)
+					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext())
+				}
+
+			}
+			p.SetState(475)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext())
+		}
+		p.SetState(486)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(476)
+					p.ComputedPropertyName()
+				}
+				p.SetState(481)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+
+				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+					if _alt == 1 {
+						{
+							p.SetState(477)
+							p.Match(FqlParserDot)
+						}
+						{
+							p.SetState(478)
+							p.PropertyName()
+						}
+
+					}
+					p.SetState(483)
+					p.GetErrorHandler().Sync(p)
+					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+				}
+
+			}
+			p.SetState(488)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext())
+		}
+
+	}
+
+	return localctx
+}
+
+// IShorthandPropertyNameContext is an interface to support dynamic dispatch.
+type IShorthandPropertyNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShorthandPropertyNameContext differentiates from other interfaces.
+	IsShorthandPropertyNameContext()
+}
+
+type ShorthandPropertyNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShorthandPropertyNameContext() *ShorthandPropertyNameContext {
+	var p = new(ShorthandPropertyNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_shorthandPropertyName
+	return p
+}
+
+func (*ShorthandPropertyNameContext) IsShorthandPropertyNameContext() {}
+
+func NewShorthandPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShorthandPropertyNameContext {
+	var p = new(ShorthandPropertyNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_shorthandPropertyName
+
+	return p
+}
+
+func (s *ShorthandPropertyNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShorthandPropertyNameContext) Variable() IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableContext)
+}
+
+func (s *ShorthandPropertyNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShorthandPropertyNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShorthandPropertyNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterShorthandPropertyName(s)
+	}
+}
+
+func (s *ShorthandPropertyNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitShorthandPropertyName(s)
+	}
+}
+
+func (s *ShorthandPropertyNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitShorthandPropertyName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) ShorthandPropertyName() (localctx IShorthandPropertyNameContext) {
+	localctx = NewShorthandPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, FqlParserRULE_shorthandPropertyName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(491)
+		p.Variable()
+	}
+
+	return localctx
+}
+
+// IComputedPropertyNameContext is an interface to support dynamic dispatch.
+type IComputedPropertyNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsComputedPropertyNameContext differentiates from other interfaces.
+	IsComputedPropertyNameContext()
+}
+
+type ComputedPropertyNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyComputedPropertyNameContext() *ComputedPropertyNameContext {
+	var p = new(ComputedPropertyNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_computedPropertyName
+	return p
+}
+
+func (*ComputedPropertyNameContext) IsComputedPropertyNameContext() {}
+
+func NewComputedPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComputedPropertyNameContext {
+	var p = new(ComputedPropertyNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_computedPropertyName
+
+	return p
+}
+
+func (s *ComputedPropertyNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ComputedPropertyNameContext) OpenBracket() antlr.TerminalNode {
+	return s.GetToken(FqlParserOpenBracket, 0)
+}
+
+func (s *ComputedPropertyNameContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ComputedPropertyNameContext) CloseBracket() antlr.TerminalNode {
+	return s.GetToken(FqlParserCloseBracket, 0)
+}
+
+func (s *ComputedPropertyNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ComputedPropertyNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ComputedPropertyNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterComputedPropertyName(s)
+	}
+}
+
+func (s *ComputedPropertyNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitComputedPropertyName(s)
+	}
+}
+
+func (s *ComputedPropertyNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitComputedPropertyName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) ComputedPropertyName() (localctx IComputedPropertyNameContext) {
+	localctx = NewComputedPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, FqlParserRULE_computedPropertyName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(493)
+		p.Match(FqlParserOpenBracket)
+	}
+	{
+		p.SetState(494)
+		p.expression(0)
+	}
+	{
+		p.SetState(495)
+		p.Match(FqlParserCloseBracket)
+	}
+
+	return localctx
+}
+
+// IPropertyNameContext is an interface to support dynamic dispatch.
+type IPropertyNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPropertyNameContext differentiates from other interfaces.
+	IsPropertyNameContext()
+}
+
+type PropertyNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPropertyNameContext() *PropertyNameContext {
+	var p = new(PropertyNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_propertyName
+	return p
+}
+
+func (*PropertyNameContext) IsPropertyNameContext() {}
+
+func NewPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyNameContext {
+	var p = new(PropertyNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_propertyName
+
+	return p
+}
+
+func (s *PropertyNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PropertyNameContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(FqlParserIdentifier, 0)
+}
+
+func (s *PropertyNameContext) StringLiteral() IStringLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStringLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *PropertyNameContext) ReservedWord() IReservedWordContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReservedWordContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReservedWordContext)
+}
+
+func (s *PropertyNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PropertyNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PropertyNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterPropertyName(s)
+	}
+}
+
+func (s *PropertyNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitPropertyName(s)
+	}
+}
+
+func (s *PropertyNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitPropertyName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) PropertyName() (localctx IPropertyNameContext) {
+	localctx = NewPropertyNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, FqlParserRULE_propertyName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(500)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case FqlParserIdentifier:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(497)
+			p.Match(FqlParserIdentifier)
+		}
+
+	case FqlParserStringLiteral, FqlParserTemplateStringLiteral, FqlParserRawStringLiteral:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(498)
+			p.StringLiteral()
+		}
+
+	case FqlParserFor, FqlParserReturn, FqlParserDistinct, FqlParserFilter, FqlParserSort, FqlParserLimit, FqlParserLet, FqlParserCollect, FqlParserSortDirection, FqlParserNone, FqlParserNull, FqlParserBooleanLiteral, FqlParserNulls, FqlParserCollate, FqlParserInto, FqlParserKeep, FqlParserWith, FqlParserCount, FqlParserAll, FqlParserAny, FqlParserAggregate, FqlParserWindow, FqlParserPartition, FqlParserSwitch, FqlParserWhen, FqlParserCase, FqlParserDefault, FqlParserEnd, FqlParserLike, FqlParserIn:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(499)
+			p.ReservedWord()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IReservedWordContext is an interface to support dynamic dispatch.
+type IReservedWordContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsReservedWordContext differentiates from other interfaces.
+	IsReservedWordContext()
+}
+
+type ReservedWordContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyReservedWordContext() *ReservedWordContext {
+	var p = new(ReservedWordContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_reservedWord
+	return p
+}
+
+func (*ReservedWordContext) IsReservedWordContext() {}
+
+func NewReservedWordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReservedWordContext {
+	var p = new(ReservedWordContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_reservedWord
+
+	return p
+}
+
+func (s *ReservedWordContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ReservedWordContext) For() antlr.TerminalNode {
+	return s.GetToken(FqlParserFor, 0)
+}
+
+func (s *ReservedWordContext) Return() antlr.TerminalNode {
+	return s.GetToken(FqlParserReturn, 0)
+}
+
+func (s *ReservedWordContext) Distinct() antlr.TerminalNode {
+	return s.GetToken(FqlParserDistinct, 0)
+}
+
+func (s *ReservedWordContext) Filter() antlr.TerminalNode {
+	return s.GetToken(FqlParserFilter, 0)
+}
+
+func (s *ReservedWordContext) Sort() antlr.TerminalNode {
+	return s.GetToken(FqlParserSort, 0)
+}
+
+func (s *ReservedWordContext) Limit() antlr.TerminalNode {
+	return s.GetToken(FqlParserLimit, 0)
+}
+
+func (s *ReservedWordContext) Let() antlr.TerminalNode {
+	return s.GetToken(FqlParserLet, 0)
+}
+
+func (s *ReservedWordContext) Collect() antlr.TerminalNode {
+	return s.GetToken(FqlParserCollect, 0)
+}
+
+func (s *ReservedWordContext) SortDirection() antlr.TerminalNode {
+	return s.GetToken(FqlParserSortDirection, 0)
+}
+
+func (s *ReservedWordContext) Nulls() antlr.TerminalNode {
+	return s.GetToken(FqlParserNulls, 0)
+}
+
+func (s *ReservedWordContext) Collate() antlr.TerminalNode {
+	return s.GetToken(FqlParserCollate, 0)
+}
+
+func (s *ReservedWordContext) None() antlr.TerminalNode {
+	return s.GetToken(FqlParserNone, 0)
+}
+
+func (s *ReservedWordContext) Null() antlr.TerminalNode {
+	return s.GetToken(FqlParserNull, 0)
+}
+
+func (s *ReservedWordContext) BooleanLiteral() antlr.TerminalNode {
+	return s.GetToken(FqlParserBooleanLiteral, 0)
+}
+
+func (s *ReservedWordContext) Into() antlr.TerminalNode {
+	return s.GetToken(FqlParserInto, 0)
+}
+
+func (s *ReservedWordContext) Keep() antlr.TerminalNode {
+	return s.GetToken(FqlParserKeep, 0)
+}
+
+func (s *ReservedWordContext) With() antlr.TerminalNode {
+	return s.GetToken(FqlParserWith, 0)
+}
+
+func (s *ReservedWordContext) Count() antlr.TerminalNode {
+	return s.GetToken(FqlParserCount, 0)
+}
+
+func (s *ReservedWordContext) All() antlr.TerminalNode {
+	return s.GetToken(FqlParserAll, 0)
+}
+
+func (s *ReservedWordContext) Any() antlr.TerminalNode {
+	return s.GetToken(FqlParserAny, 0)
+}
+
+func (s *ReservedWordContext) Aggregate() antlr.TerminalNode {
+	return s.GetToken(FqlParserAggregate, 0)
+}
+
+func (s *ReservedWordContext) Window() antlr.TerminalNode {
+	return s.GetToken(FqlParserWindow, 0)
+}
+
+func (s *ReservedWordContext) Partition() antlr.TerminalNode {
+	return s.GetToken(FqlParserPartition, 0)
+}
+
+func (s *ReservedWordContext) Switch() antlr.TerminalNode {
+	return s.GetToken(FqlParserSwitch, 0)
+}
+
+func (s *ReservedWordContext) When() antlr.TerminalNode {
+	return s.GetToken(FqlParserWhen, 0)
+}
+
+func (s *ReservedWordContext) Case() antlr.TerminalNode {
+	return s.GetToken(FqlParserCase, 0)
+}
+
+func (s *ReservedWordContext) Default() antlr.TerminalNode {
+	return s.GetToken(FqlParserDefault, 0)
+}
+
+func (s *ReservedWordContext) End() antlr.TerminalNode {
+	return s.GetToken(FqlParserEnd, 0)
+}
+
+func (s *ReservedWordContext) Like() antlr.TerminalNode {
+	return s.GetToken(FqlParserLike, 0)
+}
+
+func (s *ReservedWordContext) In() antlr.TerminalNode {
+	return s.GetToken(FqlParserIn, 0)
+}
+
+func (s *ReservedWordContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReservedWordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ReservedWordContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterReservedWord(s)
+	}
+}
+
+func (s *ReservedWordContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitReservedWord(s)
+	}
+}
+
+func (s *ReservedWordContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitReservedWord(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) ReservedWord() (localctx IReservedWordContext) {
+	localctx = NewReservedWordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, FqlParserRULE_reservedWord)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(502)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la-36)&-(0x1f+1)) == 0 && ((1<<uint((_la-36)))&((1<<(FqlParserFor-36))|(1<<(FqlParserReturn-36))|(1<<(FqlParserDistinct-36))|(1<<(FqlParserFilter-36))|(1<<(FqlParserSort-36))|(1<<(FqlParserLimit-36))|(1<<(FqlParserLet-36))|(1<<(FqlParserCollect-36))|(1<<(FqlParserSortDirection-36))|(1<<(FqlParserNone-36))|(1<<(FqlParserNull-36))|(1<<(FqlParserBooleanLiteral-36))|(1<<(FqlParserNulls-36))|(1<<(FqlParserCollate-36))|(1<<(FqlParserInto-36))|(1<<(FqlParserKeep-36))|(1<<(FqlParserWith-36))|(1<<(FqlParserCount-36))|(1<<(FqlParserAll-36))|(1<<(FqlParserAny-36))|(1<<(FqlParserAggregate-36))|(1<<(FqlParserWindow-36))|(1<<(FqlParserPartition-36))|(1<<(FqlParserSwitch-36))|(1<<(FqlParserWhen-36))|(1<<(FqlParserCase-36))|(1<<(FqlParserDefault-36))|(1<<(FqlParserEnd-36))|(1<<(FqlParserLike-36))|(1<<(FqlParserIn-36)))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// IExpressionGroupContext is an interface to support dynamic dispatch.
+type IExpressionGroupContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExpressionGroupContext differentiates from other interfaces.
+	IsExpressionGroupContext()
+}
+
+type ExpressionGroupContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionGroupContext() *ExpressionGroupContext {
+	var p = new(ExpressionGroupContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_expressionGroup
+	return p
+}
+
+func (*ExpressionGroupContext) IsExpressionGroupContext() {}
+
+func NewExpressionGroupContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionGroupContext {
+	var p = new(ExpressionGroupContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_expressionGroup
+
+	return p
+}
+
+func (s *ExpressionGroupContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionGroupContext) OpenParen() antlr.TerminalNode {
+	return s.GetToken(FqlParserOpenParen, 0)
+}
+
+func (s *ExpressionGroupContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ExpressionGroupContext) CloseParen() antlr.TerminalNode {
+	return s.GetToken(FqlParserCloseParen, 0)
+}
+
+func (s *ExpressionGroupContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionGroupContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExpressionGroupContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterExpressionGroup(s)
+	}
+}
+
+func (s *ExpressionGroupContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitExpressionGroup(s)
+	}
+}
+
+func (s *ExpressionGroupContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitExpressionGroup(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) ExpressionGroup() (localctx IExpressionGroupContext) {
+	localctx = NewExpressionGroupContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, FqlParserRULE_expressionGroup)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(504)
+		p.Match(FqlParserOpenParen)
+	}
+	{
+		p.SetState(505)
+		p.expression(0)
+	}
+	{
+		p.SetState(506)
+		p.Match(FqlParserCloseParen)
+	}
+
+	return localctx
+}
+
+// INamespaceContext is an interface to support dynamic dispatch.
+type INamespaceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNamespaceContext differentiates from other interfaces.
+	IsNamespaceContext()
+}
+
+type NamespaceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNamespaceContext() *NamespaceContext {
+	var p = new(NamespaceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_namespace
+	return p
+}
+
+func (*NamespaceContext) IsNamespaceContext() {}
+
+func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
+	var p = new(NamespaceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_namespace
+
+	return p
+}
+
+func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NamespaceContext) AllNamespaceSegment() []antlr.TerminalNode {
+	return s.GetTokens(FqlParserNamespaceSegment)
+}
+
+func (s *NamespaceContext) NamespaceSegment(i int) antlr.TerminalNode {
+	return s.GetToken(FqlParserNamespaceSegment, i)
+}
+
+func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterNamespace(s)
+	}
+}
+
+func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitNamespace(s)
+	}
+}
+
+func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitNamespace(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) Namespace() (localctx INamespaceContext) {
+	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, FqlParserRULE_namespace)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(511)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == FqlParserNamespaceSegment {
+		{
+			p.SetState(508)
+			p.Match(FqlParserNamespaceSegment)
+		}
+
+		p.SetState(513)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IFunctionCallExpressionContext is an interface to support dynamic dispatch.
+type IFunctionCallExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFunctionCallExpressionContext differentiates from other interfaces.
+	IsFunctionCallExpressionContext()
+}
+
+type FunctionCallExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFunctionCallExpressionContext() *FunctionCallExpressionContext {
+	var p = new(FunctionCallExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_functionCallExpression
+	return p
+}
+
+func (*FunctionCallExpressionContext) IsFunctionCallExpressionContext() {}
+
+func NewFunctionCallExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionCallExpressionContext {
+	var p = new(FunctionCallExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_functionCallExpression
+
+	return p
+}
+
+func (s *FunctionCallExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FunctionCallExpressionContext) Namespace() INamespaceContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamespaceContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *FunctionCallExpressionContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(FqlParserIdentifier, 0)
+}
+
+func (s *FunctionCallExpressionContext) Arguments() IArgumentsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArgumentsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgumentsContext)
+}
+
+func (s *FunctionCallExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FunctionCallExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FunctionCallExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterFunctionCallExpression(s)
+	}
+}
+
+func (s *FunctionCallExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitFunctionCallExpression(s)
+	}
+}
+
+func (s *FunctionCallExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitFunctionCallExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) FunctionCallExpression() (localctx IFunctionCallExpressionContext) {
+	localctx = NewFunctionCallExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, FqlParserRULE_functionCallExpression)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(514)
+		p.Namespace()
+	}
+	{
+		p.SetState(515)
+		p.Match(FqlParserIdentifier)
+	}
+	{
+		p.SetState(516)
+		p.Arguments()
+	}
+
+	return localctx
+}
+
+// IArgumentsContext is an interface to support dynamic dispatch.
+type IArgumentsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsArgumentsContext differentiates from other interfaces.
+	IsArgumentsContext()
+}
+
+type ArgumentsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgumentsContext() *ArgumentsContext {
+	var p = new(ArgumentsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_arguments
+	return p
+}
+
+func (*ArgumentsContext) IsArgumentsContext() {}
+
+func NewArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentsContext {
+	var p = new(ArgumentsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_arguments
+
+	return p
+}
+
+func (s *ArgumentsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentsContext) OpenParen() antlr.TerminalNode {
+	return s.GetToken(FqlParserOpenParen, 0)
+}
+
+func (s *ArgumentsContext) CloseParen() antlr.TerminalNode {
+	return s.GetToken(FqlParserCloseParen, 0)
+}
+
+func (s *ArgumentsContext) AllArgument() []IArgumentContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IArgumentContext)(nil)).Elem())
+	var tst = make([]IArgumentContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IArgumentContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ArgumentsContext) Argument(i int) IArgumentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArgumentContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgumentContext)
+}
+
+func (s *ArgumentsContext) AllComma() []antlr.TerminalNode {
+	return s.GetTokens(FqlParserComma)
+}
+
+func (s *ArgumentsContext) Comma(i int) antlr.TerminalNode {
+	return s.GetToken(FqlParserComma, i)
+}
+
+func (s *ArgumentsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgumentsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterArguments(s)
+	}
+}
+
+func (s *ArgumentsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitArguments(s)
+	}
+}
+
+func (s *ArgumentsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitArguments(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) Arguments() (localctx IArgumentsContext) {
+	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, FqlParserRULE_arguments)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(518)
+		p.Match(FqlParserOpenParen)
+	}
+	p.SetState(527)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus)|(1<<FqlParserEllipsis))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserSortDirection-44))|(1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserNulls-44))|(1<<(FqlParserCollate-44))|(1<<(FqlParserCount-44))|(1<<(FqlParserAll-44))|(1<<(FqlParserAny-44))|(1<<(FqlParserPartition-44))|(1<<(FqlParserSwitch-44))|(1<<(FqlParserWhen-44))|(1<<(FqlParserDefault-44))|(1<<(FqlParserEnd-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserRawStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
+		{
+			p.SetState(519)
+			p.Argument()
+		}
+		p.SetState(524)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == FqlParserComma {
+			{
+				p.SetState(520)
+				p.Match(FqlParserComma)
+			}
+			{
+				p.SetState(521)
+				p.Argument()
+			}
+
+			p.SetState(526)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+	{
+		p.SetState(529)
+		p.Match(FqlParserCloseParen)
+	}
+
+	return localctx
+}
+
+// IArgumentContext is an interface to support dynamic dispatch.
+type IArgumentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsArgumentContext differentiates from other interfaces.
+	IsArgumentContext()
+}
+
+type ArgumentContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgumentContext() *ArgumentContext {
+	var p = new(ArgumentContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_argument
+	return p
+}
+
+func (*ArgumentContext) IsArgumentContext() {}
+
+func NewArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentContext {
+	var p = new(ArgumentContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_argument
+
+	return p
+}
+
+func (s *ArgumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ArgumentContext) Ellipsis() antlr.TerminalNode {
+	return s.GetToken(FqlParserEllipsis, 0)
+}
+
+func (s *ArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArgumentContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterArgument(s)
+	}
+}
+
+func (s *ArgumentContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitArgument(s)
+	}
+}
+
+func (s *ArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) Argument() (localctx IArgumentContext) {
+	localctx = NewArgumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, FqlParserRULE_argument)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(532)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FqlParserEllipsis {
+		{
+			p.SetState(531)
+			p.Match(FqlParserEllipsis)
+		}
+
+	}
+	{
+		p.SetState(534)
+		p.expression(0)
+	}
+
+	return localctx
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_expression
+	return p
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) UnaryOperator() IUnaryOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUnaryOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnaryOperatorContext)
+}
+
+func (s *ExpressionContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExpressionContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ExpressionContext) FunctionCallExpression() IFunctionCallExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionCallExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionCallExpressionContext)
+}
+
+func (s *ExpressionContext) ExpressionGroup() IExpressionGroupContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionGroupContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionGroupContext)
+}
+
+func (s *ExpressionContext) SwitchExpression() ISwitchExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchExpressionContext)
+}
+
+func (s *ExpressionContext) WhenExpression() IWhenExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWhenExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhenExpressionContext)
+}
+
+func (s *ExpressionContext) RangeOperator() IRangeOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRangeOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRangeOperatorContext)
+}
+
+func (s *ExpressionContext) StringLiteral() IStringLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStringLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStringLiteralContext)
+}
+
+func (s *ExpressionContext) IntegerLiteral() IIntegerLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIntegerLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIntegerLiteralContext)
+}
+
+func (s *ExpressionContext) FloatLiteral() IFloatLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFloatLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFloatLiteralContext)
+}
+
+func (s *ExpressionContext) BooleanLiteral() IBooleanLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBooleanLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBooleanLiteralContext)
+}
+
+func (s *ExpressionContext) ArrayLiteral() IArrayLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArrayLiteralContext)
+}
+
+func (s *ExpressionContext) ObjectLiteral() IObjectLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IObjectLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IObjectLiteralContext)
+}
+
+func (s *ExpressionContext) Variable() IVariableContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVariableContext)
+}
+
+func (s *ExpressionContext) MemberExpression() IMemberExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMemberExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMemberExpressionContext)
+}
+
+func (s *ExpressionContext) NoneLiteral() INoneLiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INoneLiteralContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INoneLiteralContext)
+}
+
+func (s *ExpressionContext) Param() IParamContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParamContext)
+}
+
+func (s *ExpressionContext) MultiplicativeOperator() IMultiplicativeOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMultiplicativeOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMultiplicativeOperatorContext)
+}
+
+func (s *ExpressionContext) AdditiveOperator() IAdditiveOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAdditiveOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAdditiveOperatorContext)
+}
+
+func (s *ExpressionContext) ArrayOperator() IArrayOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArrayOperatorContext)
+}
+
+func (s *ExpressionContext) InOperator() IInOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IInOperatorContext)
+}
+
+func (s *ExpressionContext) EqualityOperator() IEqualityOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEqualityOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEqualityOperatorContext)
+}
+
+func (s *ExpressionContext) LogicalAndOperator() ILogicalAndOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILogicalAndOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILogicalAndOperatorContext)
+}
+
+func (s *ExpressionContext) LogicalOrOperator() ILogicalOrOperatorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILogicalOrOperatorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILogicalOrOperatorContext)
+}
+
+func (s *ExpressionContext) QuestionMark() antlr.TerminalNode {
+	return s.GetToken(FqlParserQuestionMark, 0)
+}
+
+func (s *ExpressionContext) Colon() antlr.TerminalNode {
+	return s.GetToken(FqlParserColon, 0)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterExpression(s)
+	}
+}
+
+func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitExpression(s)
+	}
+}
+
+func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) Expression() (localctx IExpressionContext) {
+	return p.expression(0)
+}
+
+func (p *FqlParser) expression(_p int) (localctx IExpressionContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExpressionContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 110
+	p.EnterRecursionRule(localctx, 110, FqlParserRULE_expression, _p)
+	var _la int
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(555)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(537)
+			p.UnaryOperator()
+		}
+		{
+			p.SetState(538)
+			p.expression(24)
+		}
+
+	case 2:
+		{
+			p.SetState(540)
+			p.FunctionCallExpression()
+		}
+
+	case 3:
+		{
+			p.SetState(541)
+			p.ExpressionGroup()
+		}
+
+	case 4:
+		{
+			p.SetState(542)
+			p.SwitchExpression()
+		}
+
+	case 5:
+		{
+			p.SetState(543)
+			p.WhenExpression()
+		}
+
+	case 6:
+		{
+			p.SetState(544)
+			p.RangeOperator()
+		}
+
+	case 7:
+		{
+			p.SetState(545)
+			p.StringLiteral()
+		}
+
+	case 8:
+		{
+			p.SetState(546)
+			p.IntegerLiteral()
+		}
+
+	case 9:
+		{
+			p.SetState(547)
+			p.FloatLiteral()
+		}
+
+	case 10:
+		{
+			p.SetState(548)
+			p.BooleanLiteral()
+		}
+
+	case 11:
+		{
+			p.SetState(549)
+			p.ArrayLiteral()
+		}
+
+	case 12:
+		{
+			p.SetState(550)
+			p.ObjectLiteral()
+		}
+
+	case 13:
+		{
+			p.SetState(551)
+			p.Variable()
+		}
+
+	case 14:
+		{
+			p.SetState(552)
+			p.MemberExpression()
+		}
+
+	case 15:
+		{
+			p.SetState(553)
+			p.NoneLiteral()
+		}
+
+	case 16:
+		{
+			p.SetState(554)
+			p.Param()
+		}
+
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(598)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
 			}
-			{
-				p.SetState(438)
-				p.expression(0)
+			_prevctx = localctx
+			p.SetState(596)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(557)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 23)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 23)", ""))
+				}
+				{
+					p.SetState(558)
+					p.MultiplicativeOperator()
+				}
+				{
+					p.SetState(559)
+					p.expression(24)
+				}
+
+			case 2:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(561)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
+				}
+				{
+					p.SetState(562)
+					p.AdditiveOperator()
+				}
+				{
+					p.SetState(563)
+					p.expression(23)
+				}
+
+			case 3:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(565)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				}
+				{
+					p.SetState(566)
+					p.ArrayOperator()
+				}
+				p.SetState(569)
+				p.GetErrorHandler().Sync(p)
+
+				switch p.GetTokenStream().LA(1) {
+				case FqlParserNot, FqlParserIn:
+					{
+						p.SetState(567)
+						p.InOperator()
+					}
+
+				case FqlParserGt, FqlParserLt, FqlParserEq, FqlParserGte, FqlParserLte, FqlParserNeq:
+					{
+						p.SetState(568)
+						p.EqualityOperator()
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+				{
+					p.SetState(571)
+					p.expression(18)
+				}
+
+			case 4:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(573)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				}
+				{
+					p.SetState(574)
+					p.InOperator()
+				}
+				{
+					p.SetState(575)
+					p.expression(17)
+				}
+
+			case 5:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(577)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
+				}
+				{
+					p.SetState(578)
+					p.EqualityOperator()
+				}
+				{
+					p.SetState(579)
+					p.expression(16)
+				}
+
+			case 6:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(581)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
+				}
+				{
+					p.SetState(582)
+					p.LogicalAndOperator()
+				}
+				{
+					p.SetState(583)
+					p.expression(15)
+				}
+
+			case 7:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(585)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+				}
+				{
+					p.SetState(586)
+					p.LogicalOrOperator()
+				}
+				{
+					p.SetState(587)
+					p.expression(14)
+				}
+
+			case 8:
+				localctx = NewExpressionContext(p, _parentctx, _parentState)
+				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
+				p.SetState(589)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
+				}
+				{
+					p.SetState(590)
+					p.Match(FqlParserQuestionMark)
+				}
+				p.SetState(592)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+
+				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserSortDirection-44))|(1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserNulls-44))|(1<<(FqlParserCollate-44))|(1<<(FqlParserCount-44))|(1<<(FqlParserAll-44))|(1<<(FqlParserAny-44))|(1<<(FqlParserPartition-44))|(1<<(FqlParserSwitch-44))|(1<<(FqlParserWhen-44))|(1<<(FqlParserDefault-44))|(1<<(FqlParserEnd-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserRawStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
+					{
+						p.SetState(591)
+						p.expression(0)
+					}
+
+				}
+				{
+					p.SetState(594)
+					p.Match(FqlParserColon)
+				}
+				{
+					p.SetState(595)
+					p.expression(13)
+				}
+
 			}
 
-			p.SetState(443)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
 		}
-
-	}
-	{
-		p.SetState(446)
-		p.Match(FqlParserCloseParen)
+		p.SetState(600)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
 	}
 
 	return localctx
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// ISwitchExpressionContext is an interface to support dynamic dispatch.
+type ISwitchExpressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// IsSwitchExpressionContext differentiates from other interfaces.
+	IsSwitchExpressionContext()
 }
 
-type ExpressionContext struct {
+type SwitchExpressionContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptySwitchExpressionContext() *SwitchExpressionContext {
+	var p = new(SwitchExpressionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = FqlParserRULE_expression
+	p.RuleIndex = FqlParserRULE_switchExpression
 	return p
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*SwitchExpressionContext) IsSwitchExpressionContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewSwitchExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchExpressionContext {
+	var p = new(SwitchExpressionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = FqlParserRULE_expression
+	p.RuleIndex = FqlParserRULE_switchExpression
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *SwitchExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionContext) UnaryOperator() IUnaryOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUnaryOperatorContext)(nil)).Elem(), 0)
+func (s *SwitchExpressionContext) Switch() antlr.TerminalNode {
+	return s.GetToken(FqlParserSwitch, 0)
+}
+
+func (s *SwitchExpressionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IUnaryOperatorContext)
+	return t.(IExpressionContext)
 }
 
-func (s *ExpressionContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+func (s *SwitchExpressionContext) End() antlr.TerminalNode {
+	return s.GetToken(FqlParserEnd, 0)
+}
+
+func (s *SwitchExpressionContext) AllSwitchCase() []ISwitchCaseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem())
+	var tst = make([]ISwitchCaseContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExpressionContext)
+			tst[i] = t.(ISwitchCaseContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *ExpressionContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *SwitchExpressionContext) SwitchCase(i int) ISwitchCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ISwitchCaseContext)
 }
 
-func (s *ExpressionContext) FunctionCallExpression() IFunctionCallExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionCallExpressionContext)(nil)).Elem(), 0)
+func (s *SwitchExpressionContext) SwitchDefault() ISwitchDefaultContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchDefaultContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFunctionCallExpressionContext)
+	return t.(ISwitchDefaultContext)
 }
 
-func (s *ExpressionContext) ExpressionGroup() IExpressionGroupContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionGroupContext)(nil)).Elem(), 0)
+func (s *SwitchExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-	if t == nil {
-		return nil
+func (s *SwitchExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SwitchExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterSwitchExpression(s)
 	}
+}
 
-	return t.(IExpressionGroupContext)
+func (s *SwitchExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitSwitchExpression(s)
+	}
+}
+
+func (s *SwitchExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitSwitchExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *FqlParser) SwitchExpression() (localctx ISwitchExpressionContext) {
+	localctx = NewSwitchExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, FqlParserRULE_switchExpression)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(601)
+		p.Match(FqlParserSwitch)
+	}
+	{
+		p.SetState(602)
+		p.expression(0)
+	}
+	p.SetState(604)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == FqlParserCase {
+		{
+			p.SetState(603)
+			p.SwitchCase()
+		}
+
+		p.SetState(606)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(609)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == FqlParserDefault {
+		{
+			p.SetState(608)
+			p.SwitchDefault()
+		}
+
+	}
+	{
+		p.SetState(611)
+		p.Match(FqlParserEnd)
+	}
+
+	return localctx
+}
+
+// IWhenExpressionContext is an interface to support dynamic dispatch.
+type IWhenExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsWhenExpressionContext differentiates from other interfaces.
+	IsWhenExpressionContext()
+}
+
+type WhenExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *ExpressionContext) RangeOperator() IRangeOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRangeOperatorContext)(nil)).Elem(), 0)
+func NewEmptyWhenExpressionContext() *WhenExpressionContext {
+	var p = new(WhenExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_whenExpression
+	return p
+}
 
-	if t == nil {
-		return nil
-	}
+func (*WhenExpressionContext) IsWhenExpressionContext() {}
 
-	return t.(IRangeOperatorContext)
-}
+func NewWhenExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhenExpressionContext {
+	var p = new(WhenExpressionContext)
 
-func (s *ExpressionContext) StringLiteral() IStringLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStringLiteralContext)(nil)).Elem(), 0)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_whenExpression
 
-	return t.(IStringLiteralContext)
+	return p
 }
 
-func (s *ExpressionContext) IntegerLiteral() IIntegerLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIntegerLiteralContext)(nil)).Elem(), 0)
+func (s *WhenExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *WhenExpressionContext) When() antlr.TerminalNode {
+	return s.GetToken(FqlParserWhen, 0)
+}
 
-	return t.(IIntegerLiteralContext)
+func (s *WhenExpressionContext) End() antlr.TerminalNode {
+	return s.GetToken(FqlParserEnd, 0)
 }
 
-func (s *ExpressionContext) FloatLiteral() IFloatLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFloatLiteralContext)(nil)).Elem(), 0)
+func (s *WhenExpressionContext) AllSwitchCase() []ISwitchCaseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem())
+	var tst = make([]ISwitchCaseContext, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISwitchCaseContext)
+		}
 	}
 
-	return t.(IFloatLiteralContext)
+	return tst
 }
 
-func (s *ExpressionContext) BooleanLiteral() IBooleanLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBooleanLiteralContext)(nil)).Elem(), 0)
+func (s *WhenExpressionContext) SwitchCase(i int) ISwitchCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchCaseContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IBooleanLiteralContext)
+	return t.(ISwitchCaseContext)
 }
 
-func (s *ExpressionContext) ArrayLiteral() IArrayLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayLiteralContext)(nil)).Elem(), 0)
+func (s *WhenExpressionContext) SwitchDefault() ISwitchDefaultContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchDefaultContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IArrayLiteralContext)
+	return t.(ISwitchDefaultContext)
 }
 
-func (s *ExpressionContext) ObjectLiteral() IObjectLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IObjectLiteralContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IObjectLiteralContext)
+func (s *WhenExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ExpressionContext) Variable() IVariableContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariableContext)(nil)).Elem(), 0)
+func (s *WhenExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-	if t == nil {
-		return nil
+func (s *WhenExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterWhenExpression(s)
 	}
-
-	return t.(IVariableContext)
 }
 
-func (s *ExpressionContext) MemberExpression() IMemberExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMemberExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
+func (s *WhenExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitWhenExpression(s)
 	}
-
-	return t.(IMemberExpressionContext)
 }
 
-func (s *ExpressionContext) NoneLiteral() INoneLiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*INoneLiteralContext)(nil)).Elem(), 0)
+func (s *WhenExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitWhenExpression(s)
 
-	if t == nil {
-		return nil
+	default:
+		return t.VisitChildren(s)
 	}
-
-	return t.(INoneLiteralContext)
 }
 
-func (s *ExpressionContext) Param() IParamContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParamContext)(nil)).Elem(), 0)
+func (p *FqlParser) WhenExpression() (localctx IWhenExpressionContext) {
+	localctx = NewWhenExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, FqlParserRULE_whenExpression)
+	var _la int
 
-	if t == nil {
-		return nil
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(613)
+		p.Match(FqlParserWhen)
 	}
+	p.SetState(615)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	return t.(IParamContext)
-}
+	for ok := true; ok; ok = _la == FqlParserCase {
+		{
+			p.SetState(614)
+			p.SwitchCase()
+		}
 
-func (s *ExpressionContext) MultiplicativeOperator() IMultiplicativeOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMultiplicativeOperatorContext)(nil)).Elem(), 0)
+		p.SetState(617)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(620)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	if t == nil {
-		return nil
+	if _la == FqlParserDefault {
+		{
+			p.SetState(619)
+			p.SwitchDefault()
+		}
+
+	}
+	{
+		p.SetState(622)
+		p.Match(FqlParserEnd)
 	}
 
-	return t.(IMultiplicativeOperatorContext)
+	return localctx
 }
 
-func (s *ExpressionContext) AdditiveOperator() IAdditiveOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAdditiveOperatorContext)(nil)).Elem(), 0)
+// ISwitchCaseContext is an interface to support dynamic dispatch.
+type ISwitchCaseContext interface {
+	antlr.ParserRuleContext
 
-	if t == nil {
-		return nil
-	}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	return t.(IAdditiveOperatorContext)
+	// IsSwitchCaseContext differentiates from other interfaces.
+	IsSwitchCaseContext()
 }
 
-func (s *ExpressionContext) ArrayOperator() IArrayOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayOperatorContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+type SwitchCaseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	return t.(IArrayOperatorContext)
+func NewEmptySwitchCaseContext() *SwitchCaseContext {
+	var p = new(SwitchCaseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_switchCase
+	return p
 }
 
-func (s *ExpressionContext) InOperator() IInOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInOperatorContext)(nil)).Elem(), 0)
+func (*SwitchCaseContext) IsSwitchCaseContext() {}
 
-	if t == nil {
-		return nil
-	}
+func NewSwitchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchCaseContext {
+	var p = new(SwitchCaseContext)
 
-	return t.(IInOperatorContext)
-}
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-func (s *ExpressionContext) EqualityOperator() IEqualityOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEqualityOperatorContext)(nil)).Elem(), 0)
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_switchCase
 
-	if t == nil {
-		return nil
-	}
+	return p
+}
 
-	return t.(IEqualityOperatorContext)
+func (s *SwitchCaseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SwitchCaseContext) Case() antlr.TerminalNode {
+	return s.GetToken(FqlParserCase, 0)
 }
 
-func (s *ExpressionContext) LogicalAndOperator() ILogicalAndOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILogicalAndOperatorContext)(nil)).Elem(), 0)
+func (s *SwitchCaseContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
 	}
 
-	return t.(ILogicalAndOperatorContext)
+	return tst
 }
 
-func (s *ExpressionContext) LogicalOrOperator() ILogicalOrOperatorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILogicalOrOperatorContext)(nil)).Elem(), 0)
+func (s *SwitchCaseContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILogicalOrOperatorContext)
+	return t.(IExpressionContext)
 }
 
-func (s *ExpressionContext) QuestionMark() antlr.TerminalNode {
-	return s.GetToken(FqlParserQuestionMark, 0)
+func (s *SwitchCaseContext) Colon() antlr.TerminalNode {
+	return s.GetToken(FqlParserColon, 0)
 }
 
-func (s *ExpressionContext) Colon() antlr.TerminalNode {
-	return s.GetToken(FqlParserColon, 0)
+func (s *SwitchCaseContext) AllComma() []antlr.TerminalNode {
+	return s.GetTokens(FqlParserComma)
 }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *SwitchCaseContext) Comma(i int) antlr.TerminalNode {
+	return s.GetToken(FqlParserComma, i)
+}
+
+func (s *SwitchCaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SwitchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SwitchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.EnterExpression(s)
+		listenerT.EnterSwitchCase(s)
 	}
 }
 
-func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SwitchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(FqlParserListener); ok {
-		listenerT.ExitExpression(s)
+		listenerT.ExitSwitchCase(s)
 	}
 }
 
-func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SwitchCaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case FqlParserVisitor:
-		return t.VisitExpression(s)
+		return t.VisitSwitchCase(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *FqlParser) Expression() (localctx IExpressionContext) {
-	return p.expression(0)
-}
-
-func (p *FqlParser) expression(_p int) (localctx IExpressionContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
-	_parentState := p.GetState()
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx IExpressionContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 92
-	p.EnterRecursionRule(localctx, 92, FqlParserRULE_expression, _p)
+func (p *FqlParser) SwitchCase() (localctx ISwitchCaseContext) {
+	localctx = NewSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, FqlParserRULE_switchCase)
 	var _la int
 
 	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
-	}()
-
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
-
-	var _alt int
-
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(465)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(449)
-			p.UnaryOperator()
-		}
-		{
-			p.SetState(450)
-			p.expression(22)
-		}
-
-	case 2:
-		{
-			p.SetState(452)
-			p.FunctionCallExpression()
-		}
-
-	case 3:
-		{
-			p.SetState(453)
-			p.ExpressionGroup()
-		}
-
-	case 4:
-		{
-			p.SetState(454)
-			p.RangeOperator()
-		}
-
-	case 5:
-		{
-			p.SetState(455)
-			p.StringLiteral()
-		}
-
-	case 6:
-		{
-			p.SetState(456)
-			p.IntegerLiteral()
-		}
+		p.ExitRule()
+	}()
 
-	case 7:
-		{
-			p.SetState(457)
-			p.FloatLiteral()
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
 		}
+	}()
 
-	case 8:
-		{
-			p.SetState(458)
-			p.BooleanLiteral()
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(624)
+		p.Match(FqlParserCase)
+	}
+	{
+		p.SetState(625)
+		p.expression(0)
+	}
+	p.SetState(630)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	case 9:
+	for _la == FqlParserComma {
 		{
-			p.SetState(459)
-			p.ArrayLiteral()
+			p.SetState(626)
+			p.Match(FqlParserComma)
 		}
-
-	case 10:
 		{
-			p.SetState(460)
-			p.ObjectLiteral()
+			p.SetState(627)
+			p.expression(0)
 		}
 
-	case 11:
-		{
-			p.SetState(461)
-			p.Variable()
-		}
+		p.SetState(632)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(633)
+		p.Match(FqlParserColon)
+	}
+	{
+		p.SetState(634)
+		p.expression(0)
+	}
 
-	case 12:
-		{
-			p.SetState(462)
-			p.MemberExpression()
-		}
+	return localctx
+}
 
-	case 13:
-		{
-			p.SetState(463)
-			p.NoneLiteral()
-		}
+// ISwitchDefaultContext is an interface to support dynamic dispatch.
+type ISwitchDefaultContext interface {
+	antlr.ParserRuleContext
 
-	case 14:
-		{
-			p.SetState(464)
-			p.Param()
-		}
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(508)
-	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+	// IsSwitchDefaultContext differentiates from other interfaces.
+	IsSwitchDefaultContext()
+}
 
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			p.SetState(506)
-			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
-			case 1:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(467)
+type SwitchDefaultContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
-				}
-				{
-					p.SetState(468)
-					p.MultiplicativeOperator()
-				}
-				{
-					p.SetState(469)
-					p.expression(22)
-				}
+func NewEmptySwitchDefaultContext() *SwitchDefaultContext {
+	var p = new(SwitchDefaultContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = FqlParserRULE_switchDefault
+	return p
+}
 
-			case 2:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(471)
+func (*SwitchDefaultContext) IsSwitchDefaultContext() {}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
-				}
-				{
-					p.SetState(472)
-					p.AdditiveOperator()
-				}
-				{
-					p.SetState(473)
-					p.expression(21)
-				}
+func NewSwitchDefaultContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchDefaultContext {
+	var p = new(SwitchDefaultContext)
 
-			case 3:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(475)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
-				}
-				{
-					p.SetState(476)
-					p.ArrayOperator()
-				}
-				p.SetState(479)
-				p.GetErrorHandler().Sync(p)
+	p.parser = parser
+	p.RuleIndex = FqlParserRULE_switchDefault
 
-				switch p.GetTokenStream().LA(1) {
-				case FqlParserNot, FqlParserIn:
-					{
-						p.SetState(477)
-						p.InOperator()
-					}
+	return p
+}
 
-				case FqlParserGt, FqlParserLt, FqlParserEq, FqlParserGte, FqlParserLte, FqlParserNeq:
-					{
-						p.SetState(478)
-						p.EqualityOperator()
-					}
+func (s *SwitchDefaultContext) GetParser() antlr.Parser { return s.parser }
 
-				default:
-					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-				}
-				{
-					p.SetState(481)
-					p.expression(18)
-				}
+func (s *SwitchDefaultContext) Default() antlr.TerminalNode {
+	return s.GetToken(FqlParserDefault, 0)
+}
 
-			case 4:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(483)
+func (s *SwitchDefaultContext) Colon() antlr.TerminalNode {
+	return s.GetToken(FqlParserColon, 0)
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
-				}
-				{
-					p.SetState(484)
-					p.InOperator()
-				}
-				{
-					p.SetState(485)
-					p.expression(17)
-				}
+func (s *SwitchDefaultContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
-			case 5:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(487)
+	if t == nil {
+		return nil
+	}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
-				}
-				{
-					p.SetState(488)
-					p.EqualityOperator()
-				}
-				{
-					p.SetState(489)
-					p.expression(16)
-				}
+	return t.(IExpressionContext)
+}
 
-			case 6:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(491)
+func (s *SwitchDefaultContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
-				}
-				{
-					p.SetState(492)
-					p.LogicalAndOperator()
-				}
-				{
-					p.SetState(493)
-					p.expression(15)
-				}
+func (s *SwitchDefaultContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-			case 7:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(495)
+func (s *SwitchDefaultContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.EnterSwitchDefault(s)
+	}
+}
 
-				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
-				}
-				{
-					p.SetState(496)
-					p.LogicalOrOperator()
-				}
-				{
-					p.SetState(497)
-					p.expression(14)
-				}
+func (s *SwitchDefaultContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(FqlParserListener); ok {
+		listenerT.ExitSwitchDefault(s)
+	}
+}
 
-			case 8:
-				localctx = NewExpressionContext(p, _parentctx, _parentState)
-				p.PushNewRecursionContext(localctx, _startState, FqlParserRULE_expression)
-				p.SetState(499)
+func (s *SwitchDefaultContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case FqlParserVisitor:
+		return t.VisitSwitchDefault(s)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
-				}
-				{
-					p.SetState(500)
-					p.Match(FqlParserQuestionMark)
-				}
-				p.SetState(502)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
-				if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
-					{
-						p.SetState(501)
-						p.expression(0)
-					}
+func (p *FqlParser) SwitchDefault() (localctx ISwitchDefaultContext) {
+	localctx = NewSwitchDefaultContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, FqlParserRULE_switchDefault)
 
-				}
-				{
-					p.SetState(504)
-					p.Match(FqlParserColon)
-				}
-				{
-					p.SetState(505)
-					p.expression(13)
-				}
+	defer func() {
+		p.ExitRule()
+	}()
 
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
 			}
-
 		}
-		p.SetState(510)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext())
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(636)
+		p.Match(FqlParserDefault)
+	}
+	{
+		p.SetState(637)
+		p.Match(FqlParserColon)
+	}
+	{
+		p.SetState(638)
+		p.expression(0)
 	}
 
 	return localctx
@@ -7875,7 +10090,7 @@ func (s *ForTernaryExpressionContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *FqlParser) ForTernaryExpression() (localctx IForTernaryExpressionContext) {
 	localctx = NewForTernaryExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, FqlParserRULE_forTernaryExpression)
+	p.EnterRule(localctx, 120, FqlParserRULE_forTernaryExpression)
 	var _la int
 
 	defer func() {
@@ -7894,114 +10109,114 @@ func (p *FqlParser) ForTernaryExpression() (localctx IForTernaryExpressionContex
 		}
 	}()
 
-	p.SetState(539)
+	p.SetState(668)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(511)
+			p.SetState(640)
 			p.expression(0)
 		}
 		{
-			p.SetState(512)
+			p.SetState(641)
 			p.Match(FqlParserQuestionMark)
 		}
-		p.SetState(514)
+		p.SetState(643)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserOpenBracket)|(1<<FqlParserOpenParen)|(1<<FqlParserOpenBrace)|(1<<FqlParserPlus)|(1<<FqlParserMinus))) != 0) || (((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserSortDirection-44))|(1<<(FqlParserNone-44))|(1<<(FqlParserNull-44))|(1<<(FqlParserBooleanLiteral-44))|(1<<(FqlParserNulls-44))|(1<<(FqlParserCollate-44))|(1<<(FqlParserCount-44))|(1<<(FqlParserAll-44))|(1<<(FqlParserAny-44))|(1<<(FqlParserPartition-44))|(1<<(FqlParserSwitch-44))|(1<<(FqlParserWhen-44))|(1<<(FqlParserDefault-44))|(1<<(FqlParserEnd-44))|(1<<(FqlParserLike-44))|(1<<(FqlParserNot-44))|(1<<(FqlParserParam-44))|(1<<(FqlParserIdentifier-44))|(1<<(FqlParserStringLiteral-44))|(1<<(FqlParserTemplateStringLiteral-44))|(1<<(FqlParserRawStringLiteral-44))|(1<<(FqlParserIntegerLiteral-44))|(1<<(FqlParserFloatLiteral-44))|(1<<(FqlParserNamespaceSegment-44)))) != 0) {
 			{
-				p.SetState(513)
+				p.SetState(642)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(516)
+			p.SetState(645)
 			p.Match(FqlParserColon)
 		}
 		{
-			p.SetState(517)
+			p.SetState(646)
 			p.Match(FqlParserOpenParen)
 		}
 		{
-			p.SetState(518)
+			p.SetState(647)
 			p.ForExpression()
 		}
 		{
-			p.SetState(519)
+			p.SetState(648)
 			p.Match(FqlParserCloseParen)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(521)
+			p.SetState(650)
 			p.expression(0)
 		}
 		{
-			p.SetState(522)
+			p.SetState(651)
 			p.Match(FqlParserQuestionMark)
 		}
 		{
-			p.SetState(523)
+			p.SetState(652)
 			p.Match(FqlParserOpenParen)
 		}
 		{
-			p.SetState(524)
+			p.SetState(653)
 			p.ForExpression()
 		}
 		{
-			p.SetState(525)
+			p.SetState(654)
 			p.Match(FqlParserCloseParen)
 		}
 		{
-			p.SetState(526)
+			p.SetState(655)
 			p.Match(FqlParserColon)
 		}
 		{
-			p.SetState(527)
+			p.SetState(656)
 			p.expression(0)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(529)
+			p.SetState(658)
 			p.expression(0)
 		}
 		{
-			p.SetState(530)
+			p.SetState(659)
 			p.Match(FqlParserQuestionMark)
 		}
 		{
-			p.SetState(531)
+			p.SetState(660)
 			p.Match(FqlParserOpenParen)
 		}
 		{
-			p.SetState(532)
+			p.SetState(661)
 			p.ForExpression()
 		}
 		{
-			p.SetState(533)
+			p.SetState(662)
 			p.Match(FqlParserCloseParen)
 		}
 		{
-			p.SetState(534)
+			p.SetState(663)
 			p.Match(FqlParserColon)
 		}
 		{
-			p.SetState(535)
+			p.SetState(664)
 			p.Match(FqlParserOpenParen)
 		}
 		{
-			p.SetState(536)
+			p.SetState(665)
 			p.ForExpression()
 		}
 		{
-			p.SetState(537)
+			p.SetState(666)
 			p.Match(FqlParserCloseParen)
 		}
 
@@ -8092,7 +10307,7 @@ func (s *ArrayOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *FqlParser) ArrayOperator() (localctx IArrayOperatorContext) {
 	localctx = NewArrayOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, FqlParserRULE_arrayOperator)
+	p.EnterRule(localctx, 122, FqlParserRULE_arrayOperator)
 	var _la int
 
 	defer func() {
@@ -8113,10 +10328,10 @@ func (p *FqlParser) ArrayOperator() (localctx IArrayOperatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(541)
+		p.SetState(670)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-44)&-(0x1f+1)) == 0 && ((1<<uint((_la-44)))&((1<<(FqlParserNone-44))|(1<<(FqlParserAll-44))|(1<<(FqlParserAny-44)))) != 0) {
+		if !(((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(FqlParserNone-45))|(1<<(FqlParserAll-45))|(1<<(FqlParserAny-45)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -8205,7 +10420,7 @@ func (s *InOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *FqlParser) InOperator() (localctx IInOperatorContext) {
 	localctx = NewInOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, FqlParserRULE_inOperator)
+	p.EnterRule(localctx, 124, FqlParserRULE_inOperator)
 
 	defer func() {
 		p.ExitRule()
@@ -8223,25 +10438,25 @@ func (p *FqlParser) InOperator() (localctx IInOperatorContext) {
 		}
 	}()
 
-	p.SetState(546)
+	p.SetState(675)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case FqlParserIn:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(543)
+			p.SetState(672)
 			p.Match(FqlParserIn)
 		}
 
 	case FqlParserNot:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(544)
+			p.SetState(673)
 			p.Match(FqlParserNot)
 		}
 		{
-			p.SetState(545)
+			p.SetState(674)
 			p.Match(FqlParserIn)
 		}
 
@@ -8346,7 +10561,7 @@ func (s *EqualityOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *FqlParser) EqualityOperator() (localctx IEqualityOperatorContext) {
 	localctx = NewEqualityOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, FqlParserRULE_equalityOperator)
+	p.EnterRule(localctx, 126, FqlParserRULE_equalityOperator)
 	var _la int
 
 	defer func() {
@@ -8367,7 +10582,7 @@ func (p *FqlParser) EqualityOperator() (localctx IEqualityOperatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(548)
+		p.SetState(677)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserGt)|(1<<FqlParserLt)|(1<<FqlParserEq)|(1<<FqlParserGte)|(1<<FqlParserLte)|(1<<FqlParserNeq))) != 0) {
@@ -8455,7 +10670,7 @@ func (s *LogicalAndOperatorContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *FqlParser) LogicalAndOperator() (localctx ILogicalAndOperatorContext) {
 	localctx = NewLogicalAndOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, FqlParserRULE_logicalAndOperator)
+	p.EnterRule(localctx, 128, FqlParserRULE_logicalAndOperator)
 
 	defer func() {
 		p.ExitRule()
@@ -8475,7 +10690,7 @@ func (p *FqlParser) LogicalAndOperator() (localctx ILogicalAndOperatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(550)
+		p.SetState(679)
 		p.Match(FqlParserAnd)
 	}
 
@@ -8556,7 +10771,7 @@ func (s *LogicalOrOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *FqlParser) LogicalOrOperator() (localctx ILogicalOrOperatorContext) {
 	localctx = NewLogicalOrOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, FqlParserRULE_logicalOrOperator)
+	p.EnterRule(localctx, 130, FqlParserRULE_logicalOrOperator)
 
 	defer func() {
 		p.ExitRule()
@@ -8576,7 +10791,7 @@ func (p *FqlParser) LogicalOrOperator() (localctx ILogicalOrOperatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(552)
+		p.SetState(681)
 		p.Match(FqlParserOr)
 	}
 
@@ -8665,7 +10880,7 @@ func (s *MultiplicativeOperatorContext) Accept(visitor antlr.ParseTreeVisitor) i
 
 func (p *FqlParser) MultiplicativeOperator() (localctx IMultiplicativeOperatorContext) {
 	localctx = NewMultiplicativeOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, FqlParserRULE_multiplicativeOperator)
+	p.EnterRule(localctx, 132, FqlParserRULE_multiplicativeOperator)
 	var _la int
 
 	defer func() {
@@ -8686,7 +10901,7 @@ func (p *FqlParser) MultiplicativeOperator() (localctx IMultiplicativeOperatorCo
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(554)
+		p.SetState(683)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<FqlParserMulti)|(1<<FqlParserDiv)|(1<<FqlParserMod))) != 0) {
@@ -8778,7 +10993,7 @@ func (s *AdditiveOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *FqlParser) AdditiveOperator() (localctx IAdditiveOperatorContext) {
 	localctx = NewAdditiveOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, FqlParserRULE_additiveOperator)
+	p.EnterRule(localctx, 134, FqlParserRULE_additiveOperator)
 	var _la int
 
 	defer func() {
@@ -8799,7 +11014,7 @@ func (p *FqlParser) AdditiveOperator() (localctx IAdditiveOperatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(556)
+		p.SetState(685)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == FqlParserPlus || _la == FqlParserMinus) {
@@ -8899,7 +11114,7 @@ func (s *UnaryOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *FqlParser) UnaryOperator() (localctx IUnaryOperatorContext) {
 	localctx = NewUnaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, FqlParserRULE_unaryOperator)
+	p.EnterRule(localctx, 136, FqlParserRULE_unaryOperator)
 	var _la int
 
 	defer func() {
@@ -8920,10 +11135,10 @@ func (p *FqlParser) UnaryOperator() (localctx IUnaryOperatorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(558)
+		p.SetState(687)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-24)&-(0x1f+1)) == 0 && ((1<<uint((_la-24)))&((1<<(FqlParserPlus-24))|(1<<(FqlParserMinus-24))|(1<<(FqlParserLike-24))|(1<<(FqlParserNot-24)))) != 0) {
+		if !(_la == FqlParserPlus || _la == FqlParserMinus || _la == FqlParserLike || _la == FqlParserNot) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -8936,7 +11151,7 @@ func (p *FqlParser) UnaryOperator() (localctx IUnaryOperatorContext) {
 
 func (p *FqlParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 46:
+	case 55:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
@@ -8951,10 +11166,10 @@ func (p *FqlParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int
 func (p *FqlParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 21)
+		return p.Precpred(p.GetParserRuleContext(), 23)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 20)
+		return p.Precpred(p.GetParserRuleContext(), 22)
 
 	case 2:
 		return p.Precpred(p.GetParserRuleContext(), 17)
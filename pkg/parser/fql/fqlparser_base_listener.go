@@ -130,6 +130,18 @@ func (s *BaseFqlParserListener) EnterSortClauseExpression(ctx *SortClauseExpress
 // ExitSortClauseExpression is called when production sortClauseExpression is exited.
 func (s *BaseFqlParserListener) ExitSortClauseExpression(ctx *SortClauseExpressionContext) {}
 
+// EnterSortClauseNulls is called when production sortClauseNulls is entered.
+func (s *BaseFqlParserListener) EnterSortClauseNulls(ctx *SortClauseNullsContext) {}
+
+// ExitSortClauseNulls is called when production sortClauseNulls is exited.
+func (s *BaseFqlParserListener) ExitSortClauseNulls(ctx *SortClauseNullsContext) {}
+
+// EnterSortClauseCollation is called when production sortClauseCollation is entered.
+func (s *BaseFqlParserListener) EnterSortClauseCollation(ctx *SortClauseCollationContext) {}
+
+// ExitSortClauseCollation is called when production sortClauseCollation is exited.
+func (s *BaseFqlParserListener) ExitSortClauseCollation(ctx *SortClauseCollationContext) {}
+
 // EnterCollectClause is called when production collectClause is entered.
 func (s *BaseFqlParserListener) EnterCollectClause(ctx *CollectClauseContext) {}
 
@@ -172,6 +184,24 @@ func (s *BaseFqlParserListener) EnterCollectCounter(ctx *CollectCounterContext)
 // ExitCollectCounter is called when production collectCounter is exited.
 func (s *BaseFqlParserListener) ExitCollectCounter(ctx *CollectCounterContext) {}
 
+// EnterCollectOptions is called when production collectOptions is entered.
+func (s *BaseFqlParserListener) EnterCollectOptions(ctx *CollectOptionsContext) {}
+
+// ExitCollectOptions is called when production collectOptions is exited.
+func (s *BaseFqlParserListener) ExitCollectOptions(ctx *CollectOptionsContext) {}
+
+// EnterWindowClause is called when production windowClause is entered.
+func (s *BaseFqlParserListener) EnterWindowClause(ctx *WindowClauseContext) {}
+
+// ExitWindowClause is called when production windowClause is exited.
+func (s *BaseFqlParserListener) ExitWindowClause(ctx *WindowClauseContext) {}
+
+// EnterWindowPartition is called when production windowPartition is entered.
+func (s *BaseFqlParserListener) EnterWindowPartition(ctx *WindowPartitionContext) {}
+
+// ExitWindowPartition is called when production windowPartition is exited.
+func (s *BaseFqlParserListener) ExitWindowPartition(ctx *WindowPartitionContext) {}
+
 // EnterVariableDeclaration is called when production variableDeclaration is entered.
 func (s *BaseFqlParserListener) EnterVariableDeclaration(ctx *VariableDeclarationContext) {}
 
@@ -190,6 +220,12 @@ func (s *BaseFqlParserListener) EnterVariable(ctx *VariableContext) {}
 // ExitVariable is called when production variable is exited.
 func (s *BaseFqlParserListener) ExitVariable(ctx *VariableContext) {}
 
+// EnterIdentifier is called when production identifier is entered.
+func (s *BaseFqlParserListener) EnterIdentifier(ctx *IdentifierContext) {}
+
+// ExitIdentifier is called when production identifier is exited.
+func (s *BaseFqlParserListener) ExitIdentifier(ctx *IdentifierContext) {}
+
 // EnterRangeOperator is called when production rangeOperator is entered.
 func (s *BaseFqlParserListener) EnterRangeOperator(ctx *RangeOperatorContext) {}
 
@@ -244,6 +280,12 @@ func (s *BaseFqlParserListener) EnterArrayElementList(ctx *ArrayElementListConte
 // ExitArrayElementList is called when production arrayElementList is exited.
 func (s *BaseFqlParserListener) ExitArrayElementList(ctx *ArrayElementListContext) {}
 
+// EnterArrayElement is called when production arrayElement is entered.
+func (s *BaseFqlParserListener) EnterArrayElement(ctx *ArrayElementContext) {}
+
+// ExitArrayElement is called when production arrayElement is exited.
+func (s *BaseFqlParserListener) ExitArrayElement(ctx *ArrayElementContext) {}
+
 // EnterPropertyAssignment is called when production propertyAssignment is entered.
 func (s *BaseFqlParserListener) EnterPropertyAssignment(ctx *PropertyAssignmentContext) {}
 
@@ -274,6 +316,12 @@ func (s *BaseFqlParserListener) EnterPropertyName(ctx *PropertyNameContext) {}
 // ExitPropertyName is called when production propertyName is exited.
 func (s *BaseFqlParserListener) ExitPropertyName(ctx *PropertyNameContext) {}
 
+// EnterReservedWord is called when production reservedWord is entered.
+func (s *BaseFqlParserListener) EnterReservedWord(ctx *ReservedWordContext) {}
+
+// ExitReservedWord is called when production reservedWord is exited.
+func (s *BaseFqlParserListener) ExitReservedWord(ctx *ReservedWordContext) {}
+
 // EnterExpressionGroup is called when production expressionGroup is entered.
 func (s *BaseFqlParserListener) EnterExpressionGroup(ctx *ExpressionGroupContext) {}
 
@@ -298,12 +346,42 @@ func (s *BaseFqlParserListener) EnterArguments(ctx *ArgumentsContext) {}
 // ExitArguments is called when production arguments is exited.
 func (s *BaseFqlParserListener) ExitArguments(ctx *ArgumentsContext) {}
 
+// EnterArgument is called when production argument is entered.
+func (s *BaseFqlParserListener) EnterArgument(ctx *ArgumentContext) {}
+
+// ExitArgument is called when production argument is exited.
+func (s *BaseFqlParserListener) ExitArgument(ctx *ArgumentContext) {}
+
 // EnterExpression is called when production expression is entered.
 func (s *BaseFqlParserListener) EnterExpression(ctx *ExpressionContext) {}
 
 // ExitExpression is called when production expression is exited.
 func (s *BaseFqlParserListener) ExitExpression(ctx *ExpressionContext) {}
 
+// EnterSwitchExpression is called when production switchExpression is entered.
+func (s *BaseFqlParserListener) EnterSwitchExpression(ctx *SwitchExpressionContext) {}
+
+// ExitSwitchExpression is called when production switchExpression is exited.
+func (s *BaseFqlParserListener) ExitSwitchExpression(ctx *SwitchExpressionContext) {}
+
+// EnterWhenExpression is called when production whenExpression is entered.
+func (s *BaseFqlParserListener) EnterWhenExpression(ctx *WhenExpressionContext) {}
+
+// ExitWhenExpression is called when production whenExpression is exited.
+func (s *BaseFqlParserListener) ExitWhenExpression(ctx *WhenExpressionContext) {}
+
+// EnterSwitchCase is called when production switchCase is entered.
+func (s *BaseFqlParserListener) EnterSwitchCase(ctx *SwitchCaseContext) {}
+
+// ExitSwitchCase is called when production switchCase is exited.
+func (s *BaseFqlParserListener) ExitSwitchCase(ctx *SwitchCaseContext) {}
+
+// EnterSwitchDefault is called when production switchDefault is entered.
+func (s *BaseFqlParserListener) EnterSwitchDefault(ctx *SwitchDefaultContext) {}
+
+// ExitSwitchDefault is called when production switchDefault is exited.
+func (s *BaseFqlParserListener) ExitSwitchDefault(ctx *SwitchDefaultContext) {}
+
 // EnterForTernaryExpression is called when production forTernaryExpression is entered.
 func (s *BaseFqlParserListener) EnterForTernaryExpression(ctx *ForTernaryExpressionContext) {}
 
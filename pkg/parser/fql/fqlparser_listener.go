@@ -61,6 +61,12 @@ type FqlParserListener interface {
 	// EnterSortClauseExpression is called when entering the sortClauseExpression production.
 	EnterSortClauseExpression(c *SortClauseExpressionContext)
 
+	// EnterSortClauseNulls is called when entering the sortClauseNulls production.
+	EnterSortClauseNulls(c *SortClauseNullsContext)
+
+	// EnterSortClauseCollation is called when entering the sortClauseCollation production.
+	EnterSortClauseCollation(c *SortClauseCollationContext)
+
 	// EnterCollectClause is called when entering the collectClause production.
 	EnterCollectClause(c *CollectClauseContext)
 
@@ -82,6 +88,15 @@ type FqlParserListener interface {
 	// EnterCollectCounter is called when entering the collectCounter production.
 	EnterCollectCounter(c *CollectCounterContext)
 
+	// EnterCollectOptions is called when entering the collectOptions production.
+	EnterCollectOptions(c *CollectOptionsContext)
+
+	// EnterWindowClause is called when entering the windowClause production.
+	EnterWindowClause(c *WindowClauseContext)
+
+	// EnterWindowPartition is called when entering the windowPartition production.
+	EnterWindowPartition(c *WindowPartitionContext)
+
 	// EnterVariableDeclaration is called when entering the variableDeclaration production.
 	EnterVariableDeclaration(c *VariableDeclarationContext)
 
@@ -91,6 +106,9 @@ type FqlParserListener interface {
 	// EnterVariable is called when entering the variable production.
 	EnterVariable(c *VariableContext)
 
+	// EnterIdentifier is called when entering the identifier production.
+	EnterIdentifier(c *IdentifierContext)
+
 	// EnterRangeOperator is called when entering the rangeOperator production.
 	EnterRangeOperator(c *RangeOperatorContext)
 
@@ -118,6 +136,9 @@ type FqlParserListener interface {
 	// EnterArrayElementList is called when entering the arrayElementList production.
 	EnterArrayElementList(c *ArrayElementListContext)
 
+	// EnterArrayElement is called when entering the arrayElement production.
+	EnterArrayElement(c *ArrayElementContext)
+
 	// EnterPropertyAssignment is called when entering the propertyAssignment production.
 	EnterPropertyAssignment(c *PropertyAssignmentContext)
 
@@ -133,6 +154,9 @@ type FqlParserListener interface {
 	// EnterPropertyName is called when entering the propertyName production.
 	EnterPropertyName(c *PropertyNameContext)
 
+	// EnterReservedWord is called when entering the reservedWord production.
+	EnterReservedWord(c *ReservedWordContext)
+
 	// EnterExpressionGroup is called when entering the expressionGroup production.
 	EnterExpressionGroup(c *ExpressionGroupContext)
 
@@ -145,9 +169,24 @@ type FqlParserListener interface {
 	// EnterArguments is called when entering the arguments production.
 	EnterArguments(c *ArgumentsContext)
 
+	// EnterArgument is called when entering the argument production.
+	EnterArgument(c *ArgumentContext)
+
 	// EnterExpression is called when entering the expression production.
 	EnterExpression(c *ExpressionContext)
 
+	// EnterSwitchExpression is called when entering the switchExpression production.
+	EnterSwitchExpression(c *SwitchExpressionContext)
+
+	// EnterWhenExpression is called when entering the whenExpression production.
+	EnterWhenExpression(c *WhenExpressionContext)
+
+	// EnterSwitchCase is called when entering the switchCase production.
+	EnterSwitchCase(c *SwitchCaseContext)
+
+	// EnterSwitchDefault is called when entering the switchDefault production.
+	EnterSwitchDefault(c *SwitchDefaultContext)
+
 	// EnterForTernaryExpression is called when entering the forTernaryExpression production.
 	EnterForTernaryExpression(c *ForTernaryExpressionContext)
 
@@ -229,6 +268,12 @@ type FqlParserListener interface {
 	// ExitSortClauseExpression is called when exiting the sortClauseExpression production.
 	ExitSortClauseExpression(c *SortClauseExpressionContext)
 
+	// ExitSortClauseNulls is called when exiting the sortClauseNulls production.
+	ExitSortClauseNulls(c *SortClauseNullsContext)
+
+	// ExitSortClauseCollation is called when exiting the sortClauseCollation production.
+	ExitSortClauseCollation(c *SortClauseCollationContext)
+
 	// ExitCollectClause is called when exiting the collectClause production.
 	ExitCollectClause(c *CollectClauseContext)
 
@@ -250,6 +295,15 @@ type FqlParserListener interface {
 	// ExitCollectCounter is called when exiting the collectCounter production.
 	ExitCollectCounter(c *CollectCounterContext)
 
+	// ExitCollectOptions is called when exiting the collectOptions production.
+	ExitCollectOptions(c *CollectOptionsContext)
+
+	// ExitWindowClause is called when exiting the windowClause production.
+	ExitWindowClause(c *WindowClauseContext)
+
+	// ExitWindowPartition is called when exiting the windowPartition production.
+	ExitWindowPartition(c *WindowPartitionContext)
+
 	// ExitVariableDeclaration is called when exiting the variableDeclaration production.
 	ExitVariableDeclaration(c *VariableDeclarationContext)
 
@@ -259,6 +313,9 @@ type FqlParserListener interface {
 	// ExitVariable is called when exiting the variable production.
 	ExitVariable(c *VariableContext)
 
+	// ExitIdentifier is called when exiting the identifier production.
+	ExitIdentifier(c *IdentifierContext)
+
 	// ExitRangeOperator is called when exiting the rangeOperator production.
 	ExitRangeOperator(c *RangeOperatorContext)
 
@@ -286,6 +343,9 @@ type FqlParserListener interface {
 	// ExitArrayElementList is called when exiting the arrayElementList production.
 	ExitArrayElementList(c *ArrayElementListContext)
 
+	// ExitArrayElement is called when exiting the arrayElement production.
+	ExitArrayElement(c *ArrayElementContext)
+
 	// ExitPropertyAssignment is called when exiting the propertyAssignment production.
 	ExitPropertyAssignment(c *PropertyAssignmentContext)
 
@@ -301,6 +361,9 @@ type FqlParserListener interface {
 	// ExitPropertyName is called when exiting the propertyName production.
 	ExitPropertyName(c *PropertyNameContext)
 
+	// ExitReservedWord is called when exiting the reservedWord production.
+	ExitReservedWord(c *ReservedWordContext)
+
 	// ExitExpressionGroup is called when exiting the expressionGroup production.
 	ExitExpressionGroup(c *ExpressionGroupContext)
 
@@ -313,9 +376,24 @@ type FqlParserListener interface {
 	// ExitArguments is called when exiting the arguments production.
 	ExitArguments(c *ArgumentsContext)
 
+	// ExitArgument is called when exiting the argument production.
+	ExitArgument(c *ArgumentContext)
+
 	// ExitExpression is called when exiting the expression production.
 	ExitExpression(c *ExpressionContext)
 
+	// ExitSwitchExpression is called when exiting the switchExpression production.
+	ExitSwitchExpression(c *SwitchExpressionContext)
+
+	// ExitWhenExpression is called when exiting the whenExpression production.
+	ExitWhenExpression(c *WhenExpressionContext)
+
+	// ExitSwitchCase is called when exiting the switchCase production.
+	ExitSwitchCase(c *SwitchCaseContext)
+
+	// ExitSwitchDefault is called when exiting the switchDefault production.
+	ExitSwitchDefault(c *SwitchDefaultContext)
+
 	// ExitForTernaryExpression is called when exiting the forTernaryExpression production.
 	ExitForTernaryExpression(c *ForTernaryExpressionContext)
 
@@ -61,6 +61,12 @@ type FqlParserVisitor interface {
 	// Visit a parse tree produced by FqlParser#sortClauseExpression.
 	VisitSortClauseExpression(ctx *SortClauseExpressionContext) interface{}
 
+	// Visit a parse tree produced by FqlParser#sortClauseNulls.
+	VisitSortClauseNulls(ctx *SortClauseNullsContext) interface{}
+
+	// Visit a parse tree produced by FqlParser#sortClauseCollation.
+	VisitSortClauseCollation(ctx *SortClauseCollationContext) interface{}
+
 	// Visit a parse tree produced by FqlParser#collectClause.
 	VisitCollectClause(ctx *CollectClauseContext) interface{}
 
@@ -82,6 +88,15 @@ type FqlParserVisitor interface {
 	// Visit a parse tree produced by FqlParser#collectCounter.
 	VisitCollectCounter(ctx *CollectCounterContext) interface{}
 
+	// Visit a parse tree produced by FqlParser#collectOptions.
+	VisitCollectOptions(ctx *CollectOptionsContext) interface{}
+
+	// Visit a parse tree produced by FqlParser#windowClause.
+	VisitWindowClause(ctx *WindowClauseContext) interface{}
+
+	// Visit a parse tree produced by FqlParser#windowPartition.
+	VisitWindowPartition(ctx *WindowPartitionContext) interface{}
+
 	// Visit a parse tree produced by FqlParser#variableDeclaration.
 	VisitVariableDeclaration(ctx *VariableDeclarationContext) interface{}
 
@@ -91,6 +106,9 @@ type FqlParserVisitor interface {
 	// Visit a parse tree produced by FqlParser#variable.
 	VisitVariable(ctx *VariableContext) interface{}
 
+	// Visit a parse tree produced by FqlParser#identifier.
+	VisitIdentifier(ctx *IdentifierContext) interface{}
+
 	// Visit a parse tree produced by FqlParser#rangeOperator.
 	VisitRangeOperator(ctx *RangeOperatorContext) interface{}
 
@@ -118,6 +136,9 @@ type FqlParserVisitor interface {
 	// Visit a parse tree produced by FqlParser#arrayElementList.
 	VisitArrayElementList(ctx *ArrayElementListContext) interface{}
 
+	// Visit a parse tree produced by FqlParser#arrayElement.
+	VisitArrayElement(ctx *ArrayElementContext) interface{}
+
 	// Visit a parse tree produced by FqlParser#propertyAssignment.
 	VisitPropertyAssignment(ctx *PropertyAssignmentContext) interface{}
 
@@ -133,6 +154,9 @@ type FqlParserVisitor interface {
 	// Visit a parse tree produced by FqlParser#propertyName.
 	VisitPropertyName(ctx *PropertyNameContext) interface{}
 
+	// Visit a parse tree produced by FqlParser#reservedWord.
+	VisitReservedWord(ctx *ReservedWordContext) interface{}
+
 	// Visit a parse tree produced by FqlParser#expressionGroup.
 	VisitExpressionGroup(ctx *ExpressionGroupContext) interface{}
 
@@ -145,9 +169,24 @@ type FqlParserVisitor interface {
 	// Visit a parse tree produced by FqlParser#arguments.
 	VisitArguments(ctx *ArgumentsContext) interface{}
 
+	// Visit a parse tree produced by FqlParser#argument.
+	VisitArgument(ctx *ArgumentContext) interface{}
+
 	// Visit a parse tree produced by FqlParser#expression.
 	VisitExpression(ctx *ExpressionContext) interface{}
 
+	// Visit a parse tree produced by FqlParser#switchExpression.
+	VisitSwitchExpression(ctx *SwitchExpressionContext) interface{}
+
+	// Visit a parse tree produced by FqlParser#whenExpression.
+	VisitWhenExpression(ctx *WhenExpressionContext) interface{}
+
+	// Visit a parse tree produced by FqlParser#switchCase.
+	VisitSwitchCase(ctx *SwitchCaseContext) interface{}
+
+	// Visit a parse tree produced by FqlParser#switchDefault.
+	VisitSwitchDefault(ctx *SwitchDefaultContext) interface{}
+
 	// Visit a parse tree produced by FqlParser#forTernaryExpression.
 	VisitForTernaryExpression(ctx *ForTernaryExpressionContext) interface{}
 
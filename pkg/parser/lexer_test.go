@@ -0,0 +1,133 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/parser/fql"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func tokenize(input string) []antlr.Token {
+	lexer := fql.NewFqlLexer(antlr.NewInputStream(input))
+	lexer.RemoveErrorListeners()
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	stream.Fill()
+
+	tokens := make([]antlr.Token, 0, 10)
+
+	for _, t := range stream.GetAllTokens() {
+		if t.GetChannel() != antlr.TokenDefaultChannel || t.GetTokenType() == antlr.TokenEOF {
+			continue
+		}
+
+		tokens = append(tokens, t)
+	}
+
+	return tokens
+}
+
+func tokenTypes(input string) []int {
+	tokens := tokenize(input)
+	types := make([]int, 0, len(tokens))
+
+	for _, t := range tokens {
+		types = append(types, t.GetTokenType())
+	}
+
+	return types
+}
+
+func TestLexer(t *testing.T) {
+	Convey("Keywords", t, func() {
+		Convey("Should be case-insensitive", func() {
+			for _, input := range []string{"FOR", "for", "For", "fOr"} {
+				So(tokenTypes(input), ShouldResemble, []int{fql.FqlLexerFor})
+			}
+
+			So(tokenTypes("let x = true return x"), ShouldResemble, []int{
+				fql.FqlLexerLet,
+				fql.FqlLexerIdentifier,
+				fql.FqlLexerAssign,
+				fql.FqlLexerBooleanLiteral,
+				fql.FqlLexerReturn,
+				fql.FqlLexerIdentifier,
+			})
+
+			So(tokenTypes("asc Desc"), ShouldResemble, []int{fql.FqlLexerSortDirection, fql.FqlLexerSortDirection})
+			So(tokenTypes("and Or not"), ShouldResemble, []int{fql.FqlLexerAnd, fql.FqlLexerOr, fql.FqlLexerNot})
+			So(tokenTypes("null None"), ShouldResemble, []int{fql.FqlLexerNull, fql.FqlLexerNone})
+		})
+
+		Convey("Should not match identifiers that start with a keyword", func() {
+			So(tokenTypes("format"), ShouldResemble, []int{fql.FqlLexerIdentifier})
+			So(tokenTypes("index"), ShouldResemble, []int{fql.FqlLexerIdentifier})
+			So(tokenTypes("letter"), ShouldResemble, []int{fql.FqlLexerIdentifier})
+		})
+	})
+
+	Convey("Identifiers", t, func() {
+		Convey("Should support Unicode letters", func() {
+			for _, input := range []string{"café", "ñandú", "город", "名前", "ΑΒΓ", "user_名前2"} {
+				tokens := tokenize(input)
+
+				So(tokens, ShouldHaveLength, 1)
+				So(tokens[0].GetTokenType(), ShouldEqual, fql.FqlLexerIdentifier)
+				So(tokens[0].GetText(), ShouldEqual, input)
+			}
+		})
+	})
+
+	Convey("Integers", t, func() {
+		Convey("Should support decimal, hex, binary and octal notations", func() {
+			for _, input := range []string{"42", "0x2A", "0X2a", "0b101010", "0B1", "0o52", "0O7"} {
+				tokens := tokenize(input)
+
+				So(tokens, ShouldHaveLength, 1)
+				So(tokens[0].GetTokenType(), ShouldEqual, fql.FqlLexerIntegerLiteral)
+				So(tokens[0].GetText(), ShouldEqual, input)
+			}
+		})
+
+		Convey("Should support digit separators", func() {
+			for _, input := range []string{"1_000_000", "0xFF_FF", "0b1010_1010", "0o7_7"} {
+				tokens := tokenize(input)
+
+				So(tokens, ShouldHaveLength, 1)
+				So(tokens[0].GetTokenType(), ShouldEqual, fql.FqlLexerIntegerLiteral)
+				So(tokens[0].GetText(), ShouldEqual, input)
+			}
+
+			tokens := tokenize("1_000.000_1")
+
+			So(tokens, ShouldHaveLength, 1)
+			So(tokens[0].GetTokenType(), ShouldEqual, fql.FqlLexerFloatLiteral)
+		})
+
+		Convey("Should not treat a range as a float", func() {
+			So(tokenTypes("1..10"), ShouldResemble, []int{
+				fql.FqlLexerIntegerLiteral,
+				fql.FqlLexerRange,
+				fql.FqlLexerIntegerLiteral,
+			})
+		})
+	})
+
+	Convey("Strings", t, func() {
+		Convey("Should support escape sequences", func() {
+			for _, input := range []string{`"a\"b"`, `'a\'b'`, `"\n\t\\"`, `"\u00E9"`, `"\u{1F600}"`, `"a""b"`} {
+				tokens := tokenize(input)
+
+				So(tokens, ShouldHaveLength, 1)
+				So(tokens[0].GetTokenType(), ShouldEqual, fql.FqlLexerStringLiteral)
+				So(tokens[0].GetText(), ShouldEqual, input)
+			}
+		})
+
+		Convey("Should distinguish raw strings from identifiers", func() {
+			So(tokenTypes("r`${x}`"), ShouldResemble, []int{fql.FqlLexerRawStringLiteral})
+			So(tokenTypes("`${x}`"), ShouldResemble, []int{fql.FqlLexerTemplateStringLiteral})
+			So(tokenTypes("r"), ShouldResemble, []int{fql.FqlLexerIdentifier})
+		})
+	})
+}
@@ -4,6 +4,7 @@ package parser
 import (
 	"github.com/MontFerret/ferret/pkg/parser/fql"
 	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/pkg/errors"
 )
 
 type Parser struct {
@@ -11,8 +12,22 @@ type Parser struct {
 }
 
 func New(query string) *Parser {
+	return NewAt(query, 1, 0)
+}
+
+// NewAt creates a parser for a fragment of a query
+// that starts at a given line and column of the original query.
+// It is used to keep positions of nested expressions (e.g. template interpolations)
+// relative to the original query.
+func NewAt(query string, line, column int) *Parser {
 	input := antlr.NewInputStream(query)
 	lexer := fql.NewFqlLexer(input)
+
+	if sim, ok := lexer.Interpreter.(*antlr.LexerATNSimulator); ok {
+		sim.Line = line
+		sim.CharPositionInLine = column
+	}
+
 	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 
 	p := fql.NewFqlParser(stream)
@@ -29,3 +44,16 @@ func (p *Parser) AddErrorListener(listener antlr.ErrorListener) {
 func (p *Parser) Visit(visitor fql.FqlParserVisitor) interface{} {
 	return visitor.VisitProgram(p.tree.Program().(*fql.ProgramContext))
 }
+
+// VisitExpression parses the input as a single expression
+// and returns its context or an error if the input contains anything else.
+func (p *Parser) VisitExpression() (*fql.ExpressionContext, error) {
+	exp := p.tree.Expression().(*fql.ExpressionContext)
+	next := p.tree.GetTokenStream().LT(1)
+
+	if next.GetTokenType() != antlr.TokenEOF {
+		return nil, errors.Errorf("unexpected token '%s' at %d:%d", next.GetText(), next.GetLine(), next.GetColumn())
+	}
+
+	return exp, nil
+}
@@ -29,7 +29,7 @@ func NewFilterIterator(values Iterator, predicate FilterPredicate) (*FilterItera
 
 func (iterator *FilterIterator) Next(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
 	for {
-		nextScope, err := iterator.values.Next(ctx, scope.Fork())
+		nextScope, err := iterator.values.Next(ctx, scope)
 
 		if err != nil {
 			return nil, err
@@ -49,5 +49,8 @@ func (iterator *FilterIterator) Next(ctx context.Context, scope *core.Scope) (*c
 		if take {
 			return nextScope, nil
 		}
+
+		nextScope.Dispose()
+		nextScope.Release()
 	}
 }
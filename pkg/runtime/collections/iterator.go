@@ -19,7 +19,7 @@ func ToSlice(ctx context.Context, scope *core.Scope, iterator Iterator) ([]*core
 	res := make([]*core.Scope, 0, 10)
 
 	for {
-		nextScope, err := iterator.Next(ctx, scope.Fork())
+		nextScope, err := iterator.Next(ctx, scope)
 
 		if err != nil {
 			return nil, err
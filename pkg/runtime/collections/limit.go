@@ -44,7 +44,7 @@ func (iterator *LimitIterator) verifyOffset(ctx context.Context, scope *core.Sco
 	}
 
 	for iterator.offset > iterator.currCount {
-		nextScope, err := iterator.values.Next(ctx, scope.Fork())
+		nextScope, err := iterator.values.Next(ctx, scope)
 
 		if err != nil {
 			return err
@@ -55,6 +55,9 @@ func (iterator *LimitIterator) verifyOffset(ctx context.Context, scope *core.Sco
 			return nil
 		}
 
+		nextScope.Dispose()
+		nextScope.Release()
+
 		iterator.currCount++
 	}
 
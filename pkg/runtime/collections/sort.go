@@ -1,29 +1,48 @@
 package collections
 
 import (
+	"container/heap"
 	"context"
 	"sort"
 	"strings"
 
 	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
 )
 
 type (
 	SortDirection int
 
+	// SortNulls defines where None values are placed regardless of the sort direction.
+	SortNulls int
+
+	// SortCollation defines how string values are compared.
+	SortCollation int
+
 	Comparator func(ctx context.Context, first, second *core.Scope) (int64, error)
 
+	// Selector returns a value of a given scope which is used for sorting.
+	Selector func(ctx context.Context, scope *core.Scope) (core.Value, error)
+
 	Sorter struct {
 		fn        Comparator
+		selector  Selector
 		direction SortDirection
+		nulls     SortNulls
+		collation SortCollation
 	}
 
 	SortIterator struct {
 		values  Iterator
 		sorters []*Sorter
 		ready   bool
-		result  []*core.Scope
-		pos     int
+		result  run
+	}
+
+	// mergeRun merges several sorted runs into one sorted sequence.
+	mergeRun struct {
+		heap *sortHeap
+		runs []run
 	}
 )
 
@@ -32,6 +51,21 @@ const (
 	SortDirectionDesc SortDirection = -1
 )
 
+const (
+	// SortNullsDefault treats None as the smallest value.
+	SortNullsDefault SortNulls = 0
+	SortNullsFirst   SortNulls = 1
+	SortNullsLast    SortNulls = 2
+)
+
+const (
+	SortCollationDefault SortCollation = 0
+	// SortCollationNoCase compares strings case-insensitively.
+	SortCollationNoCase SortCollation = 1
+	// SortCollationNatural compares sequences of digits inside of strings by their numeric value.
+	SortCollationNatural SortCollation = 2
+)
+
 func SortDirectionFromString(str string) SortDirection {
 	if strings.EqualFold(str, "DESC") {
 		return SortDirectionDesc
@@ -49,6 +83,48 @@ func IsValidSortDirection(direction SortDirection) bool {
 	}
 }
 
+func SortNullsFromString(str string) (SortNulls, error) {
+	switch strings.ToUpper(str) {
+	case "FIRST":
+		return SortNullsFirst, nil
+	case "LAST":
+		return SortNullsLast, nil
+	default:
+		return SortNullsDefault, core.Error(core.ErrInvalidArgument, "nulls: "+str)
+	}
+}
+
+func IsValidSortNulls(nulls SortNulls) bool {
+	switch nulls {
+	case SortNullsDefault, SortNullsFirst, SortNullsLast:
+		return true
+	default:
+		return false
+	}
+}
+
+func SortCollationFromString(str string) (SortCollation, error) {
+	switch strings.ToUpper(str) {
+	case "BINARY":
+		return SortCollationDefault, nil
+	case "NOCASE":
+		return SortCollationNoCase, nil
+	case "NATURAL":
+		return SortCollationNatural, nil
+	default:
+		return SortCollationDefault, core.Error(core.ErrInvalidArgument, "collation: "+str)
+	}
+}
+
+func IsValidSortCollation(collation SortCollation) bool {
+	switch collation {
+	case SortCollationDefault, SortCollationNoCase, SortCollationNatural:
+		return true
+	default:
+		return false
+	}
+}
+
 func NewSorter(fn Comparator, direction SortDirection) (*Sorter, error) {
 	if fn == nil {
 		return nil, core.Error(core.ErrMissedArgument, "fn")
@@ -58,7 +134,95 @@ func NewSorter(fn Comparator, direction SortDirection) (*Sorter, error) {
 		return nil, core.Error(core.ErrInvalidArgument, "direction")
 	}
 
-	return &Sorter{fn, direction}, nil
+	return &Sorter{fn: fn, direction: direction}, nil
+}
+
+// NewValueSorter creates a sorter that compares values returned by a given selector
+// using a given placement of None values and a given string collation.
+func NewValueSorter(
+	selector Selector,
+	direction SortDirection,
+	nulls SortNulls,
+	collation SortCollation,
+) (*Sorter, error) {
+	if selector == nil {
+		return nil, core.Error(core.ErrMissedArgument, "selector")
+	}
+
+	if !IsValidSortDirection(direction) {
+		return nil, core.Error(core.ErrInvalidArgument, "direction")
+	}
+
+	if !IsValidSortNulls(nulls) {
+		return nil, core.Error(core.ErrInvalidArgument, "nulls")
+	}
+
+	if !IsValidSortCollation(collation) {
+		return nil, core.Error(core.ErrInvalidArgument, "collation")
+	}
+
+	return &Sorter{
+		selector:  selector,
+		direction: direction,
+		nulls:     nulls,
+		collation: collation,
+	}, nil
+}
+
+// Compare compares two scopes taking into account the sorter direction.
+func (sorter *Sorter) Compare(ctx context.Context, first, second *core.Scope) (int64, error) {
+	if sorter.selector == nil {
+		eq, err := sorter.fn(ctx, first, second)
+
+		if err != nil {
+			return 0, err
+		}
+
+		return eq * int64(sorter.direction), nil
+	}
+
+	f, err := sorter.selector(ctx, first)
+
+	if err != nil {
+		return 0, err
+	}
+
+	s, err := sorter.selector(ctx, second)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return sorter.CompareValues(f, s), nil
+}
+
+// CompareValues compares two values taking into account the sorter direction,
+// placement of None values and string collation.
+func (sorter *Sorter) CompareValues(first, second core.Value) int64 {
+	if sorter.nulls != SortNullsDefault {
+		firstIsNone := first.Type() == types.None
+		secondIsNone := second.Type() == types.None
+
+		if firstIsNone || secondIsNone {
+			if firstIsNone && secondIsNone {
+				return 0
+			}
+
+			eq := int64(1)
+
+			if firstIsNone {
+				eq = -1
+			}
+
+			if sorter.nulls == SortNullsLast {
+				eq = -eq
+			}
+
+			return eq
+		}
+	}
+
+	return compareWithCollation(first, second, sorter.collation) * int64(sorter.direction)
 }
 
 func NewSortIterator(
@@ -78,7 +242,6 @@ func NewSortIterator(
 		comparators,
 		false,
 		nil,
-		0,
 	}, nil
 }
 
@@ -95,25 +258,94 @@ func (iterator *SortIterator) Next(ctx context.Context, scope *core.Scope) (*cor
 		iterator.result = sorted
 	}
 
-	if len(iterator.result) > iterator.pos {
-		idx := iterator.pos
-		val := iterator.result[idx]
+	return iterator.result.next(ctx)
+}
 
-		iterator.pos++
+func (iterator *SortIterator) sort(ctx context.Context, scope *core.Scope) (run, error) {
+	opts := SpillFrom(ctx)
 
-		return val, nil
+	if opts != nil && opts.Threshold > 0 {
+		return iterator.sortExternal(ctx, scope, opts)
 	}
 
-	return nil, nil
-}
-
-func (iterator *SortIterator) sort(ctx context.Context, scope *core.Scope) ([]*core.Scope, error) {
 	scopes, err := ToSlice(ctx, scope, iterator.values)
 
 	if err != nil {
 		return nil, err
 	}
 
+	if err := sortScopes(ctx, iterator.sorters, scopes); err != nil {
+		return nil, err
+	}
+
+	return &memoryRun{scopes: scopes}, nil
+}
+
+// sortExternal sorts the data source in chunks of the spill threshold size,
+// writes each sorted chunk to disk and merges them afterwards.
+// If the data source contains values that cannot be written to disk,
+// the rest of the data source is sorted in memory.
+func (iterator *SortIterator) sortExternal(ctx context.Context, scope *core.Scope, opts *SpillOptions) (run, error) {
+	runs := make([]run, 0, 10)
+	buffer := make([]*core.Scope, 0, opts.Threshold)
+	spillable := true
+
+	for {
+		nextScope, err := iterator.values.Next(ctx, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if nextScope == nil {
+			break
+		}
+
+		buffer = append(buffer, nextScope)
+
+		if !spillable || len(buffer) < opts.Threshold {
+			continue
+		}
+
+		if err := sortScopes(ctx, iterator.sorters, buffer); err != nil {
+			return nil, err
+		}
+
+		r, ok, err := spillScopes(opts, scope, buffer)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			spillable = false
+
+			continue
+		}
+
+		// the spilled scopes are restored from the file
+		for _, s := range buffer {
+			s.Release()
+		}
+
+		runs = append(runs, r)
+		buffer = make([]*core.Scope, 0, opts.Threshold)
+	}
+
+	if err := sortScopes(ctx, iterator.sorters, buffer); err != nil {
+		return nil, err
+	}
+
+	if len(runs) == 0 {
+		return &memoryRun{scopes: buffer}, nil
+	}
+
+	runs = append(runs, &memoryRun{scopes: buffer})
+
+	return newMergeRun(ctx, iterator.sorters, runs)
+}
+
+func sortScopes(ctx context.Context, sorters []*Sorter, scopes []*core.Scope) error {
 	var failure error
 
 	sort.SliceStable(scopes, func(i, j int) bool {
@@ -124,11 +356,11 @@ func (iterator *SortIterator) sort(ctx context.Context, scope *core.Scope) ([]*c
 
 		var out bool
 
-		for _, comp := range iterator.sorters {
+		for _, comp := range sorters {
 			left := scopes[i]
 			right := scopes[j]
 
-			eq, err := comp.fn(ctx, left, right)
+			eq, err := comp.Compare(ctx, left, right)
 
 			if err != nil {
 				failure = err
@@ -137,8 +369,6 @@ func (iterator *SortIterator) sort(ctx context.Context, scope *core.Scope) ([]*c
 				break
 			}
 
-			eq *= int64(comp.direction)
-
 			if eq == -1 {
 				out = true
 				break
@@ -153,9 +383,134 @@ func (iterator *SortIterator) sort(ctx context.Context, scope *core.Scope) ([]*c
 		return out
 	})
 
-	if failure != nil {
-		return nil, failure
+	return failure
+}
+
+func newMergeRun(ctx context.Context, sorters []*Sorter, runs []run) (*mergeRun, error) {
+	h := &sortHeap{
+		ctx:     ctx,
+		sorters: sorters,
+		items:   make([]*sortItem, 0, len(runs)),
+	}
+
+	for idx, r := range runs {
+		first, err := r.next(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if first != nil {
+			// runs are created in the order of the data source,
+			// so the run index keeps the merge stable
+			heap.Push(h, &sortItem{first, idx})
+		}
+
+		if h.failure != nil {
+			return nil, h.failure
+		}
 	}
 
-	return scopes, nil
+	return &mergeRun{h, runs}, nil
+}
+
+func (r *mergeRun) next(ctx context.Context) (*core.Scope, error) {
+	if r.heap.Len() == 0 {
+		return nil, nil
+	}
+
+	item := r.heap.items[0]
+	out := item.scope
+
+	nextScope, err := r.runs[item.idx].next(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if nextScope != nil {
+		item.scope = nextScope
+		heap.Fix(r.heap, 0)
+	} else {
+		heap.Pop(r.heap)
+	}
+
+	if r.heap.failure != nil {
+		return nil, r.heap.failure
+	}
+
+	return out, nil
+}
+
+func compareWithCollation(first, second core.Value, collation SortCollation) int64 {
+	if collation == SortCollationDefault || first.Type() != types.String || second.Type() != types.String {
+		return first.Compare(second)
+	}
+
+	f := first.String()
+	s := second.String()
+
+	if collation == SortCollationNoCase {
+		return int64(strings.Compare(strings.ToLower(f), strings.ToLower(s)))
+	}
+
+	return compareNatural(f, s)
+}
+
+// compareNatural compares two strings treating runs of digits as numbers,
+// so that "item2" goes before "item10".
+func compareNatural(first, second string) int64 {
+	i, j := 0, 0
+
+	for i < len(first) && j < len(second) {
+		a := first[i]
+		b := second[j]
+
+		if isDigit(a) && isDigit(b) {
+			startA := i
+			startB := j
+
+			for i < len(first) && isDigit(first[i]) {
+				i++
+			}
+
+			for j < len(second) && isDigit(second[j]) {
+				j++
+			}
+
+			numA := strings.TrimLeft(first[startA:i], "0")
+			numB := strings.TrimLeft(second[startB:j], "0")
+
+			if len(numA) != len(numB) {
+				if len(numA) < len(numB) {
+					return -1
+				}
+
+				return 1
+			}
+
+			if c := strings.Compare(numA, numB); c != 0 {
+				return int64(c)
+			}
+
+			continue
+		}
+
+		if a != b {
+			if a < b {
+				return -1
+			}
+
+			return 1
+		}
+
+		i++
+		j++
+	}
+
+	return int64(strings.Compare(first[i:], second[j:]))
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
 }
@@ -3,11 +3,15 @@ package collections_test
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
 	"github.com/MontFerret/ferret/pkg/runtime/collections"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
 	. "github.com/smartystreets/goconvey/convey"
-	"testing"
 )
 
 func toValues(scopes []*core.Scope) []core.Value {
@@ -349,4 +353,110 @@ func TestSort(t *testing.T) {
 
 		So(string(j), ShouldEqual, `[{"one":4,"two":1},{"one":4,"two":2},{"one":3,"two":1},{"one":3,"two":2},{"one":2,"two":1},{"one":2,"two":2},{"one":1,"two":1},{"one":1,"two":2}]`)
 	})
+
+	Convey("Should sort values with nulls last and natural collation", t, func() {
+		arr := []core.Value{
+			values.NewString("file10"),
+			values.None,
+			values.NewString("file2"),
+			values.NewString("file1"),
+		}
+
+		s, err := collections.NewValueSorter(
+			func(ctx context.Context, scope *core.Scope) (core.Value, error) {
+				return scope.MustGetVariable(collections.DefaultValueVar), nil
+			},
+			collections.SortDirectionAsc,
+			collections.SortNullsLast,
+			collections.SortCollationNatural,
+		)
+
+		So(err, ShouldBeNil)
+
+		iter, err := collections.NewSortIterator(
+			sliceIterator(arr),
+			s,
+		)
+
+		So(err, ShouldBeNil)
+
+		ctx := context.Background()
+		scope, _ := core.NewRootScope()
+
+		res, err := collections.ToSlice(ctx, scope, iter)
+
+		So(err, ShouldBeNil)
+
+		j, _ := json.Marshal(toArrayOfValues(res))
+
+		So(string(j), ShouldEqual, `["file1","file2","file10",null]`)
+	})
+
+	Convey("Should sort using disk-backed runs", t, func() {
+		dir, err := ioutil.TempDir("", "ferret-sort-test")
+
+		So(err, ShouldBeNil)
+
+		defer os.RemoveAll(dir)
+
+		arr := []core.Value{
+			values.NewString("c"),
+			values.NewArrayWith(values.NewInt(1)),
+			values.NewFloat(1.5),
+			values.None,
+			values.NewInt(2),
+			values.NewObjectWith(values.NewObjectProperty("a", values.True)),
+			values.NewString("a"),
+			values.NewInt(1),
+		}
+
+		ctx := collections.WithSpill(context.Background(), &collections.SpillOptions{
+			Threshold: 3,
+			Dir:       dir,
+		})
+
+		iter, err := collections.NewSortIterator(
+			indexedIterator(arr),
+			valueSorter(collections.SortDirectionAsc),
+		)
+
+		So(err, ShouldBeNil)
+
+		scope, closeFn := core.NewRootScope()
+
+		res, err := collections.ToSlice(ctx, scope, iter)
+
+		So(err, ShouldBeNil)
+
+		j, _ := json.Marshal(toArrayOfValues(res))
+
+		So(string(j), ShouldEqual, `[null,1,1.5,2,"a","c",[1],{"a":true}]`)
+		So(res[1].MustGetVariable(collections.DefaultValueVar).Type() == types.Int, ShouldBeTrue)
+		So(res[1].MustGetVariable(collections.DefaultKeyVar).Compare(values.NewInt(7)), ShouldEqual, 0)
+
+		So(closeFn(), ShouldBeNil)
+
+		files, err := ioutil.ReadDir(dir)
+
+		So(err, ShouldBeNil)
+		So(files, ShouldBeEmpty)
+	})
+}
+
+func BenchmarkSortSpill(b *testing.B) {
+	arr := randomValues(10000)
+	sorter := valueSorter(collections.SortDirectionDesc)
+	ctx := collections.WithSpill(context.Background(), &collections.SpillOptions{
+		Threshold: 1000,
+	})
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		scope, closeFn := core.NewRootScope()
+		iter, _ := collections.NewSortIterator(sliceIterator(arr), sorter)
+
+		collections.ToSlice(ctx, scope, iter)
+		closeFn()
+	}
 }
@@ -0,0 +1,572 @@
+package collections
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+type (
+	// SpillOptions controls spilling of large intermediate results to disk.
+	// When a buffered data set reaches the threshold, it gets written to a temporary file
+	// in the given directory. Empty directory means the default directory for temporary files.
+	SpillOptions struct {
+		// Threshold is a number of rows of a sorted data set kept in memory.
+		Threshold int
+		// MaxGroups is a number of groups kept in memory by COLLECT clauses using the hash method,
+		// rows of other groups are spilled. Zero means the threshold.
+		// Rows projected into the groups kept in memory are not limited.
+		MaxGroups int
+		Dir       string
+	}
+
+	spillKey int
+
+	// run is a sorted sequence of scopes
+	run interface {
+		next(ctx context.Context) (*core.Scope, error)
+	}
+
+	memoryRun struct {
+		scopes []*core.Scope
+		pos    int
+	}
+
+	fileRun struct {
+		parent *core.Scope
+		file   *os.File
+		reader *bufio.Reader
+		closed bool
+	}
+
+	// SpillFile is a temporary file that keeps a sequence of values.
+	// Values are written first and read afterwards in the same order.
+	// The file gets removed once it is closed.
+	SpillFile struct {
+		file   *os.File
+		writer *bufio.Writer
+		reader *bufio.Reader
+		closed bool
+	}
+)
+
+const (
+	spillNone byte = iota
+	spillBoolean
+	spillInt
+	spillFloat
+	spillString
+	spillDateTime
+	spillBinary
+	spillArray
+	spillObject
+)
+
+const spillOptionsKey spillKey = 0
+
+func WithSpill(ctx context.Context, opts *SpillOptions) context.Context {
+	return context.WithValue(ctx, spillOptionsKey, opts)
+}
+
+// SpillFrom returns spill options from a given context.
+// It returns nil if spilling is not enabled.
+func SpillFrom(ctx context.Context) *SpillOptions {
+	opts, ok := ctx.Value(spillOptionsKey).(*SpillOptions)
+
+	if !ok || opts == nil || (opts.Threshold <= 0 && opts.MaxGroups <= 0) {
+		return nil
+	}
+
+	return opts
+}
+
+// GroupLimit returns a number of groups kept in memory by COLLECT clauses using the hash method.
+func (opts *SpillOptions) GroupLimit() int {
+	if opts.MaxGroups > 0 {
+		return opts.MaxGroups
+	}
+
+	return opts.Threshold
+}
+
+// IsSpillable returns true if a given value can be written to disk and restored without loss.
+func IsSpillable(value core.Value) bool {
+	return values.IsPlain(value)
+}
+
+func (r *memoryRun) next(_ context.Context) (*core.Scope, error) {
+	if r.pos >= len(r.scopes) {
+		return nil, nil
+	}
+
+	out := r.scopes[r.pos]
+	r.scopes[r.pos] = nil
+	r.pos++
+
+	return out, nil
+}
+
+// spillScopes writes local variables of given scopes to a temporary file.
+// It returns false if any of the scopes has values that cannot be spilled.
+func spillScopes(opts *SpillOptions, parent *core.Scope, scopes []*core.Scope) (*fileRun, bool, error) {
+	locals := make([]map[string]core.Value, len(scopes))
+
+	for i, s := range scopes {
+		vars, ok := s.Locals(parent)
+
+		if !ok {
+			return nil, false, nil
+		}
+
+		for _, val := range vars {
+			if !IsSpillable(val) {
+				return nil, false, nil
+			}
+		}
+
+		locals[i] = vars
+	}
+
+	file, err := ioutil.TempFile(opts.Dir, "ferret-spill-")
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	r := &fileRun{parent: parent, file: file}
+
+	// the file must be removed even if the query gets terminated before the run is read
+	parent.AddDisposable(r)
+
+	w := bufio.NewWriter(file)
+
+	for _, vars := range locals {
+		if err := writeUvarint(w, uint64(len(vars))); err != nil {
+			return nil, false, err
+		}
+
+		for name, val := range vars {
+			if err := writeString(w, name); err != nil {
+				return nil, false, err
+			}
+
+			if err := writeValue(w, val); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, false, err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, false, err
+	}
+
+	r.reader = bufio.NewReader(file)
+
+	return r, true, nil
+}
+
+func (r *fileRun) next(_ context.Context) (*core.Scope, error) {
+	if r.closed {
+		return nil, nil
+	}
+
+	size, err := binary.ReadUvarint(r.reader)
+
+	if err == io.EOF {
+		return nil, r.Close()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	out := r.parent.Fork()
+
+	for i := uint64(0); i < size; i++ {
+		name, err := readString(r.reader)
+
+		if err != nil {
+			return nil, err
+		}
+
+		val, err := readValue(r.reader)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := out.SetVariable(name, val); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
+func (r *fileRun) Close() error {
+	if r.closed {
+		return nil
+	}
+
+	r.closed = true
+
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(r.file.Name())
+}
+
+func NewSpillFile(opts *SpillOptions) (*SpillFile, error) {
+	file, err := ioutil.TempFile(opts.Dir, "ferret-spill-")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &SpillFile{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+// Write appends a given value to the file.
+// The value must be spillable.
+func (f *SpillFile) Write(value core.Value) error {
+	if f.writer == nil {
+		return core.Error(core.ErrInvalidOperation, "spill file is not writable")
+	}
+
+	return writeValue(f.writer, value)
+}
+
+// Rewind flushes written values and prepares the file for reading.
+func (f *SpillFile) Rewind() error {
+	if f.writer == nil {
+		return core.Error(core.ErrInvalidOperation, "spill file is already rewound")
+	}
+
+	if err := f.writer.Flush(); err != nil {
+		return err
+	}
+
+	if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	f.writer = nil
+	f.reader = bufio.NewReader(f.file)
+
+	return nil
+}
+
+// Read returns the next value of the file.
+// It returns nil when there are no values left.
+func (f *SpillFile) Read() (core.Value, error) {
+	if f.reader == nil || f.closed {
+		return nil, nil
+	}
+
+	if _, err := f.reader.Peek(1); err == io.EOF {
+		return nil, nil
+	}
+
+	return readValue(f.reader)
+}
+
+func (f *SpillFile) Close() error {
+	if f.closed {
+		return nil
+	}
+
+	f.closed = true
+
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(f.file.Name())
+}
+
+func writeUvarint(w *bufio.Writer, num uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	_, err := w.Write(buf[:binary.PutUvarint(buf[:], num)])
+
+	return err
+}
+
+func writeBytes(w *bufio.Writer, data []byte) error {
+	if err := writeUvarint(w, uint64(len(data))); err != nil {
+		return err
+	}
+
+	_, err := w.Write(data)
+
+	return err
+}
+
+func writeString(w *bufio.Writer, str string) error {
+	if err := writeUvarint(w, uint64(len(str))); err != nil {
+		return err
+	}
+
+	_, err := w.WriteString(str)
+
+	return err
+}
+
+func writeValue(w *bufio.Writer, value core.Value) error {
+	switch value.Type() {
+	case types.None:
+		return w.WriteByte(spillNone)
+	case types.Boolean:
+		if err := w.WriteByte(spillBoolean); err != nil {
+			return err
+		}
+
+		if value.(values.Boolean) {
+			return w.WriteByte(1)
+		}
+
+		return w.WriteByte(0)
+	case types.Int:
+		if err := w.WriteByte(spillInt); err != nil {
+			return err
+		}
+
+		var buf [binary.MaxVarintLen64]byte
+		_, err := w.Write(buf[:binary.PutVarint(buf[:], int64(value.(values.Int)))])
+
+		return err
+	case types.Float:
+		if err := w.WriteByte(spillFloat); err != nil {
+			return err
+		}
+
+		return writeUvarint(w, math.Float64bits(float64(value.(values.Float))))
+	case types.String:
+		if err := w.WriteByte(spillString); err != nil {
+			return err
+		}
+
+		return writeString(w, string(value.(values.String)))
+	case types.DateTime:
+		if err := w.WriteByte(spillDateTime); err != nil {
+			return err
+		}
+
+		data, err := value.(values.DateTime).MarshalBinary()
+
+		if err != nil {
+			return err
+		}
+
+		return writeBytes(w, data)
+	case types.Binary:
+		if err := w.WriteByte(spillBinary); err != nil {
+			return err
+		}
+
+		return writeBytes(w, value.(values.Binary))
+	case types.Array:
+		arr := value.(*values.Array)
+
+		if err := w.WriteByte(spillArray); err != nil {
+			return err
+		}
+
+		if err := writeUvarint(w, uint64(arr.Length())); err != nil {
+			return err
+		}
+
+		var err error
+
+		arr.ForEach(func(item core.Value, _ int) bool {
+			err = writeValue(w, item)
+
+			return err == nil
+		})
+
+		return err
+	case types.Object:
+		obj := value.(*values.Object)
+
+		if err := w.WriteByte(spillObject); err != nil {
+			return err
+		}
+
+		if err := writeUvarint(w, uint64(obj.Length())); err != nil {
+			return err
+		}
+
+		var err error
+
+		obj.ForEach(func(item core.Value, key string) bool {
+			if err = writeString(w, key); err != nil {
+				return false
+			}
+
+			err = writeValue(w, item)
+
+			return err == nil
+		})
+
+		return err
+	default:
+		return core.TypeError(value.Type())
+	}
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, size)
+
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func readString(r *bufio.Reader) (string, error) {
+	data, err := readBytes(r)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func readValue(r *bufio.Reader) (core.Value, error) {
+	tag, err := r.ReadByte()
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case spillNone:
+		return values.None, nil
+	case spillBoolean:
+		b, err := r.ReadByte()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return values.NewBoolean(b == 1), nil
+	case spillInt:
+		num, err := binary.ReadVarint(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return values.NewInt(int(num)), nil
+	case spillFloat:
+		bits, err := binary.ReadUvarint(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return values.NewFloat(math.Float64frombits(bits)), nil
+	case spillString:
+		str, err := readString(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return values.NewString(str), nil
+	case spillDateTime:
+		data, err := readBytes(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		t := time.Time{}
+
+		if err := t.UnmarshalBinary(data); err != nil {
+			return nil, err
+		}
+
+		return values.NewDateTime(t), nil
+	case spillBinary:
+		data, err := readBytes(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return values.NewBinary(data), nil
+	case spillArray:
+		size, err := binary.ReadUvarint(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		arr := values.NewArray(int(size))
+
+		for i := uint64(0); i < size; i++ {
+			item, err := readValue(r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			arr.Push(item)
+		}
+
+		return arr, nil
+	case spillObject:
+		size, err := binary.ReadUvarint(r)
+
+		if err != nil {
+			return nil, err
+		}
+
+		obj := values.NewObject()
+
+		for i := uint64(0); i < size; i++ {
+			key, err := readString(r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			item, err := readValue(r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			obj.Set(values.NewString(key), item)
+		}
+
+		return obj, nil
+	default:
+		return nil, core.Error(core.ErrUnexpected, "spill value type")
+	}
+}
@@ -0,0 +1,250 @@
+package collections
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// topInitialCapacity limits memory allocated up front,
+// since sizes of LIMIT clauses can be far greater than data sources.
+const topInitialCapacity = 64
+
+type (
+	sortItem struct {
+		scope *core.Scope
+		idx   int
+	}
+
+	// sortHeap is a heap of items ordered by sorters.
+	// A max heap keeps the worst of the items on top.
+	sortHeap struct {
+		ctx     context.Context
+		sorters []*Sorter
+		items   []*sortItem
+		max     bool
+		failure error
+	}
+
+	// TopIterator returns the first N items of a sorted data source.
+	// Instead of sorting the whole data source it keeps only N items in a bounded heap,
+	// which makes SORT followed by LIMIT run in O(n log k) time and O(k) memory.
+	TopIterator struct {
+		values  Iterator
+		sorters []*Sorter
+		size    int
+		ready   bool
+		result  []*core.Scope
+		pos     int
+	}
+)
+
+func NewTopIterator(
+	values Iterator,
+	size int,
+	sorters ...*Sorter,
+) (*TopIterator, error) {
+	if values == nil {
+		return nil, core.Error(core.ErrMissedArgument, "values")
+	}
+
+	if len(sorters) == 0 {
+		return nil, core.Error(core.ErrMissedArgument, "comparator")
+	}
+
+	return &TopIterator{
+		values,
+		sorters,
+		size,
+		false,
+		nil,
+		0,
+	}, nil
+}
+
+func (iterator *TopIterator) Next(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
+	// we need to initialize the iterator
+	if !iterator.ready {
+		iterator.ready = true
+		sorted, err := iterator.top(ctx, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		iterator.result = sorted
+	}
+
+	if len(iterator.result) > iterator.pos {
+		idx := iterator.pos
+		val := iterator.result[idx]
+
+		iterator.pos++
+
+		return val, nil
+	}
+
+	return nil, nil
+}
+
+func (iterator *TopIterator) top(ctx context.Context, scope *core.Scope) ([]*core.Scope, error) {
+	// the worst of the retained items is kept on top,
+	// so that it can be replaced by a better one in O(log k)
+	capacity := iterator.size
+
+	if capacity > topInitialCapacity || capacity < 0 {
+		capacity = topInitialCapacity
+	}
+
+	h := &sortHeap{
+		ctx:     ctx,
+		sorters: iterator.sorters,
+		items:   make([]*sortItem, 0, capacity),
+		max:     true,
+	}
+
+	// an item that gets reused for values that do not make it into the heap
+	candidate := &sortItem{}
+
+	for idx := 0; ; idx++ {
+		nextScope, err := iterator.values.Next(ctx, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if nextScope == nil {
+			break
+		}
+
+		// the whole data source still needs to be consumed,
+		// since it might have side effects
+		if iterator.size <= 0 {
+			discard(scope, nextScope)
+
+			continue
+		}
+
+		if len(h.items) < iterator.size {
+			heap.Push(h, &sortItem{nextScope, idx})
+		} else {
+			candidate.scope = nextScope
+			candidate.idx = idx
+
+			if h.compare(candidate, h.items[0]) < 0 {
+				h.items[0], candidate = candidate, h.items[0]
+				heap.Fix(h, 0)
+			}
+
+			// the candidate is either the new value or the one pushed out of the heap
+			discard(scope, candidate.scope)
+			candidate.scope = nil
+		}
+
+		if h.failure != nil {
+			return nil, h.failure
+		}
+	}
+
+	sort.Slice(h.items, func(i, j int) bool {
+		return h.compare(h.items[i], h.items[j]) < 0
+	})
+
+	if h.failure != nil {
+		return nil, h.failure
+	}
+
+	res := make([]*core.Scope, len(h.items))
+
+	for i, item := range h.items {
+		res[i] = item.scope
+	}
+
+	return res, nil
+}
+
+// discard releases a scope which has not made it into the result.
+// Like spilled scopes, it is disposed only if it holds plain values,
+// otherwise its resources are handed over to the parent scope.
+func discard(parent, scope *core.Scope) {
+	if vars, ok := scope.Locals(parent); ok {
+		plain := true
+
+		for _, val := range vars {
+			if !IsSpillable(val) {
+				plain = false
+
+				break
+			}
+		}
+
+		if plain {
+			scope.Dispose()
+		}
+	}
+
+	scope.Release()
+}
+
+// compare compares two items using sorters and falls back to their original position,
+// which keeps the result identical to the stable sort.
+func (h *sortHeap) compare(first, second *sortItem) int64 {
+	// ignore next execution
+	if h.failure != nil {
+		return 0
+	}
+
+	for _, sorter := range h.sorters {
+		eq, err := sorter.Compare(h.ctx, first.scope, second.scope)
+
+		if err != nil {
+			h.failure = err
+
+			return 0
+		}
+
+		if eq != 0 {
+			return eq
+		}
+	}
+
+	if first.idx < second.idx {
+		return -1
+	}
+
+	if first.idx > second.idx {
+		return 1
+	}
+
+	return 0
+}
+
+func (h *sortHeap) Len() int {
+	return len(h.items)
+}
+
+func (h *sortHeap) Less(i, j int) bool {
+	if h.max {
+		return h.compare(h.items[i], h.items[j]) > 0
+	}
+
+	return h.compare(h.items[i], h.items[j]) < 0
+}
+
+func (h *sortHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *sortHeap) Push(x interface{}) {
+	h.items = append(h.items, x.(*sortItem))
+}
+
+func (h *sortHeap) Pop() interface{} {
+	last := len(h.items) - 1
+	item := h.items[last]
+	h.items = h.items[:last]
+
+	return item
+}
@@ -0,0 +1,213 @@
+package collections_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/collections"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func valueSorter(direction collections.SortDirection) *collections.Sorter {
+	s, _ := collections.NewSorter(
+		func(ctx context.Context, first, second *core.Scope) (int64, error) {
+			return first.MustGetVariable(collections.DefaultValueVar).Compare(second.MustGetVariable(collections.DefaultValueVar)), nil
+		},
+		direction,
+	)
+
+	return s
+}
+
+func randomValues(size int) []core.Value {
+	arr := make([]core.Value, size)
+
+	for i := range arr {
+		arr[i] = values.NewInt(rand.Intn(size))
+	}
+
+	return arr
+}
+
+func indexedIterator(arr []core.Value) collections.Iterator {
+	iter, _ := collections.NewDefaultIndexedIterator(values.NewArrayWith(arr...))
+
+	return iter
+}
+
+type (
+	countingCloser struct {
+		closed *int
+	}
+
+	// disposingIterator makes every scope own a resource
+	disposingIterator struct {
+		values collections.Iterator
+		closed int
+	}
+)
+
+func (c *countingCloser) Close() error {
+	*c.closed++
+
+	return nil
+}
+
+func (iterator *disposingIterator) Next(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
+	nextScope, err := iterator.values.Next(ctx, scope)
+
+	if nextScope != nil {
+		nextScope.AddDisposable(&countingCloser{&iterator.closed})
+	}
+
+	return nextScope, err
+}
+
+func TestTop(t *testing.T) {
+	Convey("Should return top N items", t, func() {
+		arr := []core.Value{
+			values.NewInt(5),
+			values.NewInt(1),
+			values.NewInt(3),
+			values.NewInt(4),
+			values.NewInt(2),
+		}
+
+		iter, err := collections.NewTopIterator(
+			sliceIterator(arr),
+			3,
+			valueSorter(collections.SortDirectionDesc),
+		)
+
+		So(err, ShouldBeNil)
+
+		ctx := context.Background()
+		scope, _ := core.NewRootScope()
+
+		res, err := collections.ToSlice(ctx, scope, iter)
+
+		So(err, ShouldBeNil)
+
+		j, _ := json.Marshal(toArrayOfValues(res))
+
+		So(string(j), ShouldEqual, `[5,4,3]`)
+	})
+
+	Convey("Should dispose scopes which do not make it into the result", t, func() {
+		arr := []core.Value{
+			values.NewInt(5),
+			values.NewInt(1),
+			values.NewInt(3),
+			values.NewInt(4),
+			values.NewInt(2),
+		}
+
+		source := &disposingIterator{values: sliceIterator(arr)}
+		iter, err := collections.NewTopIterator(source, 2, valueSorter(collections.SortDirectionDesc))
+
+		So(err, ShouldBeNil)
+
+		scope, closeFn := core.NewRootScope()
+
+		res, err := collections.ToSlice(context.Background(), scope.Fork(), iter)
+
+		So(err, ShouldBeNil)
+		So(res, ShouldHaveLength, 2)
+		So(source.closed, ShouldEqual, 3)
+
+		So(closeFn(), ShouldBeNil)
+		So(source.closed, ShouldEqual, 5)
+	})
+
+	Convey("Should return all items when N is greater than the data source", t, func() {
+		arr := []core.Value{
+			values.NewInt(2),
+			values.NewInt(1),
+		}
+
+		iter, err := collections.NewTopIterator(
+			sliceIterator(arr),
+			10,
+			valueSorter(collections.SortDirectionAsc),
+		)
+
+		So(err, ShouldBeNil)
+
+		ctx := context.Background()
+		scope, _ := core.NewRootScope()
+
+		res, err := collections.ToSlice(ctx, scope, iter)
+
+		So(err, ShouldBeNil)
+
+		j, _ := json.Marshal(toArrayOfValues(res))
+
+		So(string(j), ShouldEqual, `[1,2]`)
+	})
+
+	Convey("Should return the same items as the stable sort", t, func() {
+		arr := randomValues(1000)
+
+		ctx := context.Background()
+		scope, _ := core.NewRootScope()
+
+		sortIter, _ := collections.NewSortIterator(
+			indexedIterator(arr),
+			valueSorter(collections.SortDirectionAsc),
+		)
+
+		sorted, err := collections.ToSlice(ctx, scope, sortIter)
+
+		So(err, ShouldBeNil)
+
+		topIter, _ := collections.NewTopIterator(
+			indexedIterator(arr),
+			50,
+			valueSorter(collections.SortDirectionAsc),
+		)
+
+		top, err := collections.ToSlice(ctx, scope, topIter)
+
+		So(err, ShouldBeNil)
+		So(top, ShouldHaveLength, 50)
+
+		for i, s := range top {
+			So(s.MustGetVariable(collections.DefaultKeyVar).Compare(sorted[i].MustGetVariable(collections.DefaultKeyVar)), ShouldEqual, 0)
+		}
+	})
+}
+
+func BenchmarkSortLimit(b *testing.B) {
+	arr := randomValues(10000)
+	ctx := context.Background()
+	sorter := valueSorter(collections.SortDirectionDesc)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		scope, _ := core.NewRootScope()
+		iter, _ := collections.NewSortIterator(sliceIterator(arr), sorter)
+		limit, _ := collections.NewLimitIterator(iter, 10, 0)
+
+		collections.ToSlice(ctx, scope, limit)
+	}
+}
+
+func BenchmarkTop(b *testing.B) {
+	arr := randomValues(10000)
+	ctx := context.Background()
+	sorter := valueSorter(collections.SortDirectionDesc)
+
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		scope, _ := core.NewRootScope()
+		iter, _ := collections.NewTopIterator(sliceIterator(arr), 10, sorter)
+
+		collections.ToSlice(ctx, scope, iter)
+	}
+}
@@ -27,7 +27,7 @@ func NewUniqueIterator(values Iterator, hashKey string) (*UniqueIterator, error)
 
 func (iterator *UniqueIterator) Next(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
 	for {
-		nextScope, err := iterator.values.Next(ctx, scope.Fork())
+		nextScope, err := iterator.values.Next(ctx, scope)
 
 		if err != nil {
 			return nil, err
@@ -48,6 +48,9 @@ func (iterator *UniqueIterator) Next(ctx context.Context, scope *core.Scope) (*c
 		_, exists := iterator.hashes[h]
 
 		if exists {
+			nextScope.Dispose()
+			nextScope.Release()
+
 			continue
 		}
 
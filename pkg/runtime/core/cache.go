@@ -0,0 +1,101 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+type (
+	cacheEntry struct {
+		value   Value
+		expires time.Time
+	}
+
+	cacheKey struct {
+		name string
+		hash uint64
+	}
+
+	// Cache keeps results of expressions within a single run of a program.
+	// Results of function calls are keyed by a function name and a hash of arguments,
+	// results of CACHE expressions are keyed by a hash of a user given key.
+	Cache struct {
+		mu        sync.Mutex
+		functions map[string]bool
+		entries   map[cacheKey]*cacheEntry
+	}
+)
+
+const cacheContextKey key = 1
+
+// NewCache returns a cache which memoizes calls of given functions.
+func NewCache(functions ...string) *Cache {
+	c := &Cache{
+		functions: make(map[string]bool, len(functions)),
+		entries:   make(map[cacheKey]*cacheEntry),
+	}
+
+	for _, name := range functions {
+		c.functions[strings.ToUpper(name)] = true
+	}
+
+	return c
+}
+
+func CacheWith(ctx context.Context, cache *Cache) context.Context {
+	return context.WithValue(ctx, cacheContextKey, cache)
+}
+
+// CacheFrom returns a cache of the current run or nil if caching is not available.
+func CacheFrom(ctx context.Context) *Cache {
+	cache, _ := ctx.Value(cacheContextKey).(*Cache)
+
+	return cache
+}
+
+// IsCacheable returns true if calls of a given function should be memoized.
+func (c *Cache) IsCacheable(function string) bool {
+	if len(c.functions) == 0 {
+		return false
+	}
+
+	return c.functions[strings.ToUpper(function)]
+}
+
+// Get returns a value stored with a given name and hash unless it has expired.
+func (c *Cache) Get(name string, hash uint64) (Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := cacheKey{name, hash}
+	entry, exists := c.entries[k]
+
+	if !exists {
+		return nil, false
+	}
+
+	if !entry.expires.IsZero() && !time.Now().Before(entry.expires) {
+		delete(c.entries, k)
+
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+// Set stores a value with a given name and hash.
+// A value with a non positive ttl does not expire until the end of the run.
+func (c *Cache) Set(name string, hash uint64, value Value, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{value: value}
+
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+
+	c.entries[cacheKey{name, hash}] = entry
+}
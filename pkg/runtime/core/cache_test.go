@@ -0,0 +1,55 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCache(t *testing.T) {
+	Convey("Should store values by name and hash", t, func() {
+		c := core.NewCache()
+
+		c.Set("FOO", 1, values.NewInt(1), 0)
+
+		v, found := c.Get("FOO", 1)
+		So(found, ShouldBeTrue)
+		So(v, ShouldEqual, values.NewInt(1))
+
+		_, found = c.Get("BAR", 1)
+		So(found, ShouldBeFalse)
+
+		_, found = c.Get("FOO", 2)
+		So(found, ShouldBeFalse)
+	})
+
+	Convey("Should expire values after ttl", t, func() {
+		c := core.NewCache()
+
+		c.Set("FOO", 1, values.NewInt(1), time.Millisecond)
+
+		time.Sleep(2 * time.Millisecond)
+
+		_, found := c.Get("FOO", 1)
+		So(found, ShouldBeFalse)
+	})
+
+	Convey("Should match cacheable functions case insensitively", t, func() {
+		c := core.NewCache("document")
+
+		So(c.IsCacheable("DOCUMENT"), ShouldBeTrue)
+		So(c.IsCacheable("DOWNLOAD"), ShouldBeFalse)
+	})
+
+	Convey("Should return a cache from a context", t, func() {
+		So(core.CacheFrom(context.Background()), ShouldBeNil)
+
+		c := core.NewCache()
+
+		So(core.CacheFrom(core.CacheWith(context.Background(), c)), ShouldEqual, c)
+	})
+}
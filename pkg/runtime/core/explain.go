@@ -0,0 +1,131 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type (
+	// PlanNode describes a node of a compiled query.
+	PlanNode struct {
+		Type       string            `json:"type"`
+		Attributes map[string]string `json:"attributes,omitempty"`
+		Line       int               `json:"line,omitempty"`
+		Column     int               `json:"column,omitempty"`
+		Children   []*PlanNode       `json:"children,omitempty"`
+	}
+
+	// Explainable is implemented by expressions and data sources
+	// that are able to describe themselves in a query plan.
+	Explainable interface {
+		Explain() *PlanNode
+	}
+)
+
+func NewPlanNode(typeName string) *PlanNode {
+	return &PlanNode{
+		Type: typeName,
+	}
+}
+
+// Explain returns a plan node of a given expression or data source.
+// Values that do not implement Explainable are described by their type name only.
+func Explain(target interface{}) *PlanNode {
+	explainable, ok := target.(Explainable)
+
+	if ok {
+		return explainable.Explain()
+	}
+
+	return NewPlanNode(fmt.Sprintf("%T", target))
+}
+
+func (n *PlanNode) WithSource(src SourceMap) *PlanNode {
+	n.Line = src.Line()
+	n.Column = src.Column()
+
+	return n
+}
+
+func (n *PlanNode) Set(name, value string) *PlanNode {
+	if n.Attributes == nil {
+		n.Attributes = make(map[string]string)
+	}
+
+	n.Attributes[name] = value
+
+	return n
+}
+
+// Add adds plan nodes of given expressions or data sources as children.
+// Nil values are ignored.
+func (n *PlanNode) Add(children ...interface{}) *PlanNode {
+	for _, child := range children {
+		if child == nil {
+			continue
+		}
+
+		if node, ok := child.(*PlanNode); ok {
+			n.Children = append(n.Children, node)
+
+			continue
+		}
+
+		n.Children = append(n.Children, Explain(child))
+	}
+
+	return n
+}
+
+func (n *PlanNode) AddExpressions(exps []Expression) *PlanNode {
+	for _, exp := range exps {
+		n.Add(exp)
+	}
+
+	return n
+}
+
+// String returns the plan as an indented tree.
+func (n *PlanNode) String() string {
+	var b strings.Builder
+
+	n.write(&b, 0)
+
+	return b.String()
+}
+
+func (n *PlanNode) write(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	b.WriteString(n.Type)
+
+	if len(n.Attributes) > 0 {
+		names := make([]string, 0, len(n.Attributes))
+
+		for name := range n.Attributes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		attrs := make([]string, len(names))
+
+		for i, name := range names {
+			attrs[i] = name + "=" + n.Attributes[name]
+		}
+
+		b.WriteString(" [")
+		b.WriteString(strings.Join(attrs, ", "))
+		b.WriteString("]")
+	}
+
+	if n.Line > 0 {
+		b.WriteString(fmt.Sprintf(" at %d:%d", n.Line, n.Column))
+	}
+
+	b.WriteString("\n")
+
+	for _, child := range n.Children {
+		child.write(b, depth+1)
+	}
+}
@@ -2,39 +2,106 @@ package core
 
 import (
 	"io"
+	"reflect"
+	"sync"
 )
 
 type (
 	CloseFunc func() error
 
+	disposable struct {
+		closer io.Closer
+		closed bool
+	}
+
+	// RootScope keeps resources registered by all scopes of a query
+	// and closes those which have not been closed by their owners yet.
 	RootScope struct {
 		closed      bool
-		disposables []io.Closer
+		disposables []*disposable
+		registry    map[io.Closer]*disposable
+		errors      []error
+		state       map[interface{}]interface{}
 	}
 
+	// Scope keeps variables of a query.
+	// Variables are stored in the order of declaration,
+	// which allows the compiler to resolve them to positions instead of looking them up by name.
+	// A scope owns resources which have been assigned to its variables first.
+	// Resources owned by a forked scope get closed when the scope is disposed,
+	// or handed over to the parent scope when it is released.
 	Scope struct {
-		root   *RootScope
-		parent *Scope
-		vars   map[string]Value
+		root        *RootScope
+		parent      *Scope
+		names       []string
+		values      []Value
+		disposables []io.Closer
 	}
 )
 
+var scopePool = sync.Pool{
+	New: func() interface{} {
+		return &Scope{
+			names:  make([]string, 0, 4),
+			values: make([]Value, 0, 4),
+		}
+	},
+}
+
 func NewRootScope() (*Scope, CloseFunc) {
 	root := &RootScope{
 		closed:      false,
-		disposables: make([]io.Closer, 0, 10),
+		disposables: make([]*disposable, 0, 10),
+		registry:    make(map[io.Closer]*disposable),
 	}
 
 	return newScope(root, nil), root.Close
 }
 
-func (s *RootScope) AddDisposable(disposable io.Closer) {
-	if s.closed {
+func (s *RootScope) AddDisposable(closer io.Closer) {
+	s.register(closer)
+}
+
+// register adds a resource to the list of resources closed together with the root scope.
+// It returns false if the resource has been registered already.
+// Resources that cannot be used as map keys are always registered, but never reported as new,
+// so that they are closed only once, by the root scope.
+func (s *RootScope) register(closer io.Closer) bool {
+	if s.closed || closer == nil {
+		return false
+	}
+
+	d := &disposable{closer: closer}
+
+	if !reflect.TypeOf(closer).Comparable() {
+		s.disposables = append(s.disposables, d)
+
+		return false
+	}
+
+	if _, exists := s.registry[closer]; exists {
+		return false
+	}
+
+	s.registry[closer] = d
+	s.disposables = append(s.disposables, d)
+
+	return true
+}
+
+// dispose closes a registered resource before the root scope gets closed.
+// Errors are reported when the root scope gets closed.
+func (s *RootScope) dispose(closer io.Closer) {
+	d, exists := s.registry[closer]
+
+	if !exists || d.closed {
 		return
 	}
 
-	if disposable != nil {
-		s.disposables = append(s.disposables, disposable)
+	d.closed = true
+
+	if err := closer.Close(); err != nil {
+		s.errors = append(s.errors, err)
 	}
 }
 
@@ -44,12 +111,19 @@ func (s *RootScope) Close() error {
 	}
 
 	s.closed = true
+	s.state = nil
 
-	var errors []error
+	errors := s.errors
 
 	// close all values implemented io.Close
-	for _, c := range s.disposables {
-		if err := c.Close(); err != nil {
+	for _, d := range s.disposables {
+		if d.closed {
+			continue
+		}
+
+		d.closed = true
+
+		if err := d.closer.Close(); err != nil {
 			if errors == nil {
 				errors = make([]error, 0, len(s.disposables))
 			}
@@ -66,60 +140,127 @@ func (s *RootScope) Close() error {
 }
 
 func newScope(root *RootScope, parent *Scope) *Scope {
-	return &Scope{
-		root:   root,
-		parent: parent,
-		vars:   make(map[string]Value),
+	s := scopePool.Get().(*Scope)
+	s.root = root
+	s.parent = parent
+
+	return s
+}
+
+func (s *Scope) indexOf(name string) int {
+	for i, n := range s.names {
+		if n == name {
+			return i
+		}
 	}
+
+	return -1
 }
 
 func (s *Scope) SetVariable(name string, val Value) error {
-	_, exists := s.vars[name]
-
 	// it already has been declared in the current scope
-	if exists {
+	if s.indexOf(name) > -1 {
 		return Errorf(ErrNotUnique, "variable is already declared: '%s'", name)
 	}
 
-	disposable, ok := val.(io.Closer)
-
-	if ok {
-		s.root.AddDisposable(disposable)
+	if closer, ok := val.(io.Closer); ok {
+		s.AddDisposable(closer)
 	}
 
-	s.vars[name] = val
+	s.names = append(s.names, name)
+	s.values = append(s.values, val)
 
 	return nil
 }
 
-func (s *Scope) HasVariable(name string) bool {
-	_, exists := s.vars[name]
+// AddDisposable registers a resource owned by the scope.
+// If the resource has been registered already, it keeps its owner.
+// Resources owned by the root scope get closed together with it.
+func (s *Scope) AddDisposable(closer io.Closer) {
+	if s.root.register(closer) && s.parent != nil {
+		s.disposables = append(s.disposables, closer)
+	}
+}
 
-	// does not exist in the current scope
-	// try to find in a parent scope
-	if !exists {
-		if s.parent != nil {
-			return s.parent.HasVariable(name)
+// AddSharedDisposable registers a resource that may be used by any scope of a query,
+// e.g. a cached value. It gets closed together with the root scope.
+func (s *Scope) AddSharedDisposable(closer io.Closer) {
+	s.root.register(closer)
+}
+
+// HasDisposables returns true if the scope owns resources.
+func (s *Scope) HasDisposables() bool {
+	return len(s.disposables) > 0
+}
+
+// Dispose closes resources owned by the scope.
+// It must be called only once values of the scope cannot be referred anymore.
+func (s *Scope) Dispose() {
+	for i, closer := range s.disposables {
+		s.root.dispose(closer)
+		s.disposables[i] = nil
+	}
+
+	s.disposables = s.disposables[:0]
+}
+
+// HandOver passes resources owned by the scope to its parent.
+// It is used when values of the scope may be referred after the scope is gone.
+func (s *Scope) HandOver() {
+	if len(s.disposables) == 0 {
+		return
+	}
+
+	// the root scope closes everything registered anyway
+	if s.parent != nil && s.parent.parent != nil {
+		s.parent.disposables = append(s.parent.disposables, s.disposables...)
+	}
+
+	for i := range s.disposables {
+		s.disposables[i] = nil
+	}
+
+	s.disposables = s.disposables[:0]
+}
+
+func (s *Scope) HasVariable(name string) bool {
+	for curr := s; curr != nil; curr = curr.parent {
+		if curr.indexOf(name) > -1 {
+			return true
 		}
 	}
 
-	return exists
+	return false
 }
 
 func (s *Scope) GetVariable(name string) (Value, error) {
-	out, exists := s.vars[name]
-
 	// does not exist in the current scope
 	// try to find in the parent scope
-	if !exists {
-		if s.parent != nil {
-			return s.parent.GetVariable(name)
+	for curr := s; curr != nil; curr = curr.parent {
+		if idx := curr.indexOf(name); idx > -1 {
+			return curr.values[idx], nil
 		}
+	}
+
+	return nil, Errorf(ErrNotFound, "variable: '%s'", name)
+}
+
+// GetVariableAt returns a variable by its position resolved at compile time:
+// the number of parent scopes to go up and the index of the variable in that scope.
+// Variables get their indexes in the order of declaration.
+// If the variable is not found at the given position, it is looked up by name.
+func (s *Scope) GetVariableAt(depth, slot int, name string) (Value, error) {
+	curr := s
 
-		return nil, Errorf(ErrNotFound, "variable: '%s'", name)
+	for i := 0; i < depth && curr != nil; i++ {
+		curr = curr.parent
 	}
 
-	return out, nil
+	if curr != nil && slot < len(curr.names) && curr.names[slot] == name {
+		return curr.values[slot], nil
+	}
+
+	return s.GetVariable(name)
 }
 
 func (s *Scope) MustGetVariable(name string) Value {
@@ -133,15 +274,43 @@ func (s *Scope) MustGetVariable(name string) Value {
 }
 
 func (s *Scope) UpdateVariable(name string, val Value) error {
-	_, exists := s.vars[name]
+	idx := s.indexOf(name)
 
-	if !exists {
+	if idx < 0 {
 		return Errorf(ErrNotFound, "variable: '%s'", name)
 	}
 
-	delete(s.vars, name)
+	if closer, ok := val.(io.Closer); ok {
+		s.AddDisposable(closer)
+	}
+
+	// the variable keeps its position
+	s.values[idx] = val
+
+	return nil
+}
+
+// State returns a value stored by a given key for the current run, or nil if there is none.
+func (s *Scope) State(key interface{}) interface{} {
+	if s.root == nil {
+		return nil
+	}
 
-	return s.SetVariable(name, val)
+	return s.root.state[key]
+}
+
+// SetState stores a value by a given key until the root scope gets closed.
+// It lets expressions keep data, like indexes, for the current run only.
+func (s *Scope) SetState(key, value interface{}) {
+	if s.root == nil || s.root.closed {
+		return
+	}
+
+	if s.root.state == nil {
+		s.root.state = make(map[interface{}]interface{})
+	}
+
+	s.root.state[key] = value
 }
 
 func (s *Scope) Fork() *Scope {
@@ -149,3 +318,46 @@ func (s *Scope) Fork() *Scope {
 
 	return child
 }
+
+// Release returns the scope to the pool of scopes.
+// It must be called only by the owner of the scope once neither the scope nor its children are used anymore.
+// Resources which are still owned by the scope are handed over to the parent scope.
+// Root scopes are never released.
+func (s *Scope) Release() {
+	if s.root == nil || s.parent == nil {
+		return
+	}
+
+	s.HandOver()
+
+	for i := range s.values {
+		s.values[i] = nil
+	}
+
+	s.root = nil
+	s.parent = nil
+	s.names = s.names[:0]
+	s.values = s.values[:0]
+
+	scopePool.Put(s)
+}
+
+// Locals returns variables declared in the scope and its parents up to a given ancestor (exclusive).
+// The second returned value is false if the given scope is not an ancestor of the current one.
+func (s *Scope) Locals(ancestor *Scope) (map[string]Value, bool) {
+	res := make(map[string]Value)
+
+	for curr := s; curr != nil; curr = curr.parent {
+		if curr == ancestor {
+			return res, true
+		}
+
+		for i, name := range curr.names {
+			if _, exists := res[name]; !exists {
+				res[name] = curr.values[i]
+			}
+		}
+	}
+
+	return nil, false
+}
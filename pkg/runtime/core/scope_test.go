@@ -150,6 +150,104 @@ func TestScope(t *testing.T) {
 			})
 		})
 	})
+
+	Convey(".GetVariableAt", t, func() {
+		Convey("Should return a variable by its position", func() {
+			rs, cf := core.NewRootScope()
+			So(cf, ShouldNotBeNil)
+
+			So(rs.SetVariable("foo", values.NewString("bar")), ShouldBeNil)
+			So(rs.SetVariable("faz", values.NewString("qaz")), ShouldBeNil)
+
+			cs := rs.Fork()
+			So(cs.SetVariable("baz", values.NewString("foo")), ShouldBeNil)
+
+			v, err := cs.GetVariableAt(1, 1, "faz")
+
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "qaz")
+
+			v, err = cs.GetVariableAt(0, 0, "baz")
+
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "foo")
+		})
+
+		Convey("Should look up a variable by name when the position does not match", func() {
+			rs, cf := core.NewRootScope()
+			So(cf, ShouldNotBeNil)
+
+			So(rs.SetVariable("foo", values.NewString("bar")), ShouldBeNil)
+
+			cs := rs.Fork()
+			So(cs.SetVariable("baz", values.NewString("foo")), ShouldBeNil)
+
+			v, err := cs.GetVariableAt(0, 0, "foo")
+
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "bar")
+
+			v, err = cs.GetVariableAt(5, 3, "foo")
+
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "bar")
+
+			_, err = cs.GetVariableAt(0, 0, "qaz")
+
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey(".UpdateVariable", t, func() {
+		Convey("Should keep a position of the variable", func() {
+			rs, cf := core.NewRootScope()
+			So(cf, ShouldNotBeNil)
+
+			So(rs.SetVariable("foo", values.NewString("bar")), ShouldBeNil)
+			So(rs.SetVariable("faz", values.NewString("qaz")), ShouldBeNil)
+			So(rs.UpdateVariable("foo", values.NewString("baz")), ShouldBeNil)
+
+			v, err := rs.GetVariableAt(0, 0, "foo")
+
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "baz")
+		})
+	})
+
+	Convey(".Release", t, func() {
+		Convey("Should not affect a parent scope", func() {
+			rs, cf := core.NewRootScope()
+			So(cf, ShouldNotBeNil)
+
+			So(rs.SetVariable("foo", values.NewString("bar")), ShouldBeNil)
+
+			cs := rs.Fork()
+			So(cs.SetVariable("faz", values.NewString("qaz")), ShouldBeNil)
+
+			cs.Release()
+
+			next := rs.Fork()
+
+			So(next.HasVariable("faz"), ShouldBeFalse)
+			So(next.SetVariable("faz", values.NewString("baz")), ShouldBeNil)
+
+			v, err := next.GetVariable("foo")
+
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "bar")
+		})
+
+		Convey("Should ignore a root scope", func() {
+			rs, cf := core.NewRootScope()
+			So(cf, ShouldNotBeNil)
+
+			So(rs.SetVariable("foo", values.NewString("bar")), ShouldBeNil)
+
+			rs.Release()
+
+			So(rs.HasVariable("foo"), ShouldBeTrue)
+		})
+	})
 }
 
 func BenchmarkScope(b *testing.B) {
@@ -160,6 +258,47 @@ func BenchmarkScope(b *testing.B) {
 	}
 }
 
+func BenchmarkScopeRelease(b *testing.B) {
+	root, _ := core.NewRootScope()
+
+	for n := 0; n < b.N; n++ {
+		s := root.Fork()
+		s.SetVariable("i", values.ZeroInt)
+		s.Release()
+	}
+}
+
+func newBenchmarkScope() *core.Scope {
+	root, _ := core.NewRootScope()
+	root.SetVariable("foo", values.NewString("bar"))
+	root.SetVariable("faz", values.NewString("qaz"))
+
+	s := root.Fork()
+	s.SetVariable("i", values.ZeroInt)
+	s.SetVariable("idx", values.ZeroInt)
+
+	s = s.Fork()
+	s.SetVariable("j", values.ZeroInt)
+
+	return s
+}
+
+func BenchmarkScopeGetVariable(b *testing.B) {
+	s := newBenchmarkScope()
+
+	for n := 0; n < b.N; n++ {
+		s.GetVariable("faz")
+	}
+}
+
+func BenchmarkScopeGetVariableAt(b *testing.B) {
+	s := newBenchmarkScope()
+
+	for n := 0; n < b.N; n++ {
+		s.GetVariableAt(2, 1, "faz")
+	}
+}
+
 type (
 	TestCloserType struct{}
 
@@ -250,3 +389,127 @@ func TestCloseFunc(t *testing.T) {
 		So(err, ShouldHaveSameTypeAs, core.ErrInvalidOperation)
 	})
 }
+
+func TestDispose(t *testing.T) {
+	Convey(".Dispose", t, func() {
+		Convey("Should close resources owned by a forked scope only once", func() {
+			rs, cf := core.NewRootScope()
+
+			tc := &TestCloserValue{}
+
+			cs := rs.Fork()
+			So(cs.SetVariable("disposable", tc), ShouldBeNil)
+			So(cs.HasDisposables(), ShouldBeTrue)
+
+			cs.Dispose()
+
+			So(tc.closed, ShouldBeTrue)
+			So(cs.HasDisposables(), ShouldBeFalse)
+
+			So(cf(), ShouldBeNil)
+		})
+
+		Convey("Should not close resources registered by a parent scope", func() {
+			rs, cf := core.NewRootScope()
+
+			tc := &TestCloserValue{}
+
+			So(rs.SetVariable("disposable", tc), ShouldBeNil)
+
+			cs := rs.Fork()
+			So(cs.SetVariable("alias", tc), ShouldBeNil)
+			So(cs.HasDisposables(), ShouldBeFalse)
+
+			cs.Dispose()
+
+			So(tc.closed, ShouldBeFalse)
+
+			So(cf(), ShouldBeNil)
+			So(tc.closed, ShouldBeTrue)
+		})
+
+		Convey("Should report errors when the root scope gets closed", func() {
+			rs, cf := core.NewRootScope()
+
+			tc := &TestCloserValue{closed: true}
+
+			cs := rs.Fork()
+			So(cs.SetVariable("disposable", tc), ShouldBeNil)
+
+			cs.Dispose()
+
+			So(cf(), ShouldNotBeNil)
+		})
+	})
+
+	Convey(".Release", t, func() {
+		Convey("Should hand over resources to a parent scope", func() {
+			rs, cf := core.NewRootScope()
+
+			tc := &TestCloserValue{}
+
+			parent := rs.Fork()
+			child := parent.Fork()
+			So(child.SetVariable("disposable", tc), ShouldBeNil)
+
+			child.Release()
+
+			So(tc.closed, ShouldBeFalse)
+			So(parent.HasDisposables(), ShouldBeTrue)
+
+			parent.Dispose()
+
+			So(tc.closed, ShouldBeTrue)
+
+			So(cf(), ShouldBeNil)
+		})
+
+		Convey("Should leave resources of a child of the root scope to the root scope", func() {
+			rs, cf := core.NewRootScope()
+
+			tc := &TestCloserValue{}
+
+			cs := rs.Fork()
+			So(cs.SetVariable("disposable", tc), ShouldBeNil)
+
+			cs.Release()
+
+			So(tc.closed, ShouldBeFalse)
+
+			So(cf(), ShouldBeNil)
+			So(tc.closed, ShouldBeTrue)
+		})
+	})
+
+	Convey(".State", t, func() {
+		Convey("Should share a state between scopes of a run", func() {
+			rs, cf := core.NewRootScope()
+
+			cs := rs.Fork()
+			cs.SetState("key", 1)
+
+			So(rs.State("key"), ShouldEqual, 1)
+			So(rs.Fork().State("key"), ShouldEqual, 1)
+
+			other, otherCf := core.NewRootScope()
+
+			So(other.State("key"), ShouldBeNil)
+
+			So(cf(), ShouldBeNil)
+			So(otherCf(), ShouldBeNil)
+		})
+
+		Convey("Should drop a state when the root scope gets closed", func() {
+			rs, cf := core.NewRootScope()
+
+			rs.SetState("key", 1)
+
+			So(cf(), ShouldBeNil)
+			So(rs.State("key"), ShouldBeNil)
+
+			rs.SetState("key", 2)
+
+			So(rs.State("key"), ShouldBeNil)
+		})
+	})
+}
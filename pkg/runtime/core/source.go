@@ -20,6 +20,10 @@ func (s SourceMap) Column() int {
 	return s.column
 }
 
+func (s SourceMap) Text() string {
+	return s.text
+}
+
 func (s SourceMap) String() string {
 	return fmt.Sprintf("%s at %d:%d", s.text, s.line, s.column)
 }
@@ -58,3 +58,7 @@ func (exp *BlockExpression) Iterate(ctx context.Context, scope *core.Scope) (col
 		return collections.NewTapIterator(iter, exp)
 	}
 }
+
+func (exp *BlockExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("BlockExpression").Add(exp.values).AddExpressions(exp.statements)
+}
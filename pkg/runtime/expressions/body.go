@@ -49,3 +49,7 @@ func (b *BodyExpression) Exec(ctx context.Context, scope *core.Scope) (core.Valu
 
 	return values.None, nil
 }
+
+func (b *BodyExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("BodyExpression").AddExpressions(b.statements).Add(b.expression)
+}
@@ -0,0 +1,93 @@
+package expressions
+
+import (
+	"context"
+	"time"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// CacheExpression reuses a result of an expression within a run for keys having equal hashes,
+// e.g. CACHE(url, 60000, DOCUMENT(url)).
+// The result expires after a given number of milliseconds, or at the end of the run if it is not positive.
+type CacheExpression struct {
+	src core.SourceMap
+	key core.Expression
+	ttl core.Expression
+	exp core.Expression
+}
+
+func NewCacheExpression(
+	src core.SourceMap,
+	key,
+	ttl,
+	exp core.Expression,
+) (*CacheExpression, error) {
+	if key == nil {
+		return nil, core.Error(core.ErrMissedArgument, "key")
+	}
+
+	if ttl == nil {
+		return nil, core.Error(core.ErrMissedArgument, "ttl")
+	}
+
+	if exp == nil {
+		return nil, core.Error(core.ErrMissedArgument, "expression")
+	}
+
+	return &CacheExpression{src, key, ttl, exp}, nil
+}
+
+func (e *CacheExpression) Exec(ctx context.Context, scope *core.Scope) (core.Value, error) {
+	key, err := e.key.Exec(ctx, scope)
+
+	if err != nil {
+		return values.None, core.SourceError(e.src, err)
+	}
+
+	ttl, err := e.ttl.Exec(ctx, scope)
+
+	if err != nil {
+		return values.None, core.SourceError(e.src, err)
+	}
+
+	if err := core.ValidateType(ttl, types.Int, types.Float); err != nil {
+		return values.None, core.SourceError(e.src, err)
+	}
+
+	cache := core.CacheFrom(ctx)
+
+	if cache == nil {
+		return e.exp.Exec(ctx, scope)
+	}
+
+	// function names are never empty, so results of expressions cannot clash with results of function calls
+	hash := key.Hash()
+
+	if out, found := cache.Get("", hash); found {
+		return out, nil
+	}
+
+	out, err := e.exp.Exec(ctx, scope)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	cache.Set("", hash, out, time.Duration(values.ToInt(ttl))*time.Millisecond)
+	addSharedDisposables(scope, out)
+
+	return out, nil
+}
+
+func (e *CacheExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("CacheExpression").
+		WithSource(e.src).
+		Add(
+			core.NewPlanNode("Key").Add(e.key),
+			core.NewPlanNode("TTL").Add(e.ttl),
+			e.exp,
+		)
+}
@@ -2,16 +2,21 @@ package clauses
 
 import (
 	"context"
+	"strings"
 
 	"github.com/MontFerret/ferret/pkg/runtime/collections"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 )
 
 type (
+	// CollectMethod defines how a COLLECT clause groups its data source.
+	CollectMethod int
+
 	Collect struct {
 		group     *CollectGroup
 		count     *CollectCount
 		aggregate *CollectAggregate
+		method    CollectMethod
 	}
 
 	CollectGroup struct {
@@ -40,13 +45,67 @@ type (
 	}
 )
 
+const (
+	// CollectMethodDefault sorts the data source by grouping keys and keeps all groups in memory.
+	// Groups are returned in the order of grouping keys.
+	CollectMethodDefault CollectMethod = iota
+	// CollectMethodSorted expects the data source to be sorted by grouping keys already
+	// and keeps only the current group in memory.
+	// Groups are returned in the order of the data source.
+	CollectMethodSorted
+	// CollectMethodHash groups the data source in a hash table.
+	// If spilling is enabled, the number of groups kept in memory is limited by the spill threshold
+	// and rows of the other groups are written to temporary files and grouped afterwards.
+	// Groups are returned in no particular order.
+	CollectMethodHash
+)
+
+func CollectMethodFromString(str string) (CollectMethod, error) {
+	switch strings.ToLower(str) {
+	case "sorted":
+		return CollectMethodSorted, nil
+	case "hash":
+		return CollectMethodHash, nil
+	default:
+		return CollectMethodDefault, core.Error(core.ErrInvalidArgument, "collect method: "+str)
+	}
+}
+
+func (method CollectMethod) String() string {
+	switch method {
+	case CollectMethodSorted:
+		return "sorted"
+	case CollectMethodHash:
+		return "hash"
+	default:
+		return "default"
+	}
+}
+
 func NewCollect(
 	selectors []*CollectSelector,
 	projection *CollectProjection,
 	count *CollectCount,
 	aggregate *CollectAggregate,
 ) (*Collect, error) {
+	return NewCollectWith(selectors, projection, count, aggregate, CollectMethodDefault)
+}
+
+func NewCollectWith(
+	selectors []*CollectSelector,
+	projection *CollectProjection,
+	count *CollectCount,
+	aggregate *CollectAggregate,
+	method CollectMethod,
+) (*Collect, error) {
+	switch method {
+	case CollectMethodDefault, CollectMethodSorted, CollectMethodHash:
+	default:
+		return nil, core.Error(core.ErrInvalidArgument, "collect method")
+	}
+
 	collect := new(Collect)
+	collect.method = method
 
 	// grouping
 	if selectors != nil {
@@ -132,3 +191,56 @@ func (clause *CollectClause) Iterate(ctx context.Context, scope *core.Scope) (co
 		srcIterator,
 	)
 }
+
+func (clause *CollectClause) Explain() *core.PlanNode {
+	node := core.NewPlanNode("CollectClause").WithSource(clause.src).Add(clause.dataSource)
+	params := clause.params
+
+	if params.method != CollectMethodDefault {
+		node.Set("method", params.method.String())
+	}
+
+	if params.group != nil {
+		for _, selector := range params.group.selectors {
+			node.Add(selector.explain("Group"))
+		}
+
+		if params.group.projection != nil {
+			node.Add(params.group.projection.selector.explain("Into"))
+		}
+
+		if params.group.count != nil {
+			node.Add(core.NewPlanNode("Count").Set("variable", params.group.count.variable))
+		}
+
+		if params.group.aggregate != nil {
+			node.Add(params.group.aggregate.explain())
+		}
+	}
+
+	if params.count != nil {
+		node.Add(core.NewPlanNode("Count").Set("variable", params.count.variable))
+	}
+
+	if params.aggregate != nil {
+		node.Add(params.aggregate.explain())
+	}
+
+	return node
+}
+
+func (selector *CollectSelector) explain(typeName string) *core.PlanNode {
+	return core.NewPlanNode(typeName).Set("variable", selector.variable).Add(selector.expression)
+}
+
+func (aggregate *CollectAggregate) explain() *core.PlanNode {
+	node := core.NewPlanNode("Aggregate")
+
+	for _, selector := range aggregate.selectors {
+		node.Add(core.NewPlanNode("AggregateSelector").
+			Set("variable", selector.variable).
+			AddExpressions(selector.aggregators))
+	}
+
+	return node
+}
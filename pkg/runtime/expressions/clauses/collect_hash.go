@@ -0,0 +1,227 @@
+package clauses
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/collections"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// nextHash returns the next group of a data source grouped in a hash table.
+// Groups are built in passes. While the number of groups is below the group limit of spill options,
+// rows are added to groups in memory, otherwise rows of new groups are written to a temporary file.
+// Once the groups of a pass are returned, the next pass groups the rows of that file.
+func (iterator *CollectIterator) nextHash(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
+	for {
+		if len(iterator.values) > iterator.pos {
+			group := iterator.values[iterator.pos]
+			iterator.values[iterator.pos] = nil
+			iterator.pos++
+
+			return group, nil
+		}
+
+		if iterator.ready && iterator.overflow == nil {
+			return nil, nil
+		}
+
+		if err := iterator.hashPass(ctx, scope); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (iterator *CollectIterator) hashPass(ctx context.Context, scope *core.Scope) error {
+	// the first pass reads the data source, the others read rows spilled by the previous one
+	var input *collections.SpillFile
+
+	if iterator.ready {
+		input = iterator.overflow
+		iterator.overflow = nil
+
+		if err := input.Rewind(); err != nil {
+			return err
+		}
+	}
+
+	iterator.ready = true
+
+	opts := collections.SpillFrom(ctx)
+	groups := make([]*core.Scope, 0, 10)
+	hashTable := make(map[uint64]*core.Scope)
+	// hashes of spilled rows, rows of these groups must not be grouped in this pass
+	spilled := make(map[uint64]bool)
+
+	for {
+		row, err := iterator.nextHashRow(ctx, scope, input)
+
+		if err != nil {
+			return err
+		}
+
+		if row == nil {
+			break
+		}
+
+		group, exists := hashTable[row.hash]
+
+		if !exists {
+			if spilled[row.hash] || (opts != nil && opts.GroupLimit() > 0 && len(groups) >= opts.GroupLimit()) {
+				ok, err := iterator.spillRow(opts, scope, row)
+
+				if err != nil {
+					return err
+				}
+
+				if ok {
+					spilled[row.hash] = true
+
+					continue
+				}
+
+				if spilled[row.hash] {
+					return core.Error(core.ErrInvalidOperation, "collect row cannot be written to disk")
+				}
+			}
+
+			group, err = iterator.newGroup(scope, row)
+
+			if err != nil {
+				return err
+			}
+
+			groups = append(groups, group)
+			hashTable[row.hash] = group
+		}
+
+		if err := iterator.accumulate(group, row); err != nil {
+			return err
+		}
+	}
+
+	if input != nil {
+		if err := input.Close(); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range groups {
+		if err := iterator.finalize(ctx, group); err != nil {
+			return err
+		}
+	}
+
+	iterator.values = groups
+	iterator.pos = 0
+
+	return nil
+}
+
+func (iterator *CollectIterator) nextHashRow(ctx context.Context, scope *core.Scope, input *collections.SpillFile) (*collectRow, error) {
+	if input == nil {
+		return iterator.nextRow(ctx, scope)
+	}
+
+	value, err := input.Read()
+
+	if err != nil || value == nil {
+		return nil, err
+	}
+
+	return iterator.decodeRow(value)
+}
+
+// spillRow writes a given row to the temporary file of the current pass.
+// It returns false if the row has values that cannot be written to disk.
+func (iterator *CollectIterator) spillRow(opts *collections.SpillOptions, scope *core.Scope, row *collectRow) (bool, error) {
+	if opts == nil {
+		return false, nil
+	}
+
+	encoded := iterator.encodeRow(row)
+
+	if !collections.IsSpillable(encoded) {
+		return false, nil
+	}
+
+	if iterator.overflow == nil {
+		file, err := collections.NewSpillFile(opts)
+
+		if err != nil {
+			return false, err
+		}
+
+		// the file must be removed even if the query gets terminated before it is read
+		scope.AddDisposable(file)
+		iterator.overflow = file
+	}
+
+	if err := iterator.overflow.Write(encoded); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// encodeRow represents a given row as [keys, projection, args].
+func (iterator *CollectIterator) encodeRow(row *collectRow) core.Value {
+	projection := row.projection
+
+	if projection == nil {
+		projection = values.None
+	}
+
+	args := values.NewArray(len(row.args))
+
+	for _, selectorArgs := range row.args {
+		args.Push(values.NewArrayWith(selectorArgs...))
+	}
+
+	return values.NewArrayWith(values.NewArrayWith(row.keys...), projection, args)
+}
+
+func (iterator *CollectIterator) decodeRow(value core.Value) (*collectRow, error) {
+	encoded, ok := value.(*values.Array)
+
+	if !ok || encoded.Length() != 3 {
+		return nil, core.Error(core.ErrUnexpected, "collect row")
+	}
+
+	row := &collectRow{
+		keys:       toValues(encoded.Get(0)),
+		projection: encoded.Get(1),
+	}
+
+	row.hash = iterator.hashKeys(row.keys)
+
+	if args, ok := encoded.Get(2).(*values.Array); ok && args.Length() > 0 {
+		row.args = make([][]core.Value, 0, args.Length())
+
+		args.ForEach(func(selectorArgs core.Value, _ int) bool {
+			row.args = append(row.args, toValues(selectorArgs))
+
+			return true
+		})
+	}
+
+	return row, nil
+}
+
+func toValues(value core.Value) []core.Value {
+	arr, ok := value.(*values.Array)
+
+	if !ok {
+		return nil
+	}
+
+	res := make([]core.Value, 0, arr.Length())
+
+	arr.ForEach(func(item core.Value, _ int) bool {
+		res = append(res, item)
+
+		return true
+	})
+
+	return res
+}
@@ -9,14 +9,27 @@ import (
 	"github.com/MontFerret/ferret/pkg/runtime/values/types"
 )
 
-type CollectIterator struct {
-	ready      bool
-	values     []*core.Scope
-	pos        int
-	src        core.SourceMap
-	params     *Collect
-	dataSource collections.Iterator
-}
+type (
+	CollectIterator struct {
+		ready      bool
+		values     []*core.Scope
+		pos        int
+		src        core.SourceMap
+		params     *Collect
+		dataSource collections.Iterator
+		done       bool
+		pending    *collectRow
+		overflow   *collections.SpillFile
+	}
+
+	// collectRow is a row of the data source evaluated by grouping, projection and aggregate expressions.
+	collectRow struct {
+		hash       uint64
+		keys       []core.Value
+		projection core.Value
+		args       [][]core.Value
+	}
+)
 
 func NewCollectIterator(
 	src core.SourceMap,
@@ -24,7 +37,9 @@ func NewCollectIterator(
 	dataSource collections.Iterator,
 ) (*CollectIterator, error) {
 	if params.group != nil {
-		if params.group.selectors != nil {
+		// the default method groups the data source sorted by grouping keys,
+		// other methods either expect it to be sorted already or do not need it to be sorted
+		if params.group.selectors != nil && params.method == CollectMethodDefault {
 			var err error
 			sorters := make([]*collections.Sorter, len(params.group.selectors))
 
@@ -51,12 +66,9 @@ func NewCollectIterator(
 	}
 
 	return &CollectIterator{
-		false,
-		nil,
-		0,
-		src,
-		params,
-		dataSource,
+		src:        src,
+		params:     params,
+		dataSource: dataSource,
 	}, nil
 }
 
@@ -79,6 +91,15 @@ func newGroupSorter(selector *CollectSelector) (*collections.Sorter, error) {
 }
 
 func (iterator *CollectIterator) Next(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
+	if iterator.params.group != nil {
+		switch iterator.params.method {
+		case CollectMethodSorted:
+			return iterator.nextSorted(ctx, scope)
+		case CollectMethodHash:
+			return iterator.nextHash(ctx, scope)
+		}
+	}
+
 	if !iterator.ready {
 		iterator.ready = true
 		groups, err := iterator.init(ctx, scope)
@@ -117,208 +138,362 @@ func (iterator *CollectIterator) init(ctx context.Context, scope *core.Scope) ([
 }
 
 func (iterator *CollectIterator) group(ctx context.Context, scope *core.Scope) ([]*core.Scope, error) {
-	// TODO: honestly, this code is ugly. it needs to be refactored in more chained way with much less if statements
 	// slice of groups
 	collected := make([]*core.Scope, 0, 10)
 	// hash table of unique values
-	// key is a DataSet hash
+	// key is a hash of grouping keys
 	// value is its index in result slice (collected)
 	hashTable := make(map[uint64]int)
 
-	groupSelectors := iterator.params.group.selectors
-	proj := iterator.params.group.projection
-	count := iterator.params.group.count
-	aggr := iterator.params.group.aggregate
-
 	// iterating over underlying data source
 	for {
-		// keep all defined variables in forked scopes
-		// all those variables should not be available for further executions
-		dataSourceScope, err := iterator.dataSource.Next(ctx, scope.Fork())
+		row, err := iterator.nextRow(ctx, scope)
 
 		if err != nil {
 			return nil, err
 		}
 
-		if dataSourceScope == nil {
+		if row == nil {
 			break
 		}
 
-		// this data dataSourceScope represents a data of a given iteration with values retrieved by selectors
-		collectScope := scope.Fork()
-
-		// map for calculating a hash value
-		vals := make(map[string]core.Value)
+		idx, exists := hashTable[row.hash]
 
-		// iterate over each selector for a current data
-		for _, selector := range groupSelectors {
-			// execute a selector and get a value
-			// e.g. COLLECT age = u.age
-			value, err := selector.expression.Exec(ctx, dataSourceScope)
+		if !exists {
+			group, err := iterator.newGroup(scope, row)
 
 			if err != nil {
 				return nil, err
 			}
 
-			if err := collectScope.SetVariable(selector.variable, value); err != nil {
-				return nil, err
+			collected = append(collected, group)
+			idx = len(collected) - 1
+			hashTable[row.hash] = idx
+		}
+
+		if err := iterator.accumulate(collected[idx], row); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, group := range collected {
+		if err := iterator.finalize(ctx, group); err != nil {
+			return nil, err
+		}
+	}
+
+	return collected, nil
+}
+
+// nextSorted returns the next group of a data source sorted by grouping keys.
+// Since rows of a group follow each other, only the current group is kept in memory.
+// If the data source is not sorted, rows with equal keys that do not follow each other end up in different groups.
+func (iterator *CollectIterator) nextSorted(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
+	row := iterator.pending
+	iterator.pending = nil
+
+	if row == nil {
+		next, err := iterator.nextRow(ctx, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if next == nil {
+			return nil, nil
+		}
+
+		row = next
+	}
+
+	group, err := iterator.newGroup(scope, row)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hash := row.hash
+
+	for row != nil {
+		if row.hash != hash {
+			iterator.pending = row
+
+			break
+		}
+
+		if err := iterator.accumulate(group, row); err != nil {
+			return nil, err
+		}
+
+		row, err = iterator.nextRow(ctx, scope)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := iterator.finalize(ctx, group); err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// nextRow evaluates the next row of the data source.
+// It returns nil when the data source is exhausted.
+func (iterator *CollectIterator) nextRow(ctx context.Context, scope *core.Scope) (*collectRow, error) {
+	if iterator.done {
+		return nil, nil
+	}
+
+	dataSourceScope, err := iterator.dataSource.Next(ctx, scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dataSourceScope == nil {
+		iterator.done = true
+
+		return nil, nil
+	}
+
+	row, err := iterator.evaluate(ctx, dataSourceScope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// resources owned by the row are closed unless its group may refer to them
+	if dataSourceScope.HasDisposables() && row.isPlain() {
+		dataSourceScope.Dispose()
+	}
+
+	dataSourceScope.Release()
+
+	return row, nil
+}
+
+func (row *collectRow) isPlain() bool {
+	for _, key := range row.keys {
+		if !values.IsPlain(key) {
+			return false
+		}
+	}
+
+	if row.projection != nil && !values.IsPlain(row.projection) {
+		return false
+	}
+
+	for _, args := range row.args {
+		for _, arg := range args {
+			if !values.IsPlain(arg) {
+				return false
 			}
+		}
+	}
+
+	return true
+}
+
+func (iterator *CollectIterator) evaluate(ctx context.Context, dataSourceScope *core.Scope) (*collectRow, error) {
+	group := iterator.params.group
+	row := &collectRow{
+		keys: make([]core.Value, len(group.selectors)),
+	}
 
-			vals[selector.variable] = value
+	// iterate over each selector for a current data
+	for i, selector := range group.selectors {
+		// execute a selector and get a value
+		// e.g. COLLECT age = u.age
+		value, err := selector.expression.Exec(ctx, dataSourceScope)
+
+		if err != nil {
+			return nil, err
 		}
 
-		// it important to get hash value before projection and counting
-		// otherwise hash value will be inaccurate
-		h := values.MapHash(vals)
+		row.keys[i] = value
+	}
 
-		_, exists := hashTable[h]
+	row.hash = iterator.hashKeys(row.keys)
 
-		if !exists {
-			collected = append(collected, collectScope)
-			hashTable[h] = len(collected) - 1
+	if group.projection != nil {
+		value, err := group.projection.selector.expression.Exec(ctx, dataSourceScope)
 
-			if proj != nil {
-				// create a new variable for keeping projection
-				if err := collectScope.SetVariable(proj.selector.variable, values.NewArray(10)); err != nil {
-					return nil, err
-				}
-			} else if count != nil {
-				// create a new variable for keeping counter
-				if err := collectScope.SetVariable(count.variable, values.ZeroInt); err != nil {
+		if err != nil {
+			return nil, err
+		}
+
+		row.projection = value
+	}
+
+	if group.aggregate != nil {
+		row.args = make([][]core.Value, len(group.aggregate.selectors))
+
+		// e.g. AGGREGATE age = CONCAT(u.age, u.dob)
+		// u.age and u.dob get executed
+		for i, selector := range group.aggregate.selectors {
+			args := make([]core.Value, len(selector.aggregators))
+
+			for idx, exp := range selector.aggregators {
+				arg, err := exp.Exec(ctx, dataSourceScope)
+
+				if err != nil {
 					return nil, err
 				}
-			} else if aggr != nil {
-				// create a new variable for keeping aggregated values
-				for _, selector := range aggr.selectors {
-					arr := values.NewArray(len(selector.aggregators))
-
-					for range selector.aggregators {
-						arr.Push(values.None)
-					}
-
-					if err := collectScope.SetVariable(selector.variable, arr); err != nil {
-						return nil, err
-					}
-				}
+
+				args[idx] = arg
 			}
+
+			row.args[i] = args
 		}
+	}
 
-		if proj != nil {
-			idx := hashTable[h]
-			collectedScope := collected[idx]
-			groupValue, err := collectedScope.GetVariable(proj.selector.variable)
+	return row, nil
+}
 
-			if err != nil {
-				return nil, err
-			}
+func (iterator *CollectIterator) hashKeys(keys []core.Value) uint64 {
+	// map for calculating a hash value
+	vals := make(map[string]core.Value, len(keys))
 
-			arr, ok := groupValue.(*values.Array)
+	for i, selector := range iterator.params.group.selectors {
+		vals[selector.variable] = keys[i]
+	}
 
-			if !ok {
-				return nil, core.TypeError(groupValue.Type(), types.Int)
-			}
+	return values.MapHash(vals)
+}
 
-			value, err := proj.selector.expression.Exec(ctx, dataSourceScope)
+// newGroup creates a scope of a group with grouping keys of a given row
+// and empty projection, counter or aggregated values.
+func (iterator *CollectIterator) newGroup(scope *core.Scope, row *collectRow) (*core.Scope, error) {
+	params := iterator.params.group
+	group := scope.Fork()
 
-			if err != nil {
-				return nil, err
-			}
+	for i, selector := range params.selectors {
+		if err := group.SetVariable(selector.variable, row.keys[i]); err != nil {
+			return nil, err
+		}
+	}
 
-			arr.Push(value)
-		} else if count != nil {
-			idx := hashTable[h]
-			ds := collected[idx]
-			groupValue, err := ds.GetVariable(count.variable)
+	switch {
+	case params.projection != nil:
+		// create a new variable for keeping projection
+		if err := group.SetVariable(params.projection.selector.variable, values.NewArray(10)); err != nil {
+			return nil, err
+		}
+	case params.count != nil:
+		// create a new variable for keeping counter
+		if err := group.SetVariable(params.count.variable, values.ZeroInt); err != nil {
+			return nil, err
+		}
+	case params.aggregate != nil:
+		// create a new variable for keeping aggregated values
+		for _, selector := range params.aggregate.selectors {
+			arr := values.NewArray(len(selector.aggregators))
 
-			if err != nil {
+			for range selector.aggregators {
+				arr.Push(values.NewArray(10))
+			}
+
+			if err := group.SetVariable(selector.variable, arr); err != nil {
 				return nil, err
 			}
+		}
+	}
 
-			counter, ok := groupValue.(values.Int)
+	return group, nil
+}
 
-			if !ok {
-				return nil, core.TypeError(groupValue.Type(), types.Int)
-			}
+// accumulate adds a given row to a group.
+func (iterator *CollectIterator) accumulate(group *core.Scope, row *collectRow) error {
+	params := iterator.params.group
 
-			groupValue = counter + 1
-			// dataSourceScope a new value
-			if err := ds.UpdateVariable(count.variable, groupValue); err != nil {
-				return nil, err
-			}
-		} else if aggr != nil {
-			idx := hashTable[h]
-			ds := collected[idx]
+	switch {
+	case params.projection != nil:
+		groupValue, err := group.GetVariable(params.projection.selector.variable)
 
-			// iterate over each selector for a current data dataSourceScope
-			for _, selector := range aggr.selectors {
-				sv, err := ds.GetVariable(selector.variable)
+		if err != nil {
+			return err
+		}
 
-				if err != nil {
-					return nil, err
-				}
+		arr, ok := groupValue.(*values.Array)
 
-				vv := sv.(*values.Array)
+		if !ok {
+			return core.TypeError(groupValue.Type(), types.Array)
+		}
+
+		arr.Push(row.projection)
+	case params.count != nil:
+		groupValue, err := group.GetVariable(params.count.variable)
 
-				// execute a selector and get a value
-				// e.g. AGGREGATE age = CONCAT(u.age, u.dob)
-				// u.age and u.dob get executed
-				for idx, exp := range selector.aggregators {
-					arg, err := exp.Exec(ctx, dataSourceScope)
+		if err != nil {
+			return err
+		}
 
-					if err != nil {
-						return nil, err
-					}
+		counter, ok := groupValue.(values.Int)
 
-					var args *values.Array
-					idx := values.NewInt(idx)
+		if !ok {
+			return core.TypeError(groupValue.Type(), types.Int)
+		}
 
-					if vv.Get(idx) == values.None {
-						args = values.NewArray(10)
-						vv.Set(idx, args)
-					} else {
-						args = vv.Get(idx).(*values.Array)
-					}
+		return group.UpdateVariable(params.count.variable, counter+1)
+	case params.aggregate != nil:
+		for i, selector := range params.aggregate.selectors {
+			sv, err := group.GetVariable(selector.variable)
 
-					args.Push(arg)
-				}
+			if err != nil {
+				return err
+			}
+
+			vv := sv.(*values.Array)
+
+			for idx, arg := range row.args[i] {
+				vv.Get(values.NewInt(idx)).(*values.Array).Push(arg)
 			}
 		}
 	}
 
-	if aggr != nil {
-		for _, iterScope := range collected {
-			for _, selector := range aggr.selectors {
-				sv, err := iterScope.GetVariable(selector.variable)
+	return nil
+}
+
+// finalize reduces aggregated values of a group.
+func (iterator *CollectIterator) finalize(ctx context.Context, group *core.Scope) error {
+	aggr := iterator.params.group.aggregate
+
+	if aggr == nil {
+		return nil
+	}
+
+	for _, selector := range aggr.selectors {
+		sv, err := group.GetVariable(selector.variable)
 
-				if err != nil {
-					return nil, err
-				}
+		if err != nil {
+			return err
+		}
 
-				arr := sv.(*values.Array)
+		arr := sv.(*values.Array)
 
-				matrix := make([]core.Value, arr.Length())
+		matrix := make([]core.Value, arr.Length())
 
-				arr.ForEach(func(value core.Value, idx int) bool {
-					matrix[idx] = value
+		arr.ForEach(func(value core.Value, idx int) bool {
+			matrix[idx] = value
 
-					return true
-				})
+			return true
+		})
 
-				reduced, err := selector.reducer(ctx, matrix...)
+		reduced, err := selector.reducer(ctx, matrix...)
 
-				if err != nil {
-					return nil, err
-				}
+		if err != nil {
+			return err
+		}
 
-				// replace value with calculated one
-				if err := iterScope.UpdateVariable(selector.variable, reduced); err != nil {
-					return nil, err
-				}
-			}
+		// replace value with calculated one
+		if err := group.UpdateVariable(selector.variable, reduced); err != nil {
+			return err
 		}
 	}
 
-	return collected, nil
+	return nil
 }
 
 func (iterator *CollectIterator) count(ctx context.Context, scope *core.Scope) ([]*core.Scope, error) {
@@ -328,7 +503,7 @@ func (iterator *CollectIterator) count(ctx context.Context, scope *core.Scope) (
 	for {
 		// keep all defined variables in forked scopes
 		// all those variables should not be available for further executions
-		os, err := iterator.dataSource.Next(ctx, scope.Fork())
+		os, err := iterator.dataSource.Next(ctx, scope)
 
 		if err != nil {
 			return nil, err
@@ -338,6 +513,9 @@ func (iterator *CollectIterator) count(ctx context.Context, scope *core.Scope) (
 			break
 		}
 
+		os.Dispose()
+		os.Release()
+
 		counter++
 	}
 
@@ -365,7 +543,7 @@ func (iterator *CollectIterator) aggregate(ctx context.Context, scope *core.Scop
 	for {
 		// keep all defined variables in forked scopes
 		// all those variables should not be available for further executions
-		os, err := iterator.dataSource.Next(ctx, scope.Fork())
+		os, err := iterator.dataSource.Next(ctx, scope)
 
 		if err != nil {
 			return nil, err
@@ -406,6 +584,8 @@ func (iterator *CollectIterator) aggregate(ctx context.Context, scope *core.Scop
 				args.Push(arg)
 			}
 		}
+
+		os.Release()
 	}
 
 	for _, selector := range selectors {
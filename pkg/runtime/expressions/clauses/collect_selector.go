@@ -12,6 +12,7 @@ type (
 		variable    string
 		aggregators []core.Expression
 		reducer     core.Function
+		incremental bool
 	}
 )
 
@@ -36,6 +37,19 @@ func (selector *CollectSelector) Expression() core.Expression {
 }
 
 func NewCollectAggregateSelector(variable string, aggr []core.Expression, reducer core.Function) (*CollectAggregateSelector, error) {
+	return NewCollectAggregateSelectorWith(variable, aggr, reducer, false)
+}
+
+// NewCollectAggregateSelectorWith returns an aggregate selector.
+// A reducer is incremental if it can reduce its own result along with the rest of values, like SUM:
+// reducer([a, b, c]) equals reducer([reducer([a, b]), c]).
+// Aggregates of growing windows are calculated by incremental reducers in linear time.
+func NewCollectAggregateSelectorWith(
+	variable string,
+	aggr []core.Expression,
+	reducer core.Function,
+	incremental bool,
+) (*CollectAggregateSelector, error) {
 	if variable == "" {
 		return nil, core.Error(core.ErrMissedArgument, "selector variable")
 	}
@@ -48,7 +62,7 @@ func NewCollectAggregateSelector(variable string, aggr []core.Expression, reduce
 		return nil, core.Error(core.ErrMissedArgument, "selector aggregators")
 	}
 
-	return &CollectAggregateSelector{variable, aggr, reducer}, nil
+	return &CollectAggregateSelector{variable, aggr, reducer, incremental}, nil
 }
 
 func (selector *CollectAggregateSelector) Variable() string {
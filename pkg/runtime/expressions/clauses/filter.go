@@ -55,3 +55,7 @@ func (clause *FilterClause) filter(ctx context.Context, scope *core.Scope) (bool
 
 	return false, nil
 }
+
+func (clause *FilterClause) Explain() *core.PlanNode {
+	return core.NewPlanNode("FilterClause").WithSource(clause.src).Add(clause.dataSource, clause.predicate)
+}
@@ -8,9 +8,12 @@ import (
 	"github.com/MontFerret/ferret/pkg/runtime/values/types"
 )
 
+const maxInt = int(^uint(0) >> 1)
+
 type LimitClause struct {
 	src        core.SourceMap
 	dataSource collections.Iterable
+	sort       *SortClause
 	count      core.Expression
 	offset     core.Expression
 }
@@ -25,16 +28,26 @@ func NewLimitClause(
 		return nil, core.Error(core.ErrMissedArgument, "dataSource source")
 	}
 
-	return &LimitClause{src, dataSource, count, offset}, nil
+	return &LimitClause{src, dataSource, nil, count, offset}, nil
 }
 
-func (clause *LimitClause) Iterate(ctx context.Context, scope *core.Scope) (collections.Iterator, error) {
-	src, err := clause.dataSource.Iterate(ctx, scope)
-
-	if err != nil {
-		return nil, core.SourceError(clause.src, err)
+// NewSortLimitClause creates a LIMIT clause applied immediately to a SORT clause.
+// Since only the first offset + count items are needed, the data source is sorted with a bounded top-K heap
+// instead of sorting all of it.
+func NewSortLimitClause(
+	src core.SourceMap,
+	sort *SortClause,
+	count core.Expression,
+	offset core.Expression,
+) (collections.Iterable, error) {
+	if sort == nil {
+		return nil, core.Error(core.ErrMissedArgument, "sort clause")
 	}
 
+	return &LimitClause{src, sort, sort, count, offset}, nil
+}
+
+func (clause *LimitClause) Iterate(ctx context.Context, scope *core.Scope) (collections.Iterator, error) {
 	count, err := clause.count.Exec(ctx, scope)
 
 	if err != nil {
@@ -50,13 +63,25 @@ func (clause *LimitClause) Iterate(ctx context.Context, scope *core.Scope) (coll
 	countInt, err := clause.parseValue(count)
 
 	if err != nil {
-		return nil, err
+		return nil, core.SourceError(clause.src, err)
 	}
 
 	offsetInt, err := clause.parseValue(offset)
 
 	if err != nil {
-		return nil, err
+		return nil, core.SourceError(clause.src, err)
+	}
+
+	var src collections.Iterator
+
+	if clause.sort != nil {
+		src, err = clause.sort.IterateTop(ctx, scope, topSize(offsetInt, countInt))
+	} else {
+		src, err = clause.dataSource.Iterate(ctx, scope)
+	}
+
+	if err != nil {
+		return nil, core.SourceError(clause.src, err)
 	}
 
 	iterator, err := collections.NewLimitIterator(src, countInt, offsetInt)
@@ -68,6 +93,27 @@ func (clause *LimitClause) Iterate(ctx context.Context, scope *core.Scope) (coll
 	return iterator, nil
 }
 
+// topSize returns a number of sorted items read by the limit iterator,
+// which are the first offset + count items, or none if count is not positive.
+func topSize(offset, count int) int {
+	if count <= 0 {
+		return 0
+	}
+
+	// the sum overflows for huge values
+	if offset > maxInt-count {
+		return maxInt
+	}
+
+	size := offset + count
+
+	if size < 0 {
+		return 0
+	}
+
+	return size
+}
+
 func (clause *LimitClause) parseValue(val core.Value) (int, error) {
 	if val.Type() == types.Int {
 		return val.Unwrap().(int), nil
@@ -79,3 +125,16 @@ func (clause *LimitClause) parseValue(val core.Value) (int, error) {
 
 	return -1, core.TypeError(val.Type(), types.Int, types.Float)
 }
+
+func (clause *LimitClause) Explain() *core.PlanNode {
+	node := core.NewPlanNode("LimitClause").WithSource(clause.src)
+
+	if clause.sort != nil {
+		node.Set("optimization", "top-k")
+	}
+
+	return node.
+		Add(clause.dataSource).
+		Add(core.NewPlanNode("Count").Add(clause.count)).
+		Add(core.NewPlanNode("Offset").Add(clause.offset))
+}
@@ -10,6 +10,8 @@ type (
 	SorterExpression struct {
 		expression core.Expression
 		direction  collections.SortDirection
+		nulls      collections.SortNulls
+		collation  collections.SortCollation
 	}
 
 	SortClause struct {
@@ -28,7 +30,38 @@ func NewSorterExpression(expression core.Expression, direction collections.SortD
 		return nil, core.Error(core.ErrInvalidArgument, "direction")
 	}
 
-	return &SorterExpression{expression, direction}, nil
+	return &SorterExpression{
+		expression,
+		direction,
+		collections.SortNullsDefault,
+		collections.SortCollationDefault,
+	}, nil
+}
+
+func NewSorterExpressionWith(
+	expression core.Expression,
+	direction collections.SortDirection,
+	nulls collections.SortNulls,
+	collation collections.SortCollation,
+) (*SorterExpression, error) {
+	exp, err := NewSorterExpression(expression, direction)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !collections.IsValidSortNulls(nulls) {
+		return nil, core.Error(core.ErrInvalidArgument, "nulls")
+	}
+
+	if !collections.IsValidSortCollation(collation) {
+		return nil, core.Error(core.ErrInvalidArgument, "collation")
+	}
+
+	exp.nulls = nulls
+	exp.collation = collation
+
+	return exp, nil
 }
 
 func NewSortClause(
@@ -54,6 +87,33 @@ func (clause *SortClause) Iterate(ctx context.Context, scope *core.Scope) (colle
 		return nil, err
 	}
 
+	sorters, err := clause.newSorters()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return collections.NewSortIterator(src, sorters...)
+}
+
+// IterateTop returns an iterator over the first given number of sorted items.
+func (clause *SortClause) IterateTop(ctx context.Context, scope *core.Scope, size int) (collections.Iterator, error) {
+	src, err := clause.dataSource.Iterate(ctx, scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sorters, err := clause.newSorters()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return collections.NewTopIterator(src, size, sorters...)
+}
+
+func (clause *SortClause) newSorters() ([]*collections.Sorter, error) {
 	sorters := make([]*collections.Sorter, len(clause.sorters))
 
 	// converting sorter reducer into collections.Sorter
@@ -67,23 +127,43 @@ func (clause *SortClause) Iterate(ctx context.Context, scope *core.Scope) (colle
 		sorters[idx] = sorter
 	}
 
-	return collections.NewSortIterator(src, sorters...)
+	return sorters, nil
 }
 
 func newSorter(srt *SorterExpression) (*collections.Sorter, error) {
-	return collections.NewSorter(func(ctx context.Context, first, second *core.Scope) (int64, error) {
-		f, err := srt.expression.Exec(ctx, first)
+	return collections.NewValueSorter(func(ctx context.Context, scope *core.Scope) (core.Value, error) {
+		return srt.expression.Exec(ctx, scope)
+	}, srt.direction, srt.nulls, srt.collation)
+}
 
-		if err != nil {
-			return -1, err
+func (clause *SortClause) Explain() *core.PlanNode {
+	node := core.NewPlanNode("SortClause").WithSource(clause.src).Add(clause.dataSource)
+
+	for _, srt := range clause.sorters {
+		sorter := core.NewPlanNode("Sorter").Add(srt.expression)
+
+		if srt.direction == collections.SortDirectionDesc {
+			sorter.Set("direction", "DESC")
+		} else {
+			sorter.Set("direction", "ASC")
 		}
 
-		s, err := srt.expression.Exec(ctx, second)
+		switch srt.nulls {
+		case collections.SortNullsFirst:
+			sorter.Set("nulls", "FIRST")
+		case collections.SortNullsLast:
+			sorter.Set("nulls", "LAST")
+		}
 
-		if err != nil {
-			return -1, err
+		switch srt.collation {
+		case collections.SortCollationNoCase:
+			sorter.Set("collation", "NOCASE")
+		case collections.SortCollationNatural:
+			sorter.Set("collation", "NATURAL")
 		}
 
-		return f.Compare(s), nil
-	}, srt.direction)
+		node.Add(sorter)
+	}
+
+	return node
 }
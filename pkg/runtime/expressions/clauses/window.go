@@ -0,0 +1,98 @@
+package clauses
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/MontFerret/ferret/pkg/runtime/collections"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+)
+
+// WindowUnbounded means that a window includes all preceding or following rows of a partition.
+const WindowUnbounded = -1
+
+type (
+	// WindowBounds defines a number of rows preceding and following the current one
+	// that are included into its window.
+	WindowBounds struct {
+		Preceding int
+		Following int
+	}
+
+	// WindowClause adds aggregated values of neighbouring rows to each row of a data source.
+	// Rows are split into partitions by a partition key, if any,
+	// and windows include rows of the same partition in the order of the data source.
+	WindowClause struct {
+		src        core.SourceMap
+		dataSource collections.Iterable
+		partition  core.Expression
+		bounds     WindowBounds
+		selectors  []*CollectAggregateSelector
+	}
+)
+
+// DefaultWindowBounds includes all preceding rows and the current one, which gives running aggregates.
+func DefaultWindowBounds() WindowBounds {
+	return WindowBounds{
+		Preceding: WindowUnbounded,
+		Following: 0,
+	}
+}
+
+func NewWindowClause(
+	src core.SourceMap,
+	dataSource collections.Iterable,
+	partition core.Expression,
+	bounds WindowBounds,
+	selectors []*CollectAggregateSelector,
+) (collections.Iterable, error) {
+	if dataSource == nil {
+		return nil, core.Error(core.ErrMissedArgument, "dataSource source")
+	}
+
+	if len(selectors) == 0 {
+		return nil, core.Error(core.ErrMissedArgument, "aggregate selectors")
+	}
+
+	if bounds.Preceding < WindowUnbounded {
+		return nil, core.Error(core.ErrInvalidArgument, "preceding")
+	}
+
+	if bounds.Following < WindowUnbounded {
+		return nil, core.Error(core.ErrInvalidArgument, "following")
+	}
+
+	return &WindowClause{src, dataSource, partition, bounds, selectors}, nil
+}
+
+func (clause *WindowClause) Iterate(ctx context.Context, scope *core.Scope) (collections.Iterator, error) {
+	src, err := clause.dataSource.Iterate(ctx, scope)
+
+	if err != nil {
+		return nil, core.SourceError(clause.src, err)
+	}
+
+	return NewWindowIterator(src, clause.partition, clause.bounds, clause.selectors)
+}
+
+func (clause *WindowClause) Explain() *core.PlanNode {
+	node := core.NewPlanNode("WindowClause").
+		WithSource(clause.src).
+		Set("preceding", formatWindowBound(clause.bounds.Preceding)).
+		Set("following", formatWindowBound(clause.bounds.Following)).
+		Add(clause.dataSource)
+
+	if clause.partition != nil {
+		node.Add(core.NewPlanNode("Partition").Add(clause.partition))
+	}
+
+	return node.Add((&CollectAggregate{clause.selectors}).explain())
+}
+
+func formatWindowBound(bound int) string {
+	if bound == WindowUnbounded {
+		return "unbounded"
+	}
+
+	return strconv.Itoa(bound)
+}
@@ -0,0 +1,241 @@
+package clauses
+
+import (
+	"context"
+	"io"
+
+	"github.com/MontFerret/ferret/pkg/runtime/collections"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+type (
+	// windowPartition keeps arguments of aggregate functions evaluated for each row of a partition.
+	// args are indexed by a selector, an aggregator and a row.
+	windowPartition struct {
+		size int
+		args [][][]core.Value
+		last []windowAggregate
+	}
+
+	// windowAggregate is an aggregated value of the last window of a partition.
+	windowAggregate struct {
+		lo    int
+		hi    int
+		value core.Value
+	}
+
+	windowRow struct {
+		scope     *core.Scope
+		partition *windowPartition
+		idx       int
+	}
+
+	// WindowIterator reads the whole data source, since windows might include following rows,
+	// and calculates aggregated values of each row when the row is requested.
+	WindowIterator struct {
+		dataSource collections.Iterator
+		partition  core.Expression
+		bounds     WindowBounds
+		selectors  []*CollectAggregateSelector
+		ready      bool
+		rows       []*windowRow
+		pos        int
+	}
+)
+
+func NewWindowIterator(
+	dataSource collections.Iterator,
+	partition core.Expression,
+	bounds WindowBounds,
+	selectors []*CollectAggregateSelector,
+) (*WindowIterator, error) {
+	if dataSource == nil {
+		return nil, core.Error(core.ErrMissedArgument, "dataSource source")
+	}
+
+	return &WindowIterator{
+		dataSource: dataSource,
+		partition:  partition,
+		bounds:     bounds,
+		selectors:  selectors,
+	}, nil
+}
+
+func (iterator *WindowIterator) Next(ctx context.Context, scope *core.Scope) (*core.Scope, error) {
+	if !iterator.ready {
+		iterator.ready = true
+
+		if err := iterator.init(ctx, scope); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(iterator.rows) <= iterator.pos {
+		return nil, nil
+	}
+
+	row := iterator.rows[iterator.pos]
+	iterator.rows[iterator.pos] = nil
+	iterator.pos++
+
+	lo, hi := iterator.bounds.window(row.idx, row.partition.size)
+
+	for s, selector := range iterator.selectors {
+		reduced, err := iterator.reduce(ctx, row.partition, s, lo, hi)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := row.scope.SetVariable(selector.variable, reduced); err != nil {
+			return nil, err
+		}
+	}
+
+	return row.scope, nil
+}
+
+// reduce returns an aggregated value of a window of a partition.
+// Windows of neighbouring rows are often the same, e.g. if they include whole partitions,
+// so the last value is reused. Windows of running aggregates differ from the previous ones by following rows,
+// which incremental reducers add to the last value instead of reducing the whole window again.
+func (iterator *WindowIterator) reduce(ctx context.Context, partition *windowPartition, s, lo, hi int) (core.Value, error) {
+	selector := iterator.selectors[s]
+	last := partition.last[s]
+	var prev core.Value
+
+	if last.value != nil && last.lo == lo {
+		if last.hi == hi {
+			return last.value, nil
+		}
+
+		if selector.incremental && last.hi < hi && len(selector.aggregators) == 1 {
+			prev = last.value
+		}
+	}
+
+	args := make([]core.Value, len(selector.aggregators))
+
+	for a := range selector.aggregators {
+		column := partition.args[s][a]
+
+		if prev != nil {
+			items := make([]core.Value, 0, hi-last.hi+1)
+			items = append(items, prev)
+			args[a] = values.NewArrayWith(append(items, column[last.hi:hi]...)...)
+		} else {
+			// the capacity is limited, so that the array does not overwrite the column when it grows
+			args[a] = values.NewArrayWith(column[lo:hi:hi]...)
+		}
+	}
+
+	reduced, err := selector.reducer(ctx, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// resources are owned by scopes of single rows, so they cannot be shared
+	if _, ok := reduced.(io.Closer); ok {
+		partition.last[s] = windowAggregate{}
+	} else {
+		partition.last[s] = windowAggregate{lo, hi, reduced}
+	}
+
+	return reduced, nil
+}
+
+func (iterator *WindowIterator) init(ctx context.Context, scope *core.Scope) error {
+	var single *windowPartition
+	partitions := make(map[uint64]*windowPartition)
+	rows := make([]*windowRow, 0, 10)
+
+	for {
+		nextScope, err := iterator.dataSource.Next(ctx, scope)
+
+		if err != nil {
+			return err
+		}
+
+		if nextScope == nil {
+			break
+		}
+
+		var partition *windowPartition
+
+		if iterator.partition != nil {
+			key, err := iterator.partition.Exec(ctx, nextScope)
+
+			if err != nil {
+				return err
+			}
+
+			partition = partitions[key.Hash()]
+
+			if partition == nil {
+				partition = iterator.newPartition()
+				partitions[key.Hash()] = partition
+			}
+		} else {
+			if single == nil {
+				single = iterator.newPartition()
+			}
+
+			partition = single
+		}
+
+		for s, selector := range iterator.selectors {
+			for a, exp := range selector.aggregators {
+				arg, err := exp.Exec(ctx, nextScope)
+
+				if err != nil {
+					return err
+				}
+
+				partition.args[s][a] = append(partition.args[s][a], arg)
+			}
+		}
+
+		// aggregated values of other rows may refer to resources of this one,
+		// which thereby must outlive the row
+		nextScope.HandOver()
+
+		rows = append(rows, &windowRow{nextScope, partition, partition.size})
+		partition.size++
+	}
+
+	iterator.rows = rows
+
+	return nil
+}
+
+func (iterator *WindowIterator) newPartition() *windowPartition {
+	args := make([][][]core.Value, len(iterator.selectors))
+
+	for s, selector := range iterator.selectors {
+		args[s] = make([][]core.Value, len(selector.aggregators))
+	}
+
+	return &windowPartition{
+		args: args,
+		last: make([]windowAggregate, len(iterator.selectors)),
+	}
+}
+
+// window returns a range of rows of a partition of a given size
+// that are included into the window of a row with a given index.
+func (bounds WindowBounds) window(idx, size int) (lo, hi int) {
+	lo = 0
+	hi = size
+
+	if bounds.Preceding != WindowUnbounded && idx-bounds.Preceding > lo {
+		lo = idx - bounds.Preceding
+	}
+
+	if bounds.Following != WindowUnbounded && idx+bounds.Following+1 < hi {
+		hi = idx + bounds.Following + 1
+	}
+
+	return lo, hi
+}
@@ -65,3 +65,7 @@ func (e *ConditionExpression) Exec(ctx context.Context, scope *core.Scope) (core
 
 	return res, nil
 }
+
+func (e *ConditionExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("ConditionExpression").WithSource(e.src).Add(e.test, e.consequent, e.alternate)
+}
@@ -75,3 +75,19 @@ func (ds *DataSource) Iterate(ctx context.Context, scope *core.Scope) (collectio
 		}
 	}
 }
+
+func (ds *DataSource) Explain() *core.PlanNode {
+	return explainVariables(core.NewPlanNode("DataSource"), ds.valVariable, ds.keyVariable).
+		WithSource(ds.src).
+		Add(ds.exp)
+}
+
+func explainVariables(node *core.PlanNode, valVariable, keyVariable string) *core.PlanNode {
+	node.Set("value", valVariable)
+
+	if keyVariable != "" {
+		node.Set("key", keyVariable)
+	}
+
+	return node
+}
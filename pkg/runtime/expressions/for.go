@@ -2,6 +2,8 @@ package expressions
 
 import (
 	"context"
+	"strconv"
+
 	"github.com/MontFerret/ferret/pkg/runtime/collections"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/expressions/clauses"
@@ -41,7 +43,15 @@ func NewForExpression(
 }
 
 func (e *ForExpression) AddLimit(src core.SourceMap, size, count core.Expression) error {
-	limit, err := clauses.NewLimitClause(src, e.dataSource, size, count)
+	var limit collections.Iterable
+	var err error
+
+	// SORT immediately followed by LIMIT needs only the first items of the sorted data source
+	if sort, ok := e.dataSource.(*clauses.SortClause); ok {
+		limit, err = clauses.NewSortLimitClause(src, sort, size, count)
+	} else {
+		limit, err = clauses.NewLimitClause(src, e.dataSource, size, count)
+	}
 
 	if err != nil {
 		return err
@@ -88,6 +98,23 @@ func (e *ForExpression) AddCollect(src core.SourceMap, params *clauses.Collect)
 	return nil
 }
 
+func (e *ForExpression) AddWindow(
+	src core.SourceMap,
+	partition core.Expression,
+	bounds clauses.WindowBounds,
+	selectors []*clauses.CollectAggregateSelector,
+) error {
+	window, err := clauses.NewWindowClause(src, e.dataSource, partition, bounds, selectors)
+
+	if err != nil {
+		return err
+	}
+
+	e.dataSource = window
+
+	return nil
+}
+
 func (e *ForExpression) AddStatement(stmt core.Expression) error {
 	tap, ok := e.dataSource.(*BlockExpression)
 
@@ -144,6 +171,16 @@ func (e *ForExpression) Exec(ctx context.Context, scope *core.Scope) (core.Value
 				return values.None, err
 			}
 
+			// the iteration scope is not used after its predicate gets executed
+			// resources owned by the scope are closed unless the output may refer to them
+			if nextScope != scope {
+				if nextScope.HasDisposables() && values.IsPlain(out) {
+					nextScope.Dispose()
+				}
+
+				nextScope.Release()
+			}
+
 			var add bool
 
 			// The result shouldn't be distinct
@@ -183,3 +220,11 @@ func (e *ForExpression) Exec(ctx context.Context, scope *core.Scope) (core.Value
 		return res, nil
 	}
 }
+
+func (e *ForExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("ForExpression").
+		WithSource(e.src).
+		Set("distinct", strconv.FormatBool(e.distinct)).
+		Set("spread", strconv.FormatBool(e.spread)).
+		Add(e.dataSource, e.predicate)
+}
@@ -2,26 +2,45 @@ package expressions
 
 import (
 	"context"
+	"time"
+
 	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/expressions/literals"
+	"github.com/MontFerret/ferret/pkg/runtime/hooks"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
 
 type FunctionCallExpression struct {
-	src  core.SourceMap
-	fun  core.Function
-	args []core.Expression
+	src       core.SourceMap
+	name      string
+	fun       core.Function
+	cacheable bool
+	args      []core.Expression
 }
 
 func NewFunctionCallExpression(
 	src core.SourceMap,
 	fun core.Function,
 	args ...core.Expression,
+) (*FunctionCallExpression, error) {
+	return NewFunctionCallExpressionWith(src, "", fun, false, args...)
+}
+
+// NewFunctionCallExpressionWith returns a call expression of a function registered by a given name.
+// The name is used by caching and hooks.
+// Results of a cacheable function are reused within a run for calls with arguments having equal hashes.
+func NewFunctionCallExpressionWith(
+	src core.SourceMap,
+	name string,
+	fun core.Function,
+	cacheable bool,
+	args ...core.Expression,
 ) (*FunctionCallExpression, error) {
 	if fun == nil {
 		return nil, core.Error(core.ErrMissedArgument, "function")
 	}
 
-	return &FunctionCallExpression{src, fun, args}, nil
+	return &FunctionCallExpression{src, name, fun, cacheable, args}, nil
 }
 
 func (e *FunctionCallExpression) Arguments() []core.Expression {
@@ -37,31 +56,100 @@ func (e *FunctionCallExpression) Exec(ctx context.Context, scope *core.Scope) (c
 	case <-ctx.Done():
 		return values.None, core.ErrTerminated
 	default:
-		var out core.Value
-		var err error
+		var args []core.Value
 
-		if len(e.args) == 0 {
-			out, err = e.fun(ctx)
-		} else {
-			args := make([]core.Value, len(e.args))
+		if len(e.args) > 0 {
+			var err error
+			args, err = literals.ExecArgs(ctx, scope, e.args)
 
-			for idx, arg := range e.args {
-				out, err := arg.Exec(ctx, scope)
+			if err != nil {
+				return values.None, core.SourceError(e.src, err)
+			}
+		}
 
-				if err != nil {
-					return values.None, core.SourceError(e.src, err)
-				}
+		cache := core.CacheFrom(ctx)
 
-				args[idx] = out
-			}
+		if cache == nil || !(e.cacheable || cache.IsCacheable(e.name)) {
+			return e.call(ctx, args)
+		}
+
+		hash := argsHash(args)
 
-			out, err = e.fun(ctx, args...)
+		if out, found := cache.Get(e.name, hash); found {
+			return out, nil
 		}
 
+		out, err := e.call(ctx, args)
+
 		if err != nil {
-			return values.None, core.SourceError(e.src, err)
+			return out, err
 		}
 
+		cache.Set(e.name, hash, out, 0)
+		addSharedDisposables(scope, out)
+
 		return out, nil
 	}
 }
+
+func (e *FunctionCallExpression) call(ctx context.Context, args []core.Value) (core.Value, error) {
+	var out core.Value
+	var err error
+
+	h := hooks.FromContext(ctx)
+
+	// events are not built without hooks, since hashing of arguments is not free
+	if _, ok := h.(hooks.Noop); ok {
+		out, err = e.fun(ctx, args...)
+	} else {
+		event := hooks.FunctionEvent{Name: e.name, ArgsHash: argsHash(args)}
+		ctx = h.OnFunctionStart(ctx, event)
+		start := time.Now()
+
+		out, err = e.fun(ctx, args...)
+
+		event.Duration = time.Since(start)
+		event.Error = err
+		h.OnFunctionEnd(ctx, event)
+	}
+
+	if err != nil {
+		return values.None, core.SourceError(e.src, err)
+	}
+
+	return out, nil
+}
+
+func (e *FunctionCallExpression) Explain() *core.PlanNode {
+	node := core.NewPlanNode("FunctionCallExpression").
+		WithSource(e.src).
+		Set("name", e.name)
+
+	if e.cacheable {
+		node.Set("cacheable", "true")
+	}
+
+	return node.AddExpressions(e.args)
+}
+
+// argsHash combines hashes of arguments by FNV-1a.
+func argsHash(args []core.Value) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+
+	hash := uint64(offset)
+
+	for _, arg := range args {
+		h := arg.Hash()
+
+		for i := 0; i < 8; i++ {
+			hash ^= h & 0xff
+			hash *= prime
+			h >>= 8
+		}
+	}
+
+	return hash
+}
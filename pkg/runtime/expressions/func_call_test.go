@@ -59,6 +59,26 @@ func TestFunctionCallExpression(t *testing.T) {
 			So(out, ShouldEqual, values.True)
 		})
 
+		Convey("Should return an error of an underlying function called with arguments", func() {
+			f, err := expressions.NewFunctionCallExpression(
+				core.SourceMap{},
+				func(ctx context.Context, args ...core.Value) (value core.Value, e error) {
+					return values.None, core.ErrInvalidArgumentNumber
+				},
+				literals.NewIntLiteral(1),
+			)
+
+			So(err, ShouldBeNil)
+
+			rootScope, _ := core.NewRootScope()
+
+			out, err := f.Exec(context.Background(), rootScope.Fork())
+
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, core.ErrInvalidArgumentNumber.Error())
+			So(out, ShouldEqual, values.None)
+		})
+
 		Convey("Should stop an execution when context is cancelled", func() {
 			args := []core.Expression{
 				literals.NewIntLiteral(1),
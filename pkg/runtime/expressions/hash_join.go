@@ -0,0 +1,272 @@
+package expressions
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/collections"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+type (
+	joinEntry struct {
+		key   core.Value
+		value core.Value
+		idx   values.Int
+	}
+
+	// joinIndex is a hash index over an array.
+	// Values that can be hashed consistently with their comparison are stored in buckets,
+	// others are kept in a list and get compared one by one.
+	joinIndex struct {
+		source  *values.Array
+		buckets map[uint64][]*joinEntry
+		rest    []*joinEntry
+	}
+
+	// HashJoinDataSource replaces a data source of a nested FOR loop followed by a FILTER clause
+	// that compares a value of the inner loop with a value of an outer one.
+	// Instead of iterating over the whole inner data source for each row of an outer loop,
+	// it builds a hash index over the inner data source once per run and looks up matching values.
+	// Data sources other than arrays are handled by the fallback, which is the original data source with the filter.
+	HashJoinDataSource struct {
+		src         core.SourceMap
+		valVariable string
+		keyVariable string
+		exp         core.Expression
+		innerKey    core.Expression
+		outerKey    core.Expression
+		innerOnLeft bool
+		fallback    collections.Iterable
+	}
+
+	joinIterator struct {
+		valVariable string
+		keyVariable string
+		entries     []*joinEntry
+		pos         int
+	}
+)
+
+func NewHashJoinDataSource(
+	src core.SourceMap,
+	valVariable,
+	keyVariable string,
+	exp core.Expression,
+	innerKey core.Expression,
+	outerKey core.Expression,
+	innerOnLeft bool,
+	fallback collections.Iterable,
+) (*HashJoinDataSource, error) {
+	if exp == nil {
+		return nil, core.Error(core.ErrMissedArgument, "expression")
+	}
+
+	if innerKey == nil {
+		return nil, core.Error(core.ErrMissedArgument, "inner key")
+	}
+
+	if outerKey == nil {
+		return nil, core.Error(core.ErrMissedArgument, "outer key")
+	}
+
+	if fallback == nil {
+		return nil, core.Error(core.ErrMissedArgument, "fallback")
+	}
+
+	return &HashJoinDataSource{
+		src:         src,
+		valVariable: valVariable,
+		keyVariable: keyVariable,
+		exp:         exp,
+		innerKey:    innerKey,
+		outerKey:    outerKey,
+		innerOnLeft: innerOnLeft,
+		fallback:    fallback,
+	}, nil
+}
+
+func (ds *HashJoinDataSource) Iterate(ctx context.Context, scope *core.Scope) (collections.Iterator, error) {
+	select {
+	case <-ctx.Done():
+		return nil, core.ErrTerminated
+	default:
+		data, err := ds.exp.Exec(ctx, scope)
+
+		if err != nil {
+			return nil, core.SourceError(ds.src, err)
+		}
+
+		arr, ok := data.(*values.Array)
+
+		if !ok {
+			return ds.fallback.Iterate(ctx, scope)
+		}
+
+		index, err := ds.getIndex(ctx, scope, arr)
+
+		if err != nil {
+			return nil, core.SourceError(ds.src, err)
+		}
+
+		key, err := ds.outerKey.Exec(ctx, scope)
+
+		if err != nil {
+			return nil, core.SourceError(ds.src, err)
+		}
+
+		return &joinIterator{
+			ds.valVariable,
+			ds.keyVariable,
+			index.lookup(key, ds.innerOnLeft),
+			0,
+		}, nil
+	}
+}
+
+// getIndex returns an index over a given array.
+// The index is kept in the state of the current run and rebuilt only when the data source returns a different array.
+func (ds *HashJoinDataSource) getIndex(ctx context.Context, scope *core.Scope, arr *values.Array) (*joinIndex, error) {
+	if index, ok := scope.State(ds).(*joinIndex); ok && index.source == arr {
+		return index, nil
+	}
+
+	index := &joinIndex{
+		source:  arr,
+		buckets: make(map[uint64][]*joinEntry),
+	}
+
+	var err error
+
+	arr.ForEach(func(value core.Value, idx int) bool {
+		innerScope := scope.Fork()
+
+		if err = innerScope.SetVariable(ds.valVariable, value); err != nil {
+			return false
+		}
+
+		if ds.keyVariable != "" {
+			if err = innerScope.SetVariable(ds.keyVariable, values.NewInt(idx)); err != nil {
+				return false
+			}
+		}
+
+		var key core.Value
+		key, err = ds.innerKey.Exec(ctx, innerScope)
+
+		if err != nil {
+			return false
+		}
+
+		index.add(&joinEntry{key, value, values.NewInt(idx)})
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	scope.SetState(ds, index)
+
+	return index, nil
+}
+
+func (index *joinIndex) add(entry *joinEntry) {
+	hash, ok := joinHash(entry.key)
+
+	if !ok {
+		index.rest = append(index.rest, entry)
+
+		return
+	}
+
+	index.buckets[hash] = append(index.buckets[hash], entry)
+}
+
+// lookup returns entries equal to a given key in the original order.
+func (index *joinIndex) lookup(key core.Value, innerOnLeft bool) []*joinEntry {
+	candidates := index.rest
+	hash, ok := joinHash(key)
+
+	if ok {
+		candidates = index.buckets[hash]
+	}
+
+	res := make([]*joinEntry, 0, len(candidates))
+
+	for _, entry := range candidates {
+		var eq int64
+
+		if innerOnLeft {
+			eq = entry.key.Compare(key)
+		} else {
+			eq = key.Compare(entry.key)
+		}
+
+		if eq == 0 {
+			res = append(res, entry)
+		}
+	}
+
+	return res
+}
+
+// joinHash returns a hash of a value that is equal for all values that are equal by comparison.
+// Numbers are hashed as floats, since integers and floats with the same value are equal.
+func joinHash(value core.Value) (uint64, bool) {
+	switch value.Type() {
+	case types.None, types.Boolean, types.String:
+		return value.Hash(), true
+	case types.Int:
+		return joinFloatHash(float64(value.(values.Int))), true
+	case types.Float:
+		return joinFloatHash(float64(value.(values.Float))), true
+	default:
+		return 0, false
+	}
+}
+
+func joinFloatHash(f float64) uint64 {
+	// negative zero is equal to zero
+	if f == 0 {
+		f = 0
+	}
+
+	return values.NewFloat(f).Hash()
+}
+
+func (iterator *joinIterator) Next(_ context.Context, scope *core.Scope) (*core.Scope, error) {
+	if len(iterator.entries) > iterator.pos {
+		entry := iterator.entries[iterator.pos]
+
+		iterator.pos++
+
+		nextScope := scope.Fork()
+
+		if err := nextScope.SetVariable(iterator.valVariable, entry.value); err != nil {
+			return nil, err
+		}
+
+		if iterator.keyVariable != "" {
+			if err := nextScope.SetVariable(iterator.keyVariable, entry.idx); err != nil {
+				return nil, err
+			}
+		}
+
+		return nextScope, nil
+	}
+
+	return nil, nil
+}
+
+func (ds *HashJoinDataSource) Explain() *core.PlanNode {
+	outer := core.NewPlanNode("OuterKey").Add(ds.outerKey)
+	inner := core.NewPlanNode("InnerKey").Add(ds.innerKey)
+
+	return explainVariables(core.NewPlanNode("HashJoinDataSource"), ds.valVariable, ds.keyVariable).
+		WithSource(ds.src).
+		Set("optimization", "hash-join").
+		Add(ds.exp, inner, outer)
+}
@@ -26,6 +26,16 @@ func (l *ArrayLiteral) Exec(ctx context.Context, scope *core.Scope) (core.Value,
 	arr := values.NewArray(len(l.elements))
 
 	for _, el := range l.elements {
+		spread, ok := el.(*SpreadElement)
+
+		if ok {
+			if err := spread.ExecSpread(ctx, scope, arr.Push); err != nil {
+				return values.None, err
+			}
+
+			continue
+		}
+
 		val, err := el.Exec(ctx, scope)
 
 		if err != nil {
@@ -37,3 +47,7 @@ func (l *ArrayLiteral) Exec(ctx context.Context, scope *core.Scope) (core.Value,
 
 	return arr, nil
 }
+
+func (l *ArrayLiteral) Explain() *core.PlanNode {
+	return core.NewPlanNode("ArrayLiteral").AddExpressions(l.elements)
+}
@@ -2,6 +2,8 @@ package literals
 
 import (
 	"context"
+	"strconv"
+
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
@@ -19,3 +21,7 @@ func (l BooleanLiteral) Exec(_ context.Context, _ *core.Scope) (core.Value, erro
 
 	return values.False, nil
 }
+
+func (l BooleanLiteral) Explain() *core.PlanNode {
+	return core.NewPlanNode("BooleanLiteral").Set("value", strconv.FormatBool(bool(l)))
+}
@@ -2,6 +2,8 @@ package literals
 
 import (
 	"context"
+	"strconv"
+
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
@@ -15,3 +17,7 @@ func NewFloatLiteral(value float64) FloatLiteral {
 func (l FloatLiteral) Exec(_ context.Context, _ *core.Scope) (core.Value, error) {
 	return values.NewFloat(float64(l)), nil
 }
+
+func (l FloatLiteral) Explain() *core.PlanNode {
+	return core.NewPlanNode("FloatLiteral").Set("value", strconv.FormatFloat(float64(l), 'f', -1, 64))
+}
@@ -2,6 +2,8 @@ package literals
 
 import (
 	"context"
+	"strconv"
+
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
@@ -15,3 +17,7 @@ func NewIntLiteral(value int) IntLiteral {
 func (l IntLiteral) Exec(_ context.Context, _ *core.Scope) (core.Value, error) {
 	return values.NewInt(int(l)), nil
 }
+
+func (l IntLiteral) Explain() *core.PlanNode {
+	return core.NewPlanNode("IntLiteral").Set("value", strconv.Itoa(int(l)))
+}
@@ -13,3 +13,7 @@ var None = &noneLiteral{}
 func (l noneLiteral) Exec(_ context.Context, _ *core.Scope) (core.Value, error) {
 	return values.None, nil
 }
+
+func (l noneLiteral) Explain() *core.PlanNode {
+	return core.NewPlanNode("NoneLiteral")
+}
@@ -31,6 +31,16 @@ func NewObjectPropertyAssignment(name, value core.Expression) (*ObjectPropertyAs
 	return &ObjectPropertyAssignment{name, value}, nil
 }
 
+// NewObjectPropertySpread creates an assignment that copies all properties
+// of a given object expression into the target object.
+func NewObjectPropertySpread(value core.Expression) (*ObjectPropertyAssignment, error) {
+	if value == nil {
+		return nil, core.Error(core.ErrMissedArgument, "property value expression")
+	}
+
+	return &ObjectPropertyAssignment{nil, value}, nil
+}
+
 func NewObjectLiteralWith(props ...*ObjectPropertyAssignment) *ObjectLiteral {
 	return &ObjectLiteral{props}
 }
@@ -39,6 +49,14 @@ func (l *ObjectLiteral) Exec(ctx context.Context, scope *core.Scope) (core.Value
 	obj := values.NewObject()
 
 	for _, el := range l.properties {
+		if el.name == nil {
+			if err := l.spread(ctx, scope, obj, el.value); err != nil {
+				return values.None, err
+			}
+
+			continue
+		}
+
 		name, err := el.name.Exec(ctx, scope)
 
 		if err != nil {
@@ -60,3 +78,42 @@ func (l *ObjectLiteral) Exec(ctx context.Context, scope *core.Scope) (core.Value
 
 	return obj, nil
 }
+
+func (l *ObjectLiteral) spread(ctx context.Context, scope *core.Scope, obj *values.Object, exp core.Expression) error {
+	val, err := exp.Exec(ctx, scope)
+
+	if err != nil {
+		return err
+	}
+
+	switch val.Type() {
+	case types.None:
+		return nil
+	case types.Object:
+		val.(*values.Object).ForEach(func(value core.Value, key string) bool {
+			obj.Set(values.NewString(key), value)
+
+			return true
+		})
+
+		return nil
+	default:
+		return core.TypeError(val.Type(), types.Object)
+	}
+}
+
+func (l *ObjectLiteral) Explain() *core.PlanNode {
+	node := core.NewPlanNode("ObjectLiteral")
+
+	for _, prop := range l.properties {
+		if prop.name == nil {
+			node.Add(core.NewPlanNode("ObjectPropertySpread").Add(prop.value))
+
+			continue
+		}
+
+		node.Add(core.NewPlanNode("ObjectProperty").Add(prop.name, prop.value))
+	}
+
+	return node
+}
@@ -0,0 +1,88 @@
+package literals
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// SpreadElement wraps an expression whose result is expanded in place
+// inside of array literals, object literals and function call arguments.
+type SpreadElement struct {
+	exp core.Expression
+}
+
+func NewSpreadElement(exp core.Expression) (*SpreadElement, error) {
+	if exp == nil {
+		return nil, core.Error(core.ErrMissedArgument, "spread expression")
+	}
+
+	return &SpreadElement{exp}, nil
+}
+
+func (e *SpreadElement) Exec(ctx context.Context, scope *core.Scope) (core.Value, error) {
+	return e.exp.Exec(ctx, scope)
+}
+
+// ExecSpread evaluates the expression and passes each element of the resulting array to the given function.
+// None values are ignored.
+func (e *SpreadElement) ExecSpread(ctx context.Context, scope *core.Scope, fn func(value core.Value)) error {
+	out, err := e.exp.Exec(ctx, scope)
+
+	if err != nil {
+		return err
+	}
+
+	switch out.Type() {
+	case types.None:
+		return nil
+	case types.Array:
+		out.(*values.Array).ForEach(func(value core.Value, _ int) bool {
+			fn(value)
+
+			return true
+		})
+
+		return nil
+	default:
+		return core.TypeError(out.Type(), types.Array)
+	}
+}
+
+// ExecArgs evaluates a list of expressions into a list of values,
+// expanding spread elements in place.
+func ExecArgs(ctx context.Context, scope *core.Scope, exps []core.Expression) ([]core.Value, error) {
+	args := make([]core.Value, 0, len(exps))
+
+	for _, exp := range exps {
+		spread, ok := exp.(*SpreadElement)
+
+		if ok {
+			err := spread.ExecSpread(ctx, scope, func(value core.Value) {
+				args = append(args, value)
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		out, err := exp.Exec(ctx, scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, out)
+	}
+
+	return args, nil
+}
+
+func (e *SpreadElement) Explain() *core.PlanNode {
+	return core.NewPlanNode("SpreadElement").Add(e.exp)
+}
@@ -2,6 +2,8 @@ package literals
 
 import (
 	"context"
+	"strconv"
+
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
@@ -15,3 +17,7 @@ func NewStringLiteral(str string) StringLiteral {
 func (l StringLiteral) Exec(_ context.Context, _ *core.Scope) (core.Value, error) {
 	return values.NewString(string(l)), nil
 }
+
+func (l StringLiteral) Explain() *core.PlanNode {
+	return core.NewPlanNode("StringLiteral").Set("value", strconv.Quote(string(l)))
+}
@@ -0,0 +1,39 @@
+package literals
+
+import (
+	"context"
+	"strings"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+// TemplateLiteral represents a template string with interpolated expressions.
+// Its parts are evaluated in order and joined together using their string representation.
+type TemplateLiteral struct {
+	parts []core.Expression
+}
+
+func NewTemplateLiteral(parts ...core.Expression) *TemplateLiteral {
+	return &TemplateLiteral{parts}
+}
+
+func (l *TemplateLiteral) Exec(ctx context.Context, scope *core.Scope) (core.Value, error) {
+	var sb strings.Builder
+
+	for _, part := range l.parts {
+		val, err := part.Exec(ctx, scope)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		sb.WriteString(val.String())
+	}
+
+	return values.NewString(sb.String()), nil
+}
+
+func (l *TemplateLiteral) Explain() *core.PlanNode {
+	return core.NewPlanNode("TemplateLiteral").AddExpressions(l.parts)
+}
@@ -55,3 +55,10 @@ func (e *MemberExpression) Exec(ctx context.Context, scope *core.Scope) (core.Va
 
 	return out, nil
 }
+
+func (e *MemberExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("MemberExpression").
+		WithSource(e.src).
+		Set("variable", e.variableName).
+		AddExpressions(e.path)
+}
@@ -193,3 +193,25 @@ func (operator *ArrayOperator) none(ctx context.Context, arr *values.Array, valu
 
 	return result, nil
 }
+
+func (operator *ArrayOperator) Explain() *core.PlanNode {
+	var name string
+
+	switch operator.aotype {
+	case ArrayOperatorTypeAll:
+		name = "ALL"
+	case ArrayOperatorTypeAny:
+		name = "ANY"
+	default:
+		name = "NONE"
+	}
+
+	node := operator.explain("ArrayOperator").Set("operator", name)
+
+	// the comparator shares operands with the array operator, so only its name is shown
+	if comparator, ok := operator.comparator.(core.Explainable); ok {
+		node.Set("comparator", comparator.Explain().Attributes["operator"])
+	}
+
+	return node
+}
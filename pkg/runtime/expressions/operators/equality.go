@@ -9,7 +9,8 @@ import (
 type (
 	EqualityOperator struct {
 		*baseOperator
-		fn OperatorFunc
+		operator string
+		fn       OperatorFunc
 	}
 )
 
@@ -36,6 +37,7 @@ func NewEqualityOperator(
 
 	return &EqualityOperator{
 		&baseOperator{src, left, right},
+		operator,
 		fn,
 	}, nil
 }
@@ -59,3 +61,7 @@ func (operator *EqualityOperator) Exec(ctx context.Context, scope *core.Scope) (
 func (operator *EqualityOperator) Eval(_ context.Context, left, right core.Value) (core.Value, error) {
 	return operator.fn(left, right), nil
 }
+
+func (operator *EqualityOperator) Explain() *core.PlanNode {
+	return operator.explain("EqualityOperator").Set("operator", operator.operator)
+}
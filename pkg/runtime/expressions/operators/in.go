@@ -63,3 +63,13 @@ func (operator *InOperator) Eval(_ context.Context, left, right core.Value) (cor
 
 	return values.NewBoolean(found), nil
 }
+
+func (operator *InOperator) Explain() *core.PlanNode {
+	name := "IN"
+
+	if operator.not {
+		name = "NOT IN"
+	}
+
+	return operator.explain("InOperator").Set("operator", name)
+}
@@ -113,3 +113,18 @@ func (operator *LogicalOperator) Eval(_ context.Context, left, right core.Value)
 
 	return right, nil
 }
+
+func (operator *LogicalOperator) Explain() *core.PlanNode {
+	var name string
+
+	switch operator.value {
+	case LogicalOperatorTypeAnd:
+		name = "AND"
+	case LogicalOperatorTypeOr:
+		name = "OR"
+	default:
+		name = "NOT"
+	}
+
+	return operator.explain("LogicalOperator").Set("operator", name)
+}
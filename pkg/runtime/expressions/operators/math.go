@@ -94,3 +94,7 @@ func (operator *MathOperator) Eval(_ context.Context, left, right core.Value) (c
 
 	return operator.fn(left, right), nil
 }
+
+func (operator *MathOperator) Explain() *core.PlanNode {
+	return operator.explain("MathOperator").Set("operator", string(operator.opType))
+}
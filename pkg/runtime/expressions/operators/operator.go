@@ -415,3 +415,7 @@ func Positive(value, _ core.Value) core.Value {
 func ToBoolean(value, _ core.Value) core.Value {
 	return values.ToBoolean(value)
 }
+
+func (operator *baseOperator) explain(typeName string) *core.PlanNode {
+	return core.NewPlanNode(typeName).WithSource(operator.src).Add(operator.left, operator.right)
+}
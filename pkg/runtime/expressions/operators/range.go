@@ -80,3 +80,7 @@ func (operator *RangeOperator) Eval(_ context.Context, left, right core.Value) (
 
 	return arr, nil
 }
+
+func (operator *RangeOperator) Explain() *core.PlanNode {
+	return operator.explain("RangeOperator")
+}
@@ -11,7 +11,8 @@ type (
 	UnaryOperatorType string
 	UnaryOperator     struct {
 		*baseOperator
-		fn OperatorFunc
+		operator UnaryOperatorType
+		fn       OperatorFunc
 	}
 )
 
@@ -48,6 +49,7 @@ func NewUnaryOperator(
 			exp,
 			nil,
 		},
+		operator,
 		fn,
 	}, nil
 }
@@ -65,3 +67,7 @@ func (operator *UnaryOperator) Exec(ctx context.Context, scope *core.Scope) (cor
 func (operator *UnaryOperator) Eval(_ context.Context, left, _ core.Value) (core.Value, error) {
 	return operator.fn(left, values.None), nil
 }
+
+func (operator *UnaryOperator) Explain() *core.PlanNode {
+	return operator.explain("UnaryOperator").Set("operator", string(operator.operator))
+}
@@ -28,3 +28,7 @@ func (e *ParameterExpression) Exec(ctx context.Context, _ *core.Scope) (core.Val
 
 	return param, nil
 }
+
+func (e *ParameterExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("ParameterExpression").WithSource(e.src).Set("name", e.name)
+}
@@ -41,3 +41,7 @@ func (e *ReturnExpression) Exec(ctx context.Context, scope *core.Scope) (core.Va
 		return val, nil
 	}
 }
+
+func (e *ReturnExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("ReturnExpression").WithSource(e.src).Add(e.predicate)
+}
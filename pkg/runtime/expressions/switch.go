@@ -0,0 +1,136 @@
+package expressions
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+)
+
+type (
+	SwitchCase struct {
+		tests  []core.Expression
+		result core.Expression
+	}
+
+	// SwitchExpression evaluates its cases in order and returns the result of the first matching one.
+	// When a test value is given (SWITCH form), a case matches if any of its expressions is equal to the value.
+	// Without a test value (WHEN form), a case matches if any of its expressions is truthy.
+	// Case and result expressions are evaluated lazily, so evaluation stops at the first match.
+	SwitchExpression struct {
+		src      core.SourceMap
+		test     core.Expression
+		cases    []*SwitchCase
+		fallback core.Expression
+	}
+)
+
+func NewSwitchCase(tests []core.Expression, result core.Expression) (*SwitchCase, error) {
+	if len(tests) == 0 {
+		return nil, core.Error(core.ErrMissedArgument, "case expressions")
+	}
+
+	if result == nil {
+		return nil, core.Error(core.ErrMissedArgument, "case result")
+	}
+
+	return &SwitchCase{tests, result}, nil
+}
+
+func NewSwitchExpression(
+	src core.SourceMap,
+	test core.Expression,
+	cases []*SwitchCase,
+	fallback core.Expression,
+) (*SwitchExpression, error) {
+	if len(cases) == 0 {
+		return nil, core.Error(core.ErrMissedArgument, "cases")
+	}
+
+	return &SwitchExpression{
+		src,
+		test,
+		cases,
+		fallback,
+	}, nil
+}
+
+func (e *SwitchExpression) Exec(ctx context.Context, scope *core.Scope) (core.Value, error) {
+	var value core.Value
+
+	if e.test != nil {
+		out, err := e.test.Exec(ctx, scope)
+
+		if err != nil {
+			return values.None, core.SourceError(e.src, err)
+		}
+
+		value = out
+	}
+
+	for _, c := range e.cases {
+		matched, err := e.match(ctx, scope, c, value)
+
+		if err != nil {
+			return values.None, core.SourceError(e.src, err)
+		}
+
+		if matched {
+			return e.exec(ctx, scope, c.result)
+		}
+	}
+
+	if e.fallback == nil {
+		return values.None, nil
+	}
+
+	return e.exec(ctx, scope, e.fallback)
+}
+
+func (e *SwitchExpression) match(ctx context.Context, scope *core.Scope, c *SwitchCase, value core.Value) (bool, error) {
+	for _, test := range c.tests {
+		out, err := test.Exec(ctx, scope)
+
+		if err != nil {
+			return false, err
+		}
+
+		if value == nil {
+			if values.ToBoolean(out) == values.True {
+				return true, nil
+			}
+
+			continue
+		}
+
+		if value.Compare(out) == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (e *SwitchExpression) exec(ctx context.Context, scope *core.Scope, exp core.Expression) (core.Value, error) {
+	res, err := exp.Exec(ctx, scope)
+
+	if err != nil {
+		return values.None, core.SourceError(e.src, err)
+	}
+
+	return res, nil
+}
+
+func (e *SwitchExpression) Explain() *core.PlanNode {
+	node := core.NewPlanNode("SwitchExpression").WithSource(e.src).Add(e.test)
+
+	for _, c := range e.cases {
+		node.Add(core.NewPlanNode("SwitchCase").AddExpressions(c.tests).Add(c.result))
+	}
+
+	if e.fallback != nil {
+		node.Add(core.NewPlanNode("SwitchDefault").Add(e.fallback))
+	}
+
+	return node
+}
@@ -0,0 +1,110 @@
+package expressions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/expressions"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSwitchExpression(t *testing.T) {
+	sourceMap := core.NewSourceMap("test", 1, 1)
+	valueOf := func(val core.Value, calls *int) core.Expression {
+		return ExpressionFn(func(_ context.Context, _ *core.Scope) (core.Value, error) {
+			if calls != nil {
+				*calls++
+			}
+
+			return val, nil
+		})
+	}
+
+	Convey("Should not create a switch expression without cases", t, func() {
+		exp, err := expressions.NewSwitchExpression(sourceMap, valueOf(values.NewInt(1), nil), nil, nil)
+
+		So(err, ShouldBeError)
+		So(exp, ShouldBeNil)
+	})
+
+	Convey("Should not create a switch case without tests", t, func() {
+		c, err := expressions.NewSwitchCase(nil, valueOf(values.True, nil))
+
+		So(err, ShouldBeError)
+		So(c, ShouldBeNil)
+	})
+
+	Convey("Should stop evaluation at the first matching case", t, func() {
+		var calls int
+
+		first, err := expressions.NewSwitchCase(
+			[]core.Expression{valueOf(values.NewInt(1), &calls), valueOf(values.NewInt(2), &calls)},
+			valueOf(values.NewString("first"), nil),
+		)
+		So(err, ShouldBeNil)
+
+		second, err := expressions.NewSwitchCase(
+			[]core.Expression{valueOf(values.NewInt(2), &calls)},
+			valueOf(values.NewString("second"), nil),
+		)
+		So(err, ShouldBeNil)
+
+		exp, err := expressions.NewSwitchExpression(
+			sourceMap,
+			valueOf(values.NewInt(2), nil),
+			[]*expressions.SwitchCase{first, second},
+			nil,
+		)
+		So(err, ShouldBeNil)
+
+		out, err := exp.Exec(context.Background(), nil)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, values.NewString("first"))
+		So(calls, ShouldEqual, 2)
+	})
+
+	Convey("Should use truthiness of tests when there is no value", t, func() {
+		c, err := expressions.NewSwitchCase(
+			[]core.Expression{valueOf(values.False, nil), valueOf(values.NewString(""), nil)},
+			valueOf(values.NewString("matched"), nil),
+		)
+		So(err, ShouldBeNil)
+
+		exp, err := expressions.NewSwitchExpression(
+			sourceMap,
+			nil,
+			[]*expressions.SwitchCase{c},
+			valueOf(values.NewString("fallback"), nil),
+		)
+		So(err, ShouldBeNil)
+
+		out, err := exp.Exec(context.Background(), nil)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, values.NewString("fallback"))
+	})
+
+	Convey("Should return None when nothing matches and there is no fallback", t, func() {
+		c, err := expressions.NewSwitchCase(
+			[]core.Expression{valueOf(values.NewInt(1), nil)},
+			valueOf(values.NewString("one"), nil),
+		)
+		So(err, ShouldBeNil)
+
+		exp, err := expressions.NewSwitchExpression(
+			sourceMap,
+			valueOf(values.NewInt(2), nil),
+			[]*expressions.SwitchCase{c},
+			nil,
+		)
+		So(err, ShouldBeNil)
+
+		out, err := exp.Exec(context.Background(), nil)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, values.None)
+	})
+}
@@ -2,14 +2,20 @@ package expressions
 
 import (
 	"context"
+	"io"
+	"strconv"
+
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
 
 type (
 	VariableExpression struct {
-		src  core.SourceMap
-		name string
+		src      core.SourceMap
+		name     string
+		depth    int
+		slot     int
+		resolved bool
 	}
 
 	VariableDeclarationExpression struct {
@@ -23,7 +29,23 @@ func NewVariableExpression(src core.SourceMap, name string) (*VariableExpression
 		return nil, core.Error(core.ErrMissedArgument, "missed variable name")
 	}
 
-	return &VariableExpression{src, name}, nil
+	return &VariableExpression{src: src, name: name}, nil
+}
+
+// NewResolvedVariableExpression creates a variable expression
+// that reads the variable by its position in the scope resolved at compile time.
+func NewResolvedVariableExpression(src core.SourceMap, name string, depth, slot int) (*VariableExpression, error) {
+	v, err := NewVariableExpression(src, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v.depth = depth
+	v.slot = slot
+	v.resolved = true
+
+	return v, nil
 }
 
 func NewVariableDeclarationExpression(src core.SourceMap, name string, init core.Expression) (*VariableDeclarationExpression, error) {
@@ -41,6 +63,10 @@ func NewVariableDeclarationExpression(src core.SourceMap, name string, init core
 }
 
 func (e *VariableExpression) Exec(_ context.Context, scope *core.Scope) (core.Value, error) {
+	if e.resolved {
+		return scope.GetVariableAt(e.depth, e.slot, e.name)
+	}
+
 	return scope.GetVariable(e.name)
 }
 
@@ -51,5 +77,63 @@ func (e *VariableDeclarationExpression) Exec(ctx context.Context, scope *core.Sc
 		return values.None, err
 	}
 
-	return values.None, scope.SetVariable(e.name, val)
+	if err := scope.SetVariable(e.name, val); err != nil {
+		return values.None, err
+	}
+
+	// resources nested in arrays and objects belong to the declaring scope as well,
+	// e.g. LET pages = (FOR url IN urls RETURN DOCUMENT(url))
+	addNestedDisposables(scope, val)
+
+	return values.None, nil
+}
+
+func addNestedDisposables(scope *core.Scope, val core.Value) {
+	forEachDisposable(val, scope.AddDisposable)
+}
+
+// addSharedDisposables registers resources of a value which may be used by any scope,
+// so that none of the scopes closes them before the end of the run.
+func addSharedDisposables(scope *core.Scope, val core.Value) {
+	forEachDisposable(val, scope.AddSharedDisposable)
+}
+
+// forEachDisposable calls a given function for a value and values nested in its arrays and objects
+// which implement io.Closer.
+func forEachDisposable(val core.Value, fn func(closer io.Closer)) {
+	if closer, ok := val.(io.Closer); ok {
+		fn(closer)
+	}
+
+	switch v := val.(type) {
+	case *values.Array:
+		v.ForEach(func(item core.Value, _ int) bool {
+			forEachDisposable(item, fn)
+
+			return true
+		})
+	case *values.Object:
+		v.ForEach(func(item core.Value, _ string) bool {
+			forEachDisposable(item, fn)
+
+			return true
+		})
+	}
+}
+
+func (e *VariableExpression) Explain() *core.PlanNode {
+	node := core.NewPlanNode("VariableExpression").WithSource(e.src).Set("name", e.name)
+
+	if e.resolved {
+		node.Set("slot", strconv.Itoa(e.depth)+":"+strconv.Itoa(e.slot))
+	}
+
+	return node
+}
+
+func (e *VariableDeclarationExpression) Explain() *core.PlanNode {
+	return core.NewPlanNode("VariableDeclarationExpression").
+		WithSource(e.src).
+		Set("name", e.name).
+		Add(e.init)
 }
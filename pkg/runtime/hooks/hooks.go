@@ -0,0 +1,83 @@
+package hooks
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	ctxKey struct{}
+
+	// ProgramEvent describes a run of a program.
+	// Duration and Error are set only when the run ends.
+	ProgramEvent struct {
+		Source   string
+		Duration time.Duration
+		Error    error
+	}
+
+	// FunctionEvent describes a call of a function.
+	// ArgsHash is a hash of the call arguments, which allows to tell identical calls apart.
+	// Duration and Error are set only when the call ends.
+	FunctionEvent struct {
+		Name     string
+		ArgsHash uint64
+		Duration time.Duration
+		Error    error
+	}
+
+	// PageEvent describes a page opened by a driver.
+	// Error is set only when closing of the page fails.
+	PageEvent struct {
+		Driver string
+		URL    string
+		Error  error
+	}
+
+	// Hooks receives events of a program run, e.g. in order to emit metrics or tracing spans.
+	// Start events return a context which is passed down to nested operations and to the matching end event,
+	// which allows to link them together.
+	// Implementations must be safe for concurrent use.
+	Hooks interface {
+		OnProgramStart(ctx context.Context, event ProgramEvent) context.Context
+		OnProgramEnd(ctx context.Context, event ProgramEvent)
+		OnFunctionStart(ctx context.Context, event FunctionEvent) context.Context
+		OnFunctionEnd(ctx context.Context, event FunctionEvent)
+		OnPageOpen(ctx context.Context, event PageEvent)
+		OnPageClose(ctx context.Context, event PageEvent)
+	}
+
+	// Noop ignores all events.
+	Noop struct{}
+)
+
+func WithContext(ctx context.Context, hooks Hooks) context.Context {
+	return context.WithValue(ctx, ctxKey{}, hooks)
+}
+
+// FromContext returns hooks of the current run or Noop if there are none.
+func FromContext(ctx context.Context) Hooks {
+	hooks, ok := ctx.Value(ctxKey{}).(Hooks)
+
+	if !ok || hooks == nil {
+		return Noop{}
+	}
+
+	return hooks
+}
+
+func (Noop) OnProgramStart(ctx context.Context, _ ProgramEvent) context.Context {
+	return ctx
+}
+
+func (Noop) OnProgramEnd(_ context.Context, _ ProgramEvent) {}
+
+func (Noop) OnFunctionStart(ctx context.Context, _ FunctionEvent) context.Context {
+	return ctx
+}
+
+func (Noop) OnFunctionEnd(_ context.Context, _ FunctionEvent) {}
+
+func (Noop) OnPageOpen(_ context.Context, _ PageEvent) {}
+
+func (Noop) OnPageClose(_ context.Context, _ PageEvent) {}
@@ -0,0 +1,88 @@
+package hooks
+
+import (
+	"context"
+	"sync"
+)
+
+// Recorder keeps ended programs and functions as well as opened and closed pages in memory.
+// It is meant for tests.
+type Recorder struct {
+	mu          sync.Mutex
+	programs    []ProgramEvent
+	functions   []FunctionEvent
+	openPages   []PageEvent
+	closedPages []PageEvent
+}
+
+func NewRecorder() *Recorder {
+	return new(Recorder)
+}
+
+func (r *Recorder) OnProgramStart(ctx context.Context, _ ProgramEvent) context.Context {
+	return ctx
+}
+
+func (r *Recorder) OnProgramEnd(_ context.Context, event ProgramEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.programs = append(r.programs, event)
+}
+
+func (r *Recorder) OnFunctionStart(ctx context.Context, _ FunctionEvent) context.Context {
+	return ctx
+}
+
+func (r *Recorder) OnFunctionEnd(_ context.Context, event FunctionEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.functions = append(r.functions, event)
+}
+
+func (r *Recorder) OnPageOpen(_ context.Context, event PageEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.openPages = append(r.openPages, event)
+}
+
+func (r *Recorder) OnPageClose(_ context.Context, event PageEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closedPages = append(r.closedPages, event)
+}
+
+// Programs returns ended runs in the order of their end.
+func (r *Recorder) Programs() []ProgramEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]ProgramEvent(nil), r.programs...)
+}
+
+// Functions returns ended function calls in the order of their end.
+func (r *Recorder) Functions() []FunctionEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]FunctionEvent(nil), r.functions...)
+}
+
+// OpenedPages returns pages in the order of their opening.
+func (r *Recorder) OpenedPages() []PageEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]PageEvent(nil), r.openPages...)
+}
+
+// ClosedPages returns pages in the order of their closing.
+func (r *Recorder) ClosedPages() []PageEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]PageEvent(nil), r.closedPages...)
+}
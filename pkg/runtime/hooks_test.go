@@ -0,0 +1,118 @@
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	h "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/hooks"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHooks(t *testing.T) {
+	Convey("Should report a run and function calls", t, func() {
+		c := compiler.New()
+		c.RegisterFunction("FAIL", func(_ context.Context, _ ...core.Value) (core.Value, error) {
+			return values.None, errors.New("failed")
+		})
+
+		recorder := hooks.NewRecorder()
+		query := `
+			LET a = TYPENAME(1)
+			LET b = TYPENAME(1)
+			LET c = TYPENAME("1")
+
+			RETURN [a, b, c]
+		`
+
+		_, err := c.MustCompile(query).Run(context.Background(), runtime.WithHooks(recorder))
+
+		So(err, ShouldBeNil)
+
+		programs := recorder.Programs()
+		So(programs, ShouldHaveLength, 1)
+		So(programs[0].Source, ShouldEqual, query)
+		So(programs[0].Error, ShouldBeNil)
+
+		functions := recorder.Functions()
+		So(functions, ShouldHaveLength, 3)
+
+		for _, fn := range functions {
+			So(fn.Name, ShouldEqual, "TYPENAME")
+			So(fn.Error, ShouldBeNil)
+		}
+
+		So(functions[0].ArgsHash, ShouldEqual, functions[1].ArgsHash)
+		So(functions[0].ArgsHash, ShouldNotEqual, functions[2].ArgsHash)
+
+		Convey("Should report errors", func() {
+			recorder := hooks.NewRecorder()
+
+			_, err := c.MustCompile(`RETURN FAIL()`).Run(context.Background(), runtime.WithHooks(recorder))
+
+			So(err, ShouldNotBeNil)
+
+			So(recorder.Functions(), ShouldHaveLength, 1)
+			So(recorder.Functions()[0].Name, ShouldEqual, "FAIL")
+			So(recorder.Functions()[0].Error, ShouldNotBeNil)
+
+			So(recorder.Programs(), ShouldHaveLength, 1)
+			So(recorder.Programs()[0].Error, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Should report panics", t, func() {
+		c := compiler.New()
+		c.RegisterFunction("PANIC", func(_ context.Context, _ ...core.Value) (core.Value, error) {
+			panic("test")
+		})
+
+		recorder := hooks.NewRecorder()
+
+		_, err := c.MustCompile(`RETURN PANIC()`).Run(context.Background(), runtime.WithHooks(recorder))
+
+		So(err, ShouldNotBeNil)
+		So(recorder.Programs(), ShouldHaveLength, 1)
+		So(recorder.Programs()[0].Error, ShouldNotBeNil)
+	})
+
+	Convey("Should report opened and closed pages", t, func() {
+		server := httptest.NewServer(h.HandlerFunc(func(w h.ResponseWriter, _ *h.Request) {
+			fmt.Fprint(w, `<html><head><title>Test</title></head><body></body></html>`)
+		}))
+		defer server.Close()
+
+		c := compiler.New()
+		recorder := hooks.NewRecorder()
+		ctx := drivers.WithContext(context.Background(), http.NewDriver())
+
+		out, err := c.MustCompile(`
+			FOR i IN 1..2
+				LET doc = DOCUMENT(@url)
+				RETURN doc.title
+		`).Run(ctx, runtime.WithParam("url", server.URL), runtime.WithHooks(recorder))
+
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, `["Test","Test"]`)
+
+		opened := recorder.OpenedPages()
+		So(opened, ShouldHaveLength, 2)
+		So(opened[0].Driver, ShouldEqual, http.DriverName)
+		So(opened[0].URL, ShouldEqual, server.URL)
+
+		So(recorder.ClosedPages(), ShouldHaveLength, 2)
+	})
+
+	Convey("Should ignore events without hooks", t, func() {
+		So(hooks.FromContext(context.Background()), ShouldHaveSameTypeAs, hooks.Noop{})
+	})
+}
@@ -2,17 +2,35 @@ package logging
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+
 	"github.com/gofrs/uuid"
 	"github.com/rs/zerolog"
-	"io"
 )
 
 type (
 	Level uint8
 
+	// Logger receives log records of a run.
+	// It allows to plug in a logging library of choice instead of writing records to an io.Writer.
+	Logger interface {
+		Log(level Level, message string, fields map[string]interface{})
+	}
+
 	Options struct {
 		Writer io.Writer
+		// Logger takes precedence over Writer if set.
+		Logger Logger
 		Level  Level
+		// Fields are added to each record of a run, e.g. user supplied labels.
+		Fields map[string]interface{}
+	}
+
+	// loggerWriter passes records written by zerolog to a Logger.
+	loggerWriter struct {
+		logger Logger
 	}
 )
 
@@ -34,16 +52,51 @@ func WithContext(ctx context.Context, opts *Options) context.Context {
 		panic(err)
 	}
 
-	logger := zerolog.New(opts.Writer).
+	var writer io.Writer = opts.Writer
+
+	if opts.Logger != nil {
+		writer = &loggerWriter{opts.Logger}
+	}
+
+	if writer == nil {
+		writer = ioutil.Discard
+	}
+
+	logger := zerolog.New(writer).
+		Level(zerolog.Level(opts.Level)).
 		With().
+		Fields(opts.Fields).
 		Str("id", id.String()).
 		Logger()
 
-	logger.WithLevel(zerolog.Level(opts.Level))
-
 	return logger.WithContext(ctx)
 }
 
 func FromContext(ctx context.Context) *zerolog.Logger {
 	return zerolog.Ctx(ctx)
 }
+
+func (l Level) String() string {
+	return zerolog.Level(l).String()
+}
+
+func (w *loggerWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+func (w *loggerWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	fields := make(map[string]interface{})
+
+	if err := json.Unmarshal(p, &fields); err != nil {
+		return 0, err
+	}
+
+	message, _ := fields[zerolog.MessageFieldName].(string)
+
+	delete(fields, zerolog.MessageFieldName)
+	delete(fields, zerolog.LevelFieldName)
+
+	w.logger.Log(Level(level), message, fields)
+
+	return len(p), nil
+}
@@ -0,0 +1,119 @@
+package runtime_test
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/compiler"
+	"github.com/MontFerret/ferret/pkg/runtime"
+	"github.com/MontFerret/ferret/pkg/runtime/logging"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type (
+	logRecord struct {
+		level   logging.Level
+		message string
+		fields  map[string]interface{}
+	}
+
+	testLogger struct {
+		mu      sync.Mutex
+		records []logRecord
+	}
+)
+
+func (l *testLogger) Log(level logging.Level, message string, fields map[string]interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.records = append(l.records, logRecord{level, message, fields})
+}
+
+func TestLogging(t *testing.T) {
+	query := `
+		PRINT("print")
+		LOG::DEBUG("debug")
+		LOG::INFO("info", 1)
+		LOG::WARN("warn")
+		LOG::ERROR("error")
+
+		RETURN TRUE
+	`
+
+	Convey("Should pass records to a logger according to a log level", t, func() {
+		p := compiler.New().MustCompile(query)
+		logger := &testLogger{}
+
+		_, err := p.Run(
+			context.Background(),
+			runtime.WithLogger(logger),
+			runtime.WithLogLevel(logging.InfoLevel),
+			runtime.WithLogFields(map[string]interface{}{"job": "test"}),
+		)
+
+		So(err, ShouldBeNil)
+		So(logger.records, ShouldHaveLength, 3)
+
+		So(logger.records[0].level, ShouldEqual, logging.InfoLevel)
+		So(logger.records[0].message, ShouldEqual, "info 1")
+		So(logger.records[1].level, ShouldEqual, logging.WarnLevel)
+		So(logger.records[1].message, ShouldEqual, "warn")
+		So(logger.records[2].level, ShouldEqual, logging.ErrorLevel)
+		So(logger.records[2].message, ShouldEqual, "error")
+
+		for _, r := range logger.records {
+			So(r.fields["job"], ShouldEqual, "test")
+			So(r.fields["query_hash"], ShouldEqual, p.Hash())
+			So(r.fields["id"], ShouldNotBeEmpty)
+		}
+	})
+
+	Convey("Should write records of the configured log level only", t, func() {
+		p := compiler.New().MustCompile(query)
+		buf := &bytes.Buffer{}
+
+		_, err := p.Run(context.Background(), runtime.WithLog(buf), runtime.WithLogLevel(logging.ErrorLevel))
+
+		So(err, ShouldBeNil)
+
+		out := buf.String()
+		So(out, ShouldContainSubstring, `"message":"error"`)
+		So(out, ShouldNotContainSubstring, `"message":"warn"`)
+		So(out, ShouldNotContainSubstring, `"message":"print"`)
+
+		Convey("Should write debug records with the debug level", func() {
+			buf := &bytes.Buffer{}
+
+			_, err := p.Run(context.Background(), runtime.WithLog(buf), runtime.WithLogLevel(logging.DebugLevel))
+
+			So(err, ShouldBeNil)
+			So(buf.String(), ShouldContainSubstring, `"message":"print"`)
+			So(buf.String(), ShouldContainSubstring, `"message":"debug"`)
+		})
+	})
+
+	Convey("Should give programs compiled from the same query the same hash", t, func() {
+		c := compiler.New()
+
+		So(c.MustCompile(query).Hash(), ShouldEqual, c.MustCompile(query).Hash())
+		So(c.MustCompile(`RETURN 1`).Hash(), ShouldNotEqual, c.MustCompile(query).Hash())
+	})
+
+	Convey("Should give each run its own id", t, func() {
+		p := compiler.New().MustCompile(query)
+		logger := &testLogger{}
+
+		for i := 0; i < 2; i++ {
+			_, err := p.Run(context.Background(), runtime.WithLogger(logger), runtime.WithLogLevel(logging.ErrorLevel))
+
+			So(err, ShouldBeNil)
+		}
+
+		So(logger.records, ShouldHaveLength, 2)
+		So(logger.records[0].fields["query_hash"], ShouldEqual, logger.records[1].fields["query_hash"])
+		So(logger.records[0].fields["id"], ShouldNotEqual, logger.records[1].fields["id"])
+	})
+}
@@ -5,7 +5,9 @@ import (
 	"io"
 	"os"
 
+	"github.com/MontFerret/ferret/pkg/runtime/collections"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/hooks"
 	"github.com/MontFerret/ferret/pkg/runtime/logging"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
@@ -14,6 +16,9 @@ type (
 	Options struct {
 		params  map[string]core.Value
 		logging *logging.Options
+		spill   *collections.SpillOptions
+		cache   []string
+		hooks   hooks.Hooks
 	}
 
 	Option func(*Options)
@@ -25,6 +30,7 @@ func NewOptions(setters []Option) *Options {
 		logging: &logging.Options{
 			Writer: os.Stdout,
 			Level:  logging.ErrorLevel,
+			Fields: make(map[string]interface{}),
 		},
 	}
 
@@ -61,9 +67,79 @@ func WithLogLevel(lvl logging.Level) Option {
 	}
 }
 
+// WithLogger sets a logger receiving log records instead of the writer set by WithLog.
+func WithLogger(logger logging.Logger) Option {
+	return func(options *Options) {
+		options.logging.Logger = logger
+	}
+}
+
+// WithLogFields adds given fields to each log record of a run, e.g. labels identifying a caller.
+func WithLogFields(fields map[string]interface{}) Option {
+	return func(options *Options) {
+		for name, value := range fields {
+			options.logging.Fields[name] = value
+		}
+	}
+}
+
+// WithSpill enables spilling of sorted data sets larger than a given threshold
+// to temporary files in a given directory.
+// Unless WithSpillGroupLimit sets another limit, the threshold also limits the number of groups
+// kept in memory by COLLECT clauses using the hash method.
+func WithSpill(threshold int, dir string) Option {
+	return func(options *Options) {
+		spill := options.spillOptions()
+		spill.Threshold = threshold
+		spill.Dir = dir
+	}
+}
+
+// WithSpillGroupLimit limits the number of groups kept in memory by COLLECT clauses using the hash method.
+// Rows of other groups are spilled to temporary files in a directory set by WithSpill.
+// The limit counts groups only: rows projected into a group kept in memory by INTO or KEEP
+// are not limited, so a single large group is held in memory entirely.
+func WithSpillGroupLimit(max int) Option {
+	return func(options *Options) {
+		options.spillOptions().MaxGroups = max
+	}
+}
+
+// WithCache enables memoization of calls of given functions within a run.
+// Calls with arguments having equal hashes reuse the result of the first call.
+func WithCache(functions ...string) Option {
+	return func(options *Options) {
+		options.cache = append(options.cache, functions...)
+	}
+}
+
+// WithHooks sets hooks receiving events of a run, e.g. in order to emit metrics or tracing spans.
+func WithHooks(h hooks.Hooks) Option {
+	return func(options *Options) {
+		options.hooks = h
+	}
+}
+
+func (opts *Options) spillOptions() *collections.SpillOptions {
+	if opts.spill == nil {
+		opts.spill = new(collections.SpillOptions)
+	}
+
+	return opts.spill
+}
+
 func (opts *Options) WithContext(parent context.Context) context.Context {
 	ctx := core.ParamsWith(parent, opts.params)
 	ctx = logging.WithContext(ctx, opts.logging)
+	ctx = core.CacheWith(ctx, core.NewCache(opts.cache...))
+
+	if opts.hooks != nil {
+		ctx = hooks.WithContext(ctx, opts.hooks)
+	}
+
+	if opts.spill != nil {
+		ctx = collections.WithSpill(ctx, opts.spill)
+	}
 
 	return ctx
 }
@@ -2,15 +2,20 @@ package runtime
 
 import (
 	"context"
+	"hash/fnv"
 	"runtime"
+	"strconv"
+	"time"
 
 	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/hooks"
 	"github.com/MontFerret/ferret/pkg/runtime/logging"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 	"github.com/pkg/errors"
 )
 
 type Program struct {
+	hash string
 	src  string
 	body core.Expression
 }
@@ -24,17 +29,49 @@ func NewProgram(src string, body core.Expression) (*Program, error) {
 		return nil, core.Error(core.ErrMissedArgument, "body")
 	}
 
-	return &Program{src, body}, nil
+	h := fnv.New64a()
+	h.Write([]byte(src))
+
+	return &Program{strconv.FormatUint(h.Sum64(), 16), src, body}, nil
+}
+
+// Hash returns a hash of the query, which is the same for programs compiled from the same source.
+// Log records of a run have it in the "query_hash" field, while the "id" field identifies the run.
+func (p *Program) Hash() string {
+	return p.hash
 }
 
 func (p *Program) Source() string {
 	return p.src
 }
 
+// Explain returns a tree that describes the compiled query.
+func (p *Program) Explain() *core.PlanNode {
+	return core.Explain(p.body)
+}
+
 func (p *Program) Run(ctx context.Context, setters ...Option) (result []byte, err error) {
-	ctx = NewOptions(setters).WithContext(ctx)
+	opts := NewOptions(setters)
+
+	if _, exists := opts.logging.Fields["query_hash"]; !exists {
+		opts.logging.Fields["query_hash"] = p.hash
+	}
+
+	ctx = opts.WithContext(ctx)
 
 	logger := logging.FromContext(ctx)
+	h := hooks.FromContext(ctx)
+	event := hooks.ProgramEvent{Source: p.src}
+	ctx = h.OnProgramStart(ctx, event)
+	start := time.Now()
+
+	// it gets called last in order to report panics and to include closing of the root scope
+	defer func() {
+		event.Duration = time.Since(start)
+		event.Error = err
+
+		h.OnProgramEnd(ctx, event)
+	}()
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -436,3 +436,34 @@ func IsNumber(input core.Value) Boolean {
 
 	return t == types.Int || t == types.Float
 }
+
+// IsPlain returns true if a given value consists of primitive values, arrays and objects only.
+// Such values cannot refer to external resources like pages and elements.
+func IsPlain(value core.Value) bool {
+	switch v := value.(type) {
+	case *none, Boolean, Int, Float, String, DateTime, Binary:
+		return true
+	case *Array:
+		res := true
+
+		v.ForEach(func(item core.Value, _ int) bool {
+			res = IsPlain(item)
+
+			return res
+		})
+
+		return res
+	case *Object:
+		res := true
+
+		v.ForEach(func(item core.Value, _ string) bool {
+			res = IsPlain(item)
+
+			return res
+		})
+
+		return res
+	default:
+		return false
+	}
+}
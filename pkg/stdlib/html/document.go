@@ -2,6 +2,7 @@ package html
 
 import (
 	"context"
+	"net/url"
 	"strings"
 	"time"
 
@@ -26,6 +27,10 @@ type PageLoadParams struct {
 // or an object with the following properties :
 // 		dynamic (Boolean) - Optional, indicates whether to use dynamic page.
 // 		timeout (Int) - Optional, Open load timeout.
+// 		allowedStatusCodes (Array<Int>) - Optional, response status codes which are not treated as errors. Default [200].
+// 		method (String) - Optional, HTTP method of the request. Default "GET".
+// 		body (String|Object|Binary) - Optional, body of the request. Objects are sent as a form, or as JSON if contentType is JSON.
+// 		contentType (String) - Optional, content type of the body.
 // @returns (HTMLDocument) - Returns loaded HTML document.
 func Open(ctx context.Context, args ...core.Value) (core.Value, error) {
 	err := core.ValidateArgs(args, 1, 2)
@@ -155,6 +160,51 @@ func newPageLoadParams(url values.String, arg core.Value) (PageLoadParams, error
 			res.Header = header
 		}
 
+		allowedStatusCodes, exists := obj.Get(values.NewString("allowedStatusCodes"))
+
+		if exists {
+			if err := core.ValidateType(allowedStatusCodes, types.Array); err != nil {
+				return res, err
+			}
+
+			codes, err := parseStatusCodes(allowedStatusCodes.(*values.Array))
+
+			if err != nil {
+				return res, err
+			}
+
+			res.AllowedStatusCodes = codes
+		}
+
+		method, exists := obj.Get(values.NewString("method"))
+
+		if exists {
+			if err := core.ValidateType(method, types.String); err != nil {
+				return res, err
+			}
+
+			res.Method = strings.ToUpper(method.String())
+		}
+
+		contentType, exists := obj.Get(values.NewString("contentType"))
+
+		if exists {
+			if err := core.ValidateType(contentType, types.String); err != nil {
+				return res, err
+			}
+
+			res.ContentType = contentType.String()
+		}
+
+		// the content type is needed to encode objects
+		body, exists := obj.Get(values.NewString("body"))
+
+		if exists {
+			if err := parseBody(&res.OpenPageParams, body); err != nil {
+				return res, err
+			}
+		}
+
 	case types.String:
 		res.Driver = arg.(values.String).String()
 
@@ -280,6 +330,80 @@ func parseCookie(value core.Value) (drivers.HTTPCookie, error) {
 	return cookie, err
 }
 
+func parseStatusCodes(arr *values.Array) ([]int, error) {
+	var err error
+	res := make([]int, 0, arr.Length())
+
+	arr.ForEach(func(value core.Value, _ int) bool {
+		if err = core.ValidateType(value, types.Int); err != nil {
+			return false
+		}
+
+		res = append(res, int(value.(values.Int)))
+
+		return true
+	})
+
+	return res, err
+}
+
+func parseBody(params *drivers.OpenPageParams, body core.Value) error {
+	if err := core.ValidateType(body, types.String, types.Object, types.Binary); err != nil {
+		return err
+	}
+
+	switch body.Type() {
+	case types.Object:
+		if strings.Contains(strings.ToLower(params.ContentType), "json") {
+			data, err := body.MarshalJSON()
+
+			if err != nil {
+				return err
+			}
+
+			params.Body = data
+
+			return nil
+		}
+
+		form := url.Values{}
+
+		body.(*values.Object).ForEach(func(value core.Value, key string) bool {
+			if arr, ok := value.(*values.Array); ok {
+				arr.ForEach(func(item core.Value, _ int) bool {
+					form.Add(key, item.String())
+
+					return true
+				})
+			} else {
+				form.Add(key, value.String())
+			}
+
+			return true
+		})
+
+		params.Body = []byte(form.Encode())
+
+		if params.ContentType == "" {
+			params.ContentType = "application/x-www-form-urlencoded"
+		}
+	case types.Binary:
+		params.Body = []byte(body.(values.Binary))
+
+		if params.ContentType == "" {
+			params.ContentType = "application/octet-stream"
+		}
+	default:
+		params.Body = []byte(body.String())
+
+		if params.ContentType == "" {
+			params.ContentType = "text/plain; charset=utf-8"
+		}
+	}
+
+	return nil
+}
+
 func parseHeader(header *values.Object) drivers.HTTPHeader {
 	res := make(drivers.HTTPHeader)
 
@@ -0,0 +1,105 @@
+package html_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	h "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/stdlib/html"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDocument(t *testing.T) {
+	server := httptest.NewServer(h.HandlerFunc(func(w h.ResponseWriter, r *h.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		fmt.Fprintf(
+			w,
+			`<html><head><title>%s</title></head><body><p id="type">%s</p><p id="body">%s</p></body></html>`,
+			r.Method,
+			r.Header.Get("Content-Type"),
+			body,
+		)
+	}))
+	defer server.Close()
+
+	ctx := drivers.WithContext(context.Background(), http.NewDriver(), drivers.AsDefault())
+
+	open := func(params *values.Object) drivers.HTMLPage {
+		out, err := html.Open(ctx, values.NewString(server.URL), params)
+
+		So(err, ShouldBeNil)
+		So(out, ShouldImplement, (*drivers.HTMLPage)(nil))
+
+		return out.(drivers.HTMLPage)
+	}
+
+	text := func(page drivers.HTMLPage, selector string) string {
+		el := page.GetMainFrame().QuerySelector(ctx, values.NewString(selector))
+
+		So(el, ShouldImplement, (*drivers.HTMLElement)(nil))
+
+		return el.(drivers.HTMLElement).GetInnerText(ctx).String()
+	}
+
+	Convey("Should send a request with a method and a body", t, func() {
+		Convey("Objects as a form", func() {
+			page := open(values.NewObjectWith(
+				values.NewObjectProperty("method", values.NewString("post")),
+				values.NewObjectProperty("body", values.NewObjectWith(
+					values.NewObjectProperty("q", values.NewString("x")),
+				)),
+			))
+
+			So(text(page, "title"), ShouldEqual, "POST")
+			So(text(page, "#type"), ShouldEqual, "application/x-www-form-urlencoded")
+			So(text(page, "#body"), ShouldEqual, "q=x")
+		})
+
+		Convey("Objects as JSON", func() {
+			page := open(values.NewObjectWith(
+				values.NewObjectProperty("method", values.NewString("PUT")),
+				values.NewObjectProperty("contentType", values.NewString("application/json")),
+				values.NewObjectProperty("body", values.NewObjectWith(
+					values.NewObjectProperty("q", values.NewString("x")),
+				)),
+			))
+
+			So(text(page, "title"), ShouldEqual, "PUT")
+			So(text(page, "#type"), ShouldEqual, "application/json")
+			So(text(page, "#body"), ShouldEqual, `{"q":"x"}`)
+		})
+
+		Convey("Strings as text", func() {
+			page := open(values.NewObjectWith(
+				values.NewObjectProperty("method", values.NewString("POST")),
+				values.NewObjectProperty("body", values.NewString("foo")),
+			))
+
+			So(text(page, "#type"), ShouldEqual, "text/plain; charset=utf-8")
+			So(text(page, "#body"), ShouldEqual, "foo")
+		})
+	})
+
+	Convey("Should send GET requests by default", t, func() {
+		page := open(values.NewObject())
+
+		So(text(page, "title"), ShouldEqual, "GET")
+	})
+
+	Convey("Should reject bodies of invalid types", t, func() {
+		_, err := html.Open(ctx, values.NewString(server.URL), values.NewObjectWith(
+			values.NewObjectProperty("body", values.NewInt(1)),
+		))
+
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, core.ErrInvalidType.Error())
+	})
+}
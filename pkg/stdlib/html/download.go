@@ -3,8 +3,10 @@ package html
 import (
 	"context"
 	"io/ioutil"
-	"net/http"
+	h "net/http"
 
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 	"github.com/MontFerret/ferret/pkg/runtime/values/types"
@@ -12,8 +14,9 @@ import (
 
 // Download a resource from the given GetURL.
 // @param GetURL (String) - GetURL to download.
+// If http driver is registered, its retries and rate limits are applied.
 // @returns data (Binary) - Returns a base64 encoded string in binary format.
-func Download(_ context.Context, args ...core.Value) (core.Value, error) {
+func Download(ctx context.Context, args ...core.Value) (core.Value, error) {
 	err := core.ValidateArgs(args, 1, 1)
 
 	if err != nil {
@@ -27,7 +30,21 @@ func Download(_ context.Context, args ...core.Value) (core.Value, error) {
 		return values.None, err
 	}
 
-	resp, err := http.Get(arg1.String())
+	drv, err := drivers.FromContext(ctx, http.DriverName)
+
+	if err == nil {
+		if httpDriver, ok := drv.(*http.Driver); ok {
+			data, err := httpDriver.Download(ctx, arg1.String())
+
+			if err != nil {
+				return values.None, err
+			}
+
+			return values.NewBinary(data), nil
+		}
+	}
+
+	resp, err := h.Get(arg1.String())
 
 	if err != nil {
 		return values.None, err
@@ -0,0 +1,74 @@
+package html
+
+import (
+	"context"
+
+	"github.com/MontFerret/ferret/pkg/drivers"
+	"github.com/MontFerret/ferret/pkg/drivers/http"
+	"github.com/MontFerret/ferret/pkg/runtime/core"
+	"github.com/MontFerret/ferret/pkg/runtime/values"
+	"github.com/MontFerret/ferret/pkg/runtime/values/types"
+)
+
+// FormSubmit submits a given form of a static page by http driver.
+// Action, method and current values of the form are taken from the form element.
+// @param form (HTMLElement) - Target form element.
+// @param values (Object, optional) - Values overriding current values of the form. None removes a value.
+// @param timeout (Int, optional) - Optional timeout. Default is 5000.
+// @returns (HTMLPage) - Returns a page loaded in response to the form.
+func FormSubmit(ctx context.Context, args ...core.Value) (core.Value, error) {
+	err := core.ValidateArgs(args, 1, 3)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	err = core.ValidateType(args[0], drivers.HTMLElementType)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	form := args[0].(drivers.HTMLElement)
+
+	var fields *values.Object
+
+	if len(args) > 1 {
+		err = core.ValidateType(args[1], types.Object)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		fields = args[1].(*values.Object)
+	}
+
+	timeout := values.NewInt(defaultTimeout)
+
+	if len(args) > 2 {
+		err = core.ValidateType(args[2], types.Int)
+
+		if err != nil {
+			return values.None, err
+		}
+
+		timeout = args[2].(values.Int)
+	}
+
+	drv, err := drivers.FromContext(ctx, http.DriverName)
+
+	if err != nil {
+		return values.None, err
+	}
+
+	httpDriver, ok := drv.(*http.Driver)
+
+	if !ok {
+		return values.None, core.Errorf(core.ErrNotSupported, "%s driver", http.DriverName)
+	}
+
+	ctx, fn := waitTimeout(ctx, timeout)
+	defer fn()
+
+	return httpDriver.SubmitForm(ctx, form, fields)
+}
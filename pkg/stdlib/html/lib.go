@@ -28,6 +28,7 @@ func NewLib() map[string]core.Function {
 		"ELEMENT_EXISTS":    ElementExists,
 		"ELEMENTS":          Elements,
 		"ELEMENTS_COUNT":    ElementsCount,
+		"FORM_SUBMIT":       FormSubmit,
 		"HOVER":             Hover,
 		"INNER_HTML":        InnerHTML,
 		"INNER_HTML_ALL":    InnerHTMLAll,
@@ -4,7 +4,11 @@ import "github.com/MontFerret/ferret/pkg/runtime/core"
 
 func NewLib() map[string]core.Function {
 	return map[string]core.Function{
-		"WAIT":  Wait,
-		"PRINT": Print,
+		"WAIT":       Wait,
+		"PRINT":      Print,
+		"LOG::DEBUG": LogDebug,
+		"LOG::INFO":  LogInfo,
+		"LOG::WARN":  LogWarn,
+		"LOG::ERROR": LogError,
 	}
 }
@@ -2,14 +2,41 @@ package utils
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
 
 	"github.com/MontFerret/ferret/pkg/runtime/core"
 	"github.com/MontFerret/ferret/pkg/runtime/logging"
 	"github.com/MontFerret/ferret/pkg/runtime/values"
 )
 
-// Print writes messages into the system log.
+// Print writes messages into the system log with the debug level.
 func Print(ctx context.Context, args ...core.Value) (core.Value, error) {
+	return logWithLevel(ctx, logging.DebugLevel, args)
+}
+
+// LogDebug writes messages into the system log with the debug level.
+func LogDebug(ctx context.Context, args ...core.Value) (core.Value, error) {
+	return logWithLevel(ctx, logging.DebugLevel, args)
+}
+
+// LogInfo writes messages into the system log with the info level.
+func LogInfo(ctx context.Context, args ...core.Value) (core.Value, error) {
+	return logWithLevel(ctx, logging.InfoLevel, args)
+}
+
+// LogWarn writes messages into the system log with the warn level.
+func LogWarn(ctx context.Context, args ...core.Value) (core.Value, error) {
+	return logWithLevel(ctx, logging.WarnLevel, args)
+}
+
+// LogError writes messages into the system log with the error level.
+func LogError(ctx context.Context, args ...core.Value) (core.Value, error) {
+	return logWithLevel(ctx, logging.ErrorLevel, args)
+}
+
+func logWithLevel(ctx context.Context, level logging.Level, args []core.Value) (core.Value, error) {
 	err := core.ValidateArgs(args, 1, core.MaxArgs)
 
 	if err != nil {
@@ -28,7 +55,7 @@ func Print(ctx context.Context, args ...core.Value) (core.Value, error) {
 
 	logger := logging.FromContext(ctx)
 
-	logger.Print(messages...)
+	logger.WithLevel(zerolog.Level(level)).Msg(fmt.Sprint(messages...))
 
 	return values.None, nil
 }